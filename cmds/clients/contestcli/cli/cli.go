@@ -28,22 +28,100 @@ var (
 	flagSet       *flag.FlagSet
 	flagAddr      *string
 	flagRequestor *string
+	flagToken     *string
 	flagWait      *bool
 	flagYAML      *bool
 	flagStates    *[]string
 	flagTags      *[]string
+
+	flagJobRequestor    *string
+	flagNamePattern     *string
+	flagRequestedAfter  *string
+	flagRequestedBefore *string
+	flagEndedAfter      *string
+	flagEndedBefore     *string
+	flagNewestFirst     *bool
+	flagAfterJob        *uint64
+
+	flagOnlyFailedTests   *bool
+	flagOnlyFailedTargets *bool
+
+	flagSkipIfRunning *bool
+
+	flagRunID               *uint64
+	flagTestName            *string
+	flagStepLabel           *string
+	flagTargetID            *string
+	flagEventNames          *[]string
+	flagAfterTestEvent      *uint64
+	flagAfterFrameworkEvent *uint64
+
+	flagKinds           *[]string
+	flagPayloadContains *string
+	flagSince           *string
+	flagUntil           *string
+	flagLimit           *uint
+
+	flagFollow   *bool
+	flagAfterLog *uint64
+
+	flagSelector *string
+
+	flagReason *string
 )
 
 func initFlags(cmd string) {
 	flagSet = flag.NewFlagSet(cmd, flag.ContinueOnError)
 	flagAddr = flagSet.StringP("addr", "a", "http://localhost:8080", "ConTest server [scheme://]host:port[/basepath] to connect to")
 	flagRequestor = flagSet.StringP("requestor", "r", defaultRequestor, "Identifier of the requestor of the API call")
+	flagToken = flagSet.String("token", "", "Bearer token to authenticate with, the requestor is then the one the token belongs to unless specified")
 	flagWait = flagSet.BoolP("wait", "w", false, "After starting a job, wait for it to finish, and exit 0 only if it is successful")
 	flagYAML = flagSet.BoolP("yaml", "Y", false, "Parse job descriptor as YAML instead of JSON")
 
 	// Flags for the "list" command.
 	flagStates = flagSet.StringSlice("states", []string{}, "List of job states for the list command. A job must be in any of the specified states to match.")
 	flagTags = flagSet.StringSlice("tags", []string{}, "List of tags for the list command. A job must have all the tags to match.")
+	flagJobRequestor = flagSet.String("job-requestor", "", "Only list the jobs submitted by this requestor for the list command.")
+	flagNamePattern = flagSet.String("name", "", "Only list the jobs whose name matches this pattern for the list command. '*' matches any sequence of characters, '?' any single character.")
+	flagRequestedAfter = flagSet.String("requested-after", "", "Only list the jobs requested at or after this RFC 3339 time for the list command.")
+	flagRequestedBefore = flagSet.String("requested-before", "", "Only list the jobs requested at or before this RFC 3339 time for the list command.")
+	flagEndedAfter = flagSet.String("ended-after", "", "Only list the jobs which ended at or after this RFC 3339 time for the list command.")
+	flagEndedBefore = flagSet.String("ended-before", "", "Only list the jobs which ended at or before this RFC 3339 time for the list command.")
+	flagNewestFirst = flagSet.Bool("newest-first", false, "List the most recent jobs first for the list command.")
+	flagAfterJob = flagSet.Uint64("after-job", 0, "Only list the jobs which come after the job with this ID in the listing order for the list command, to get the next page.")
+
+	// Flags for the "retry" command.
+	flagOnlyFailedTests = flagSet.Bool("only-failed-tests", false, "Only retry the tests that failed in the last run of the job.")
+	flagOnlyFailedTargets = flagSet.Bool("only-failed-targets", false, "Only retry the failed tests, on the targets that failed them in the last run of the job.")
+
+	// Flags for the "schedule" command.
+	flagSkipIfRunning = flagSet.Bool("skip-if-running", false, "Do not submit a new job while the previous one submitted by the schedule is still queued or running.")
+
+	// Flags for the "tail" and "events" commands.
+	flagRunID = flagSet.Uint64("run", 0, "Only return the test events of this run for the tail and events commands.")
+	flagTestName = flagSet.String("test", "", "Only return the test events of this test for the tail and events commands.")
+	flagStepLabel = flagSet.String("step", "", "Only return the test events of the step with this label for the tail and events commands.")
+	flagTargetID = flagSet.String("target", "", "Only return the test events of this target for the tail and events commands.")
+	flagEventNames = flagSet.StringSlice("events", []string{}, "List of event names for the tail and events commands. An event must have any of the names to match.")
+	flagAfterTestEvent = flagSet.Uint64("after-test-event", 0, "Resume the tail command, or start the events command, after the test event with this sequence ID.")
+	flagAfterFrameworkEvent = flagSet.Uint64("after-framework-event", 0, "Resume the tail command, or start the events command, after the framework event with this sequence ID.")
+
+	// Flags for the "events" command.
+	flagKinds = flagSet.StringSlice("kinds", []string{"test", "framework"}, "Kinds of events to return for the events command, test and/or framework.")
+	flagPayloadContains = flagSet.String("payload", "", "Only return the test events whose payload contains this string for the events command.")
+	flagSince = flagSet.String("since", "", "Only return the events emitted at or after this RFC 3339 time for the events command.")
+	flagUntil = flagSet.String("until", "", "Only return the events emitted at or before this RFC 3339 time for the events command.")
+	flagLimit = flagSet.Uint("limit", 0, "Maximum number of events of each kind to return for the events command, the server applies a default if not set. Maximum number of jobs to return for the list command.")
+
+	// Flags for the "logs" command.
+	flagFollow = flagSet.BoolP("follow", "f", false, "Keep printing the log lines of the job as they are logged, until the job is over, for the logs command.")
+	flagAfterLog = flagSet.Uint64("after-log", 0, "Only print the log lines which come after the one with this sequence ID for the logs command.")
+
+	// Flags for the "listtargets" command.
+	flagSelector = flagSet.String("selector", "", "Only list the targets whose labels match this selector for the listtargets command, e.g. board=tioga,!broken.")
+
+	// Flags for the "quarantine", "release" and "forceunlock" commands.
+	flagReason = flagSet.String("reason", "", "Why the target is quarantined, released or forcibly unlocked, for the quarantine, release and forceunlock commands.")
 
 	flagSet.Usage = func() {
 		fmt.Fprintf(flagSet.Output(),
@@ -57,14 +135,83 @@ Commands:
         or passed via stdin.
         when used with -wait flag, stdout will have two JSON outputs
         for job start and completion status separated with newline
+  validate [file]
+        check the job description from the specified file or passed via
+        stdin like start does, without starting a job. All the problems
+        found are listed along with their path in the job description
   stop int
         stop a job by job ID
+  pause int
+        pause a running job by job ID. The job keeps its targets locked
+        until it is resumed, or until the server's paused lock timeout
+  resume int
+        resume a paused job by job ID
   status int
         get the status of a job by job ID
-  retry int
-        retry a job by job ID
-  list [--states=JobStateStarted,...] [--tags=foo,...]
-        list jobs by state and/or tags
+  retry [--only-failed-tests] [--only-failed-targets] int
+        retry a job by job ID. The retry is submitted as a new job
+  list [--states=JobStateStarted,...] [--tags=foo,...] [--job-requestor=name]
+       [--name=pattern] [--requested-after=time] [--requested-before=time]
+       [--ended-after=time] [--ended-before=time] [--newest-first]
+       [--after-job=int] [--limit=int]
+        list the summaries of the jobs matching all the given filters, sorted
+        by job ID. Use --after-job with the ID of the last job returned to
+        get the next page
+  schedule [--skip-if-running] cron [file]
+        periodically start a job using the job description from the specified
+        file or passed via stdin. cron is a five-field cron expression in UTC
+        (e.g. "0 2 * * *") or a shortcut like @daily
+  unschedule int
+        delete a schedule by schedule ID
+  listschedules
+        list the schedules of the server
+  junit int
+        print the results of a job by job ID as JUnit XML, as produced by the
+        JUnit reporter
+  artifact int int
+        print the content of an artifact uploaded by a test step, by job ID
+        and artifact ID. The artifacts of a job are listed in its status
+  logs [--follow] [--after-log=int] int
+        print the lines logged by the server while running a job, by job ID.
+        With --follow, keep printing them as they are logged until the job is
+        over. The lines are not captured if the server is started with an
+        empty -jobLogLevel
+  tail [--run=int] [--test=name] [--step=label] [--target=id] [--events=name,...] int
+        stream the events of a job by job ID as they are emitted, one JSON
+        object per line, until the job completes or is paused. Use
+        --after-test-event and --after-framework-event with the sequence IDs
+        of the last events received to resume an interrupted stream
+  events [--kinds=test,framework] [--payload=string] [--since=time] [--until=time] [--limit=int] int
+        query the events of a job by job ID, with the same filters as tail.
+        Events are sorted by sequence ID, use --after-test-event and
+        --after-framework-event with the sequence IDs of the last events
+        returned to get the next page
+  addtarget [file]
+        add a target to the inventory of the server, using the JSON target
+        from the specified file or passed via stdin, e.g.
+        {"ID": "t1", "FQDN": "t1.example.com", "Labels": {"board": "tioga"},
+        "Attributes": {"bmc_host": "t1-bmc.example.com"}}
+  updatetarget [file]
+        replace a target of the inventory, like addtarget does
+  removetarget id
+        remove a target from the inventory by target ID
+  listtargets [--selector=selector]
+        list the targets of the inventory whose labels match the selector, a
+        comma-separated list of key=value, key!=value, key and !key
+  quarantine id --reason=reason
+        quarantine a target by target ID, so that it is not handed out to
+        jobs until it is released
+  release id --reason=reason
+        release a target from quarantine by target ID
+  quarantined
+        list the targets in quarantine, along with why and when they were
+        quarantined
+  locks [id...]
+        list the locks held on the specified targets, or on all the targets,
+        along with the job holding them and when they expire
+  forceunlock id... --reason=reason
+        unlock the specified targets whichever job holds them, e.g. after a
+        server crashed; the override is recorded as an event of the job
   version
         request the API version to the server
 
@@ -82,5 +229,10 @@ func CLIMain(cmd string, args []string, stdout io.Writer) error {
 		}
 		return err
 	}
-	return run(*flagRequestor, &http.HTTP{Addr: *flagAddr}, stdout)
+	requestor := *flagRequestor
+	if *flagToken != "" && !flagSet.Changed("requestor") {
+		// Let the server derive the requestor from the token.
+		requestor = ""
+	}
+	return run(requestor, &http.HTTP{Addr: *flagAddr, Token: *flagToken}, stdout)
 }
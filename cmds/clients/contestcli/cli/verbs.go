@@ -21,7 +21,12 @@ import (
 	"github.com/linuxboot/contest/pkg/api"
 	"github.com/linuxboot/contest/pkg/config"
 	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/transport"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
@@ -37,35 +42,9 @@ func run(requestor string, transport transport.Transport, stdout io.Writer) erro
 	var err error
 	switch verb {
 	case "start":
-		var jobDesc []byte
-		if flagSet.Arg(1) == "" {
-			fmt.Fprintf(os.Stderr, "Reading from stdin...\n")
-			jd, err := ioutil.ReadAll(os.Stdin)
-			if err != nil {
-				return fmt.Errorf("failed to read job descriptor: %w", err)
-			}
-			jobDesc = jd
-		} else {
-			jd, err := ioutil.ReadFile(flagSet.Arg(1))
-			if err != nil {
-				return fmt.Errorf("failed to read job descriptor: %w", err)
-			}
-			jobDesc = jd
-		}
-
-		jobDescFormat := config.JobDescFormatJSON
-		if *flagYAML {
-			jobDescFormat = config.JobDescFormatYAML
-		}
-		jobDescJSON, err := config.ParseJobDescriptor(jobDesc, jobDescFormat)
+		jobDescJSON, err := readJobDescriptor(flagSet.Arg(1))
 		if err != nil {
-			return fmt.Errorf("failed to parse job descriptor: %w", err)
-		}
-
-		// Add the version field if it does not exist
-		jobDescJSON, err = addVersion(jobDescJSON)
-		if err != nil {
-			return fmt.Errorf("failed to add version to descriptor: %w", err)
+			return err
 		}
 
 		startResp, err := transport.Start(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, string(jobDescJSON))
@@ -94,6 +73,15 @@ func run(requestor string, transport transport.Transport, stdout io.Writer) erro
 				return err
 			}
 		}
+	case "validate":
+		jobDescJSON, err := readJobDescriptor(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		resp, err = transport.Validate(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, string(jobDescJSON))
+		if err != nil {
+			return err
+		}
 	case "stop":
 		jobID, err := parseJob(flagSet.Arg(1))
 		if err != nil {
@@ -103,6 +91,24 @@ func run(requestor string, transport transport.Transport, stdout io.Writer) erro
 		if err != nil {
 			return err
 		}
+	case "pause":
+		jobID, err := parseJob(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		resp, err = transport.Pause(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID)
+		if err != nil {
+			return err
+		}
+	case "resume":
+		jobID, err := parseJob(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		resp, err = transport.Resume(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID)
+		if err != nil {
+			return err
+		}
 	case "status":
 		jobID, err := parseJob(flagSet.Arg(1))
 		if err != nil {
@@ -117,20 +123,179 @@ func run(requestor string, transport transport.Transport, stdout io.Writer) erro
 		if err != nil {
 			return err
 		}
-		resp, err = transport.Retry(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID)
+		opts := api.RetryOptions{
+			OnlyFailedTests:   *flagOnlyFailedTests,
+			OnlyFailedTargets: *flagOnlyFailedTargets,
+		}
+		resp, err = transport.Retry(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID, opts)
 		if err != nil {
 			return err
 		}
 	case "list":
-		var states []job.State
-		for _, sts := range *flagStates {
-			st, err := job.EventNameToJobState(event.Name(sts))
-			if err != nil {
-				return err
-			}
-			states = append(states, st)
+		jobQuery, err := listQuery()
+		if err != nil {
+			return err
+		}
+		resp, err = transport.List(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobQuery)
+		if err != nil {
+			return err
+		}
+	case "schedule":
+		cronExpr := flagSet.Arg(1)
+		if cronExpr == "" {
+			return errors.New("missing cron expression")
+		}
+		jobDescJSON, err := readJobDescriptor(flagSet.Arg(2))
+		if err != nil {
+			return err
+		}
+		resp, err = transport.Schedule(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, string(jobDescJSON), cronExpr, *flagSkipIfRunning)
+		if err != nil {
+			return err
+		}
+	case "unschedule":
+		scheduleID, err := parseSchedule(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		resp, err = transport.Unschedule(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, scheduleID)
+		if err != nil {
+			return err
+		}
+	case "listschedules":
+		resp, err = transport.ListSchedules(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor)
+		if err != nil {
+			return err
+		}
+	case "junit":
+		jobID, err := parseJob(flagSet.Arg(1))
+		if err != nil {
+			return err
 		}
-		resp, err = transport.List(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, states, *flagTags)
+		junitResp, err := transport.JUnit(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID)
+		if err != nil {
+			return err
+		}
+		if junitResp.Err == nil {
+			// Print the document as is, so that it can be redirected to a file.
+			_, err := fmt.Fprint(stdout, junitResp.Data.XML)
+			return err
+		}
+		resp = junitResp
+	case "artifact":
+		jobID, err := parseJob(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		artifactID, err := strconv.ParseUint(flagSet.Arg(2), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid artifact ID %q: %w", flagSet.Arg(2), err)
+		}
+		artifactResp, err := transport.Artifact(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID, artifactID)
+		if err != nil {
+			return err
+		}
+		if artifactResp.Err == nil {
+			// Print the content as is, so that it can be redirected to a file.
+			_, err := stdout.Write(artifactResp.Data.Content)
+			return err
+		}
+		resp = artifactResp
+	case "logs":
+		jobID, err := parseJob(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		// Log lines are printed as they are received, there is no final
+		// response.
+		return logs(ctx, jobID, requestor, transport, stdout)
+	case "tail":
+		jobID, err := parseJob(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		// Events are printed as they arrive, there is no final response.
+		return tail(ctx, jobID, requestor, transport, stdout)
+	case "events":
+		jobID, err := parseJob(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		testQuery, frameworkQuery, err := eventQueries(jobID)
+		if err != nil {
+			return err
+		}
+		resp, err = transport.Events(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, testQuery, frameworkQuery)
+		if err != nil {
+			return err
+		}
+	case "addtarget":
+		t, err := readTarget(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		resp, err = transport.AddTarget(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, *t)
+		if err != nil {
+			return err
+		}
+	case "updatetarget":
+		t, err := readTarget(flagSet.Arg(1))
+		if err != nil {
+			return err
+		}
+		resp, err = transport.UpdateTarget(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, *t)
+		if err != nil {
+			return err
+		}
+	case "removetarget":
+		targetID := flagSet.Arg(1)
+		if targetID == "" {
+			return errors.New("missing target ID")
+		}
+		resp, err = transport.RemoveTarget(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, targetID)
+		if err != nil {
+			return err
+		}
+	case "listtargets":
+		resp, err = transport.ListTargets(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, *flagSelector)
+		if err != nil {
+			return err
+		}
+	case "quarantine", "release":
+		targetID := flagSet.Arg(1)
+		if targetID == "" {
+			return errors.New("missing target ID")
+		}
+		if *flagReason == "" {
+			return errors.New("missing reason, set it with --reason")
+		}
+		if verb == "quarantine" {
+			resp, err = transport.QuarantineTarget(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, targetID, *flagReason)
+		} else {
+			resp, err = transport.ReleaseTarget(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, targetID, *flagReason)
+		}
+		if err != nil {
+			return err
+		}
+	case "quarantined":
+		resp, err = transport.ListQuarantinedTargets(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor)
+		if err != nil {
+			return err
+		}
+	case "locks":
+		resp, err = transport.ListLocks(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, flagSet.Args()[1:])
+		if err != nil {
+			return err
+		}
+	case "forceunlock":
+		targetIDs := flagSet.Args()[1:]
+		if len(targetIDs) == 0 {
+			return errors.New("missing target ID")
+		}
+		if *flagReason == "" {
+			return errors.New("missing reason, set it with --reason")
+		}
+		resp, err = transport.ForceUnlock(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, targetIDs, *flagReason)
 		if err != nil {
 			return err
 		}
@@ -177,6 +342,178 @@ func wait(ctx context.Context, jobID types.JobID, jobWaitPoll time.Duration, req
 	}
 }
 
+// tailWait is how long each tail request waits for new events.
+const tailWait = 5 * time.Second
+
+func tail(ctx context.Context, jobID types.JobID, requestor string, transport transport.Transport, stdout io.Writer) error {
+	filter := stream.Filter{
+		RunID:         types.RunID(*flagRunID),
+		TestName:      *flagTestName,
+		TestStepLabel: *flagStepLabel,
+		TargetID:      *flagTargetID,
+	}
+	for _, name := range *flagEventNames {
+		filter.EventNames = append(filter.EventNames, event.Name(name))
+	}
+	cursor := stream.Cursor{
+		TestEventSequenceID:      *flagAfterTestEvent,
+		FrameworkEventSequenceID: *flagAfterFrameworkEvent,
+	}
+	encoder := json.NewEncoder(stdout)
+	encoder.SetEscapeHTML(false)
+	for {
+		resp, err := transport.Tail(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID, filter, cursor, tailWait)
+		if err != nil {
+			return err
+		}
+		if resp.Err != nil {
+			return fmt.Errorf("server responded with an error: %s", resp.Err)
+		}
+		for _, ev := range resp.Data.Events {
+			if err := encoder.Encode(ev); err != nil {
+				return err
+			}
+		}
+		if resp.Data.Done {
+			return nil
+		}
+		cursor = resp.Data.Cursor
+	}
+}
+
+// logsPoll is how long the logs command waits before asking again for the
+// log lines of a running job it follows.
+const logsPoll = 2 * time.Second
+
+func logs(ctx context.Context, jobID types.JobID, requestor string, transport transport.Transport, stdout io.Writer) error {
+	afterSequenceID := *flagAfterLog
+	for {
+		resp, err := transport.Logs(xcontext.NewContext(ctx, "", nil, nil, nil, nil, nil), requestor, jobID, afterSequenceID, 0)
+		if err != nil {
+			return err
+		}
+		if resp.Err != nil {
+			return fmt.Errorf("server responded with an error: %s", resp.Err)
+		}
+		for _, entry := range resp.Data.Entries {
+			if _, err := fmt.Fprintf(stdout, "%s %s %s\n", entry.Time.Format(time.RFC3339Nano), entry.Level, entry.Message); err != nil {
+				return err
+			}
+			afterSequenceID = entry.SequenceID
+		}
+		if resp.Data.Done {
+			return nil
+		}
+		if len(resp.Data.Entries) == 0 {
+			if !*flagFollow {
+				return nil
+			}
+			time.Sleep(logsPoll)
+		}
+	}
+}
+
+// listQuery builds the job query of the list command from the flags.
+func listQuery() (*storage.JobQuery, error) {
+	var fields []storage.JobQueryField
+	if len(*flagStates) > 0 {
+		var states []job.State
+		for _, sts := range *flagStates {
+			st, err := job.EventNameToJobState(event.Name(sts))
+			if err != nil {
+				return nil, err
+			}
+			states = append(states, st)
+		}
+		fields = append(fields, storage.QueryJobStates(states...))
+	}
+	if len(*flagTags) > 0 {
+		fields = append(fields, storage.QueryJobTags(*flagTags...))
+	}
+	if *flagJobRequestor != "" {
+		fields = append(fields, storage.QueryJobRequestor(*flagJobRequestor))
+	}
+	if *flagNamePattern != "" {
+		fields = append(fields, storage.QueryJobNamePattern(*flagNamePattern))
+	}
+	for _, timeFlag := range []struct {
+		name  string
+		value string
+		field func(time.Time) storage.JobQueryField
+	}{
+		{"requested-after", *flagRequestedAfter, storage.QueryJobRequestedAfter},
+		{"requested-before", *flagRequestedBefore, storage.QueryJobRequestedBefore},
+		{"ended-after", *flagEndedAfter, storage.QueryJobEndedAfter},
+		{"ended-before", *flagEndedBefore, storage.QueryJobEndedBefore},
+	} {
+		if timeFlag.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, timeFlag.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s time: %w", timeFlag.name, err)
+		}
+		fields = append(fields, timeFlag.field(t))
+	}
+	if *flagNewestFirst {
+		fields = append(fields, storage.QueryJobsNewestFirst())
+	}
+	if *flagAfterJob != 0 {
+		fields = append(fields, storage.QueryJobAfterJobID(types.JobID(*flagAfterJob)))
+	}
+	if *flagLimit != 0 {
+		fields = append(fields, storage.QueryJobLimit(*flagLimit))
+	}
+	return storage.BuildJobQuery(fields...)
+}
+
+// eventQueries builds the queries of the events command from the flags.
+func eventQueries(jobID types.JobID) (*testevent.Query, *frameworkevent.Query, error) {
+	common := event.Query{JobID: jobID, Limit: *flagLimit}
+	for _, name := range *flagEventNames {
+		common.EventNames = append(common.EventNames, event.Name(name))
+	}
+	if *flagSince != "" {
+		since, err := time.Parse(time.RFC3339Nano, *flagSince)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --since time: %w", err)
+		}
+		common.EmittedStartTime = since
+	}
+	if *flagUntil != "" {
+		until, err := time.Parse(time.RFC3339Nano, *flagUntil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --until time: %w", err)
+		}
+		common.EmittedEndTime = until
+	}
+
+	var (
+		testQuery      *testevent.Query
+		frameworkQuery *frameworkevent.Query
+	)
+	for _, kind := range *flagKinds {
+		switch kind {
+		case "test":
+			testQuery = &testevent.Query{
+				Query:           common,
+				RunID:           types.RunID(*flagRunID),
+				TestName:        *flagTestName,
+				TestStepLabel:   *flagStepLabel,
+				TargetID:        *flagTargetID,
+				PayloadContains: *flagPayloadContains,
+			}
+			testQuery.AfterSequenceID = *flagAfterTestEvent
+		case "framework":
+			frameworkQuery = &frameworkevent.Query{Query: common}
+			frameworkQuery.AfterSequenceID = *flagAfterFrameworkEvent
+		default:
+			return nil, nil, fmt.Errorf("invalid kind of events %q, must be test or framework", kind)
+		}
+	}
+	return testQuery, frameworkQuery, nil
+}
+
 func parseJob(jobIDStr string) (types.JobID, error) {
 	if jobIDStr == "" {
 		return 0, errors.New("missing job ID")
@@ -193,6 +530,80 @@ func parseJob(jobIDStr string) (types.JobID, error) {
 	return jobID, nil
 }
 
+func parseSchedule(scheduleIDStr string) (types.ScheduleID, error) {
+	if scheduleIDStr == "" {
+		return 0, errors.New("missing schedule ID")
+	}
+	scheduleID, err := strconv.ParseUint(scheduleIDStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid schedule ID: %s: %v", scheduleIDStr, err)
+	}
+	if scheduleID == 0 {
+		return 0, fmt.Errorf("Invalid schedule ID: %s: it must be positive", scheduleIDStr)
+	}
+	return types.ScheduleID(scheduleID), nil
+}
+
+// readJobDescriptor reads a job descriptor from the specified file, or from
+// stdin if no file is specified, and returns it as JSON with the version field
+// set.
+func readJobDescriptor(path string) ([]byte, error) {
+	var jobDesc []byte
+	if path == "" {
+		fmt.Fprintf(os.Stderr, "Reading from stdin...\n")
+		jd, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read job descriptor: %w", err)
+		}
+		jobDesc = jd
+	} else {
+		jd, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read job descriptor: %w", err)
+		}
+		jobDesc = jd
+	}
+
+	jobDescFormat := config.JobDescFormatJSON
+	if *flagYAML {
+		jobDescFormat = config.JobDescFormatYAML
+	}
+	jobDescJSON, err := config.ParseJobDescriptor(jobDesc, jobDescFormat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse job descriptor: %w", err)
+	}
+
+	// Add the version field if it does not exist
+	jobDescJSON, err = addVersion(jobDescJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add version to descriptor: %w", err)
+	}
+	return jobDescJSON, nil
+}
+
+// readTarget reads a JSON inventory target from the file at path, or from
+// stdin if path is empty.
+func readTarget(path string) (*inventory.Target, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "" {
+		fmt.Fprintf(os.Stderr, "Reading from stdin...\n")
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read target: %w", err)
+	}
+	var t inventory.Target
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse target: %w", err)
+	}
+	return &t, nil
+}
+
 // addVersion adds the version field to the job descriptor if it does not exist
 func addVersion(jobDescJSON []byte) ([]byte, error) {
 	jobDesc := make(map[string]interface{})
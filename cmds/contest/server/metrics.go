@@ -0,0 +1,44 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/linuxboot/contest/pkg/xcontext"
+	prometheusadapter "github.com/linuxboot/contest/pkg/xcontext/metrics/prometheus"
+)
+
+// serveMetrics serves the metrics recorded through ctx in the Prometheus
+// text format on the /metrics path of addr, until ctx is done.
+func serveMetrics(ctx xcontext.Context, addr string) error {
+	m, ok := ctx.Metrics().(*prometheusadapter.Metrics)
+	if !ok {
+		return fmt.Errorf("metrics of type %T cannot be served", ctx.Metrics())
+	}
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("cannot listen for metrics on %s: %w", addr, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.Gatherer(), promhttp.HandlerOpts{}))
+	srv := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			ctx.Errorf("Metrics server failed: %v", err)
+		}
+	}()
+	return nil
+}
@@ -6,9 +6,13 @@
 package server
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -16,6 +20,7 @@ import (
 	"github.com/benbjohnson/clock"
 
 	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/artifact"
 	"github.com/linuxboot/contest/pkg/config"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/jobmanager"
@@ -39,6 +44,7 @@ import (
 
 	// the targetmanager plugins
 	csvtargetmanager "github.com/linuxboot/contest/plugins/targetmanagers/csvtargetmanager"
+	"github.com/linuxboot/contest/plugins/targetmanagers/inventorytargetmanager"
 	targetlist "github.com/linuxboot/contest/plugins/targetmanagers/targetlist"
 
 	// the testfetcher plugins
@@ -72,8 +78,11 @@ import (
 	sysbench "github.com/linuxboot/contest/plugins/teststeps/sysbench"
 
 	// the reporter plugins
+	junit "github.com/linuxboot/contest/plugins/reporters/junit"
 	noop "github.com/linuxboot/contest/plugins/reporters/noop"
 	targetsuccess "github.com/linuxboot/contest/plugins/reporters/targetsuccess"
+	threshold "github.com/linuxboot/contest/plugins/reporters/threshold"
+	webhook "github.com/linuxboot/contest/plugins/reporters/webhook"
 )
 
 var (
@@ -88,6 +97,22 @@ var (
 	flagPauseTimeout       *time.Duration
 	flagResumeJobs         *bool
 	flagTargetLockDuration *time.Duration
+	flagPausedLockTimeout  *time.Duration
+	flagMaxConcurrentJobs  *uint
+	flagTagConcurrency     *string
+	flagAuthTokens         *string
+	flagTLSCert            *string
+	flagTLSKey             *string
+	flagTLSClientCA        *string
+	flagAdmins             *string
+	flagArtifactDir        *string
+	flagArtifactMaxSize    *int64
+	flagArtifactMaxJobSize *int64
+	flagArtifactMaxRead    *int64
+	flagJobLogLevel        *string
+	flagJobLogMaxEntries   *uint
+	flagMetricsListenAddr  *string
+	flagQuarantineAfter    *uint
 )
 
 func initFlags(cmd string) {
@@ -104,6 +129,81 @@ func initFlags(cmd string) {
 	flagTargetLockDuration = flagSet.Duration("targetLockDuration", config.DefaultTargetLockDuration,
 		"The amount of time target lock is extended by while the job is running. "+
 			"This is the maximum amount of time a job can stay paused safely.")
+	flagPausedLockTimeout = flagSet.Duration("pausedLockTimeout", jobmanager.DefaultPausedLockTimeout, "Maximum amount of time a paused job keeps its targets locked; 0 - until the server exits")
+	flagMaxConcurrentJobs = flagSet.Uint("maxConcurrentJobs", 0, "Maximum number of jobs running at the same time, further jobs are queued; 0 - no limit")
+	flagTagConcurrency = flagSet.String("tagConcurrency", "", "Comma-separated list of tag=N limits on the number of running jobs carrying a tag, e.g. \"slow=1,lab=4\"")
+	flagAuthTokens = flagSet.String("authTokens", "", "JSON file mapping API bearer tokens to their requestor; enables authentication")
+	flagTLSCert = flagSet.String("tlsCert", "", "Certificate file to serve the API over TLS")
+	flagTLSKey = flagSet.String("tlsKey", "", "Key file of the TLS certificate")
+	flagTLSClientCA = flagSet.String("tlsClientCA", "", "CA file to verify client certificates with; enables authentication by the common name of the client certificate")
+	flagAdmins = flagSet.String("admins", "", "Comma-separated list of requestors who can stop and retry any job, when authentication is enabled")
+	flagArtifactDir = flagSet.String("artifactDir", "", "Directory to store the artifacts uploaded by the test steps in; empty - do not store artifacts")
+	flagArtifactMaxSize = flagSet.Int64("artifactMaxSize", 64<<20, "Maximum size of an artifact in bytes; 0 - no limit")
+	flagArtifactMaxJobSize = flagSet.Int64("artifactMaxJobSize", 1<<30, "Maximum size of the artifacts of a job in bytes; 0 - no limit")
+	flagArtifactMaxRead = flagSet.Int64("artifactMaxDownloadSize", jobmanager.DefaultArtifactMaxDownloadSize, "Maximum size in bytes of an artifact downloaded through the API, which holds it in memory; 0 - no limit")
+	flagJobLogLevel = flagSet.String("jobLogLevel", "info", "Log level of the lines captured for each job and returned by the logs API, possible values: debug, info, warning, error, panic, fatal; empty - do not capture job logs")
+	flagJobLogMaxEntries = flagSet.Uint("jobLogMaxEntries", 10000, "Maximum number of log lines captured for each job; 0 - no limit")
+	flagMetricsListenAddr = flagSet.String("metricsListenAddr", "", "Listen address and port to serve the Prometheus metrics on, under /metrics; empty - do not serve metrics")
+	flagQuarantineAfter = flagSet.Uint("quarantineAfter", 3, "Number of consecutive infrastructure failures after which a target is quarantined; 0 - never quarantine targets automatically")
+}
+
+// apiAuthOptions returns the API options for authentication and
+// authorization, and the TLS configuration of the listener, according to the
+// flags.
+func apiAuthOptions() ([]api.Option, *tls.Config, error) {
+	var (
+		authenticators api.Authenticators
+		tlsConfig      *tls.Config
+	)
+	if *flagAuthTokens != "" {
+		tokens, err := api.LoadStaticTokens(*flagAuthTokens)
+		if err != nil {
+			return nil, nil, err
+		}
+		authenticators = append(authenticators, tokens)
+	}
+	if *flagTLSCert != "" || *flagTLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(*flagTLSCert, *flagTLSKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot load TLS certificate: %w", err)
+		}
+		tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+	if *flagTLSClientCA != "" {
+		if tlsConfig == nil {
+			return nil, nil, fmt.Errorf("client certificates require a TLS certificate for the server")
+		}
+		caPEM, err := os.ReadFile(*flagTLSClientCA)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot read client CA: %w", err)
+		}
+		tlsConfig.ClientCAs = x509.NewCertPool()
+		if !tlsConfig.ClientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, nil, fmt.Errorf("no certificates found in client CA file %s", *flagTLSClientCA)
+		}
+		// Clients can authenticate with a token instead of a certificate.
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		if len(authenticators) == 0 {
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		authenticators = append(authenticators, api.ClientCertificates{})
+	}
+	if len(authenticators) == 0 {
+		if *flagAdmins != "" {
+			return nil, nil, fmt.Errorf("admins require authentication to be enabled")
+		}
+		return nil, tlsConfig, nil
+	}
+	var policy api.OwnerOrAdmin
+	if *flagAdmins != "" {
+		for _, admin := range strings.Split(*flagAdmins, ",") {
+			policy.Admins = append(policy.Admins, api.EventRequestor(admin))
+		}
+	}
+	return []api.Option{
+		api.OptionAuthenticator{Authenticator: authenticators},
+		api.OptionAuthorizer{Authorizer: policy},
+	}, tlsConfig, nil
 }
 
 var userFunctions = []map[string]interface{}{
@@ -126,6 +226,7 @@ func GetPluginConfig() *PluginConfig {
 	var pc PluginConfig
 	pc.TargetManagerLoaders = append(pc.TargetManagerLoaders, csvtargetmanager.Load)
 	pc.TargetManagerLoaders = append(pc.TargetManagerLoaders, targetlist.Load)
+	pc.TargetManagerLoaders = append(pc.TargetManagerLoaders, inventorytargetmanager.Load)
 
 	pc.TestFetcherLoaders = append(pc.TestFetcherLoaders, literal.Load)
 	pc.TestFetcherLoaders = append(pc.TestFetcherLoaders, uri.Load)
@@ -157,6 +258,9 @@ func GetPluginConfig() *PluginConfig {
 	pc.TestStepLoaders = append(pc.TestStepLoaders, qemu.Load)
 
 	pc.ReporterLoaders = append(pc.ReporterLoaders, targetsuccess.Load)
+	pc.ReporterLoaders = append(pc.ReporterLoaders, junit.Load)
+	pc.ReporterLoaders = append(pc.ReporterLoaders, threshold.Load)
+	pc.ReporterLoaders = append(pc.ReporterLoaders, webhook.Load)
 
 	return &pc
 }
@@ -230,6 +334,13 @@ func Main(cmd string, args []string, sigs <-chan os.Signal) error {
 	log := ctx.Logger()
 	defer cancel()
 
+	if *flagMetricsListenAddr != "" {
+		if err := serveMetrics(ctx, *flagMetricsListenAddr); err != nil {
+			return err
+		}
+		log.Infof("Serving metrics on %s/metrics", *flagMetricsListenAddr)
+	}
+
 	// Let's store storage engine in context
 	storageEngineVault := storage.NewSimpleEngineVault()
 
@@ -253,7 +364,7 @@ func Main(cmd string, args []string, sigs <-chan os.Signal) error {
 	if *flagDBURI != "" {
 		primaryDBURI := *flagDBURI
 		log.Infof("Using database URI for primary storage: %s", primaryDBURI)
-		s, err := rdbms.New(primaryDBURI)
+		s, err := rdbms.New(primaryDBURI, rdbms.Metrics(ctx.Metrics()))
 		if err != nil {
 			log.Fatalf("Could not initialize database: %v", err)
 		}
@@ -330,11 +441,22 @@ func Main(cmd string, args []string, sigs <-chan os.Signal) error {
 	}
 
 	// spawn JobManager
-	listener := grpclistener.New(*flagListenAddr)
+	authOpts, tlsConfig, err := apiAuthOptions()
+	if err != nil {
+		return fmt.Errorf("invalid API authentication settings: %w", err)
+	}
+	var listenerOpts []grpclistener.Option
+	if tlsConfig != nil {
+		listenerOpts = append(listenerOpts, grpclistener.WithTLSConfig(tlsConfig))
+	}
+	listener := grpclistener.New(*flagListenAddr, listenerOpts...)
 
 	opts := []jobmanager.Option{
 		jobmanager.APIOption(api.OptionEventTimeout(*flagProcessTimeout)),
 	}
+	for _, opt := range authOpts {
+		opts = append(opts, jobmanager.APIOption(opt))
+	}
 	if *flagServerID != "" {
 		opts = append(opts, jobmanager.APIOption(api.OptionServerID(*flagServerID)))
 	}
@@ -344,6 +466,42 @@ func Main(cmd string, args []string, sigs <-chan os.Signal) error {
 	if *flagTargetLockDuration != 0 {
 		opts = append(opts, jobmanager.OptionTargetLockDuration(*flagTargetLockDuration))
 	}
+	opts = append(opts, jobmanager.OptionPausedLockTimeout(*flagPausedLockTimeout))
+	if *flagArtifactDir != "" {
+		store, err := artifact.NewFilesystemStore(*flagArtifactDir, *flagArtifactMaxSize, *flagArtifactMaxJobSize)
+		if err != nil {
+			return fmt.Errorf("could not create artifact store: %w", err)
+		}
+		opts = append(opts, jobmanager.OptionArtifactStore(store), jobmanager.OptionArtifactMaxDownloadSize(*flagArtifactMaxRead))
+	}
+	if *flagJobLogLevel != "" {
+		jobLogLevel, err := logger.ParseLogLevel(*flagJobLogLevel)
+		if err != nil {
+			return fmt.Errorf("invalid job log level: %w", err)
+		}
+		opts = append(opts, jobmanager.OptionJobLogs(jobLogLevel, *flagJobLogMaxEntries))
+	}
+
+	if *flagQuarantineAfter != 0 {
+		opts = append(opts, jobmanager.OptionQuarantineThreshold(*flagQuarantineAfter))
+	}
+
+	if *flagMaxConcurrentJobs != 0 {
+		opts = append(opts, jobmanager.OptionMaxConcurrentJobs(*flagMaxConcurrentJobs))
+	}
+	if *flagTagConcurrency != "" {
+		for _, limit := range strings.Split(*flagTagConcurrency, ",") {
+			parts := strings.SplitN(limit, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid tag concurrency limit %q, expected tag=N", limit)
+			}
+			max, err := strconv.ParseUint(parts[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid tag concurrency limit %q: %w", limit, err)
+			}
+			opts = append(opts, jobmanager.OptionTagConcurrencyLimit{Tag: parts[0], Max: uint(max)})
+		}
+	}
 
 	jm, err := jobmanager.New(listener, pluginRegistry, storageEngineVault, opts...)
 	if err != nil {
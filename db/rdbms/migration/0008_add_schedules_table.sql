@@ -0,0 +1,24 @@
+-- Copyright (c) Facebook, Inc. and its affiliates.
+--
+-- This source code is licensed under the MIT license found in the
+-- LICENSE file in the root directory of this source tree.
+
+-- +goose Up
+
+CREATE TABLE schedules (
+	schedule_id BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	cron_expression VARCHAR(64) NOT NULL,
+	descriptor TEXT NOT NULL,
+	requestor VARCHAR(32) NOT NULL,
+	server_id VARCHAR(64) NOT NULL,
+	skip_if_running TINYINT(1) NOT NULL DEFAULT 0,
+	create_time TIMESTAMP NOT NULL,
+	last_job_id BIGINT(20) UNSIGNED NOT NULL DEFAULT 0,
+	last_run_time TIMESTAMP NULL,
+	PRIMARY KEY (schedule_id),
+	KEY (server_id)
+);
+
+-- +goose Down
+
+DROP TABLE schedules;
@@ -0,0 +1,61 @@
+-- Copyright (c) Facebook, Inc. and its affiliates.
+--
+-- This source code is licensed under the MIT license found in the
+-- LICENSE file in the root directory of this source tree.
+
+-- +goose Up
+
+ALTER TABLE jobs ADD COLUMN start_time TIMESTAMP NULL DEFAULT NULL AFTER request_time;
+ALTER TABLE jobs ADD COLUMN end_time TIMESTAMP NULL DEFAULT NULL AFTER start_time;
+CREATE INDEX job_request_time ON jobs (request_time);
+CREATE INDEX job_end_time ON jobs (end_time);
+
+-- Populate the columns.
+
+SET SQL_BIG_SELECTS=1;
+
+UPDATE
+  jobs
+INNER JOIN
+  ( -- This query finds the time of the last start event for each job.
+    SELECT
+      job_id,
+      MAX(emit_time) AS start_time
+    FROM
+      framework_events
+    WHERE
+      event_name = "JobStateStarted"
+    GROUP BY
+      job_id
+  ) fe
+ON
+  jobs.job_id = fe.job_id
+SET
+  jobs.start_time = fe.start_time;
+
+UPDATE
+  jobs
+INNER JOIN
+  ( -- This query finds the time of the completion event for each job.
+    SELECT
+      job_id,
+      MAX(emit_time) AS end_time
+    FROM
+      framework_events
+    WHERE
+      event_name IN ("JobStateCompleted", "JobStateFailed",
+                     "JobStateCancelled", "JobStateCancellationFailed")
+    GROUP BY
+      job_id
+  ) fe
+ON
+  jobs.job_id = fe.job_id
+SET
+  jobs.end_time = fe.end_time;
+
+-- +goose Down
+
+DROP INDEX job_end_time ON jobs;
+DROP INDEX job_request_time ON jobs;
+ALTER TABLE jobs DROP COLUMN end_time;
+ALTER TABLE jobs DROP COLUMN start_time;
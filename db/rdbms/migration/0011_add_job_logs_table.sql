@@ -0,0 +1,20 @@
+-- Copyright (c) Facebook, Inc. and its affiliates.
+--
+-- This source code is licensed under the MIT license found in the
+-- LICENSE file in the root directory of this source tree.
+
+-- +goose Up
+
+CREATE TABLE job_logs (
+	sequence_id BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	job_id BIGINT(20) UNSIGNED NOT NULL,
+	log_time TIMESTAMP(3) NOT NULL,
+	level VARCHAR(16) NOT NULL,
+	message TEXT NOT NULL,
+	PRIMARY KEY (sequence_id),
+	KEY (job_id, sequence_id)
+);
+
+-- +goose Down
+
+DROP TABLE job_logs;
@@ -0,0 +1,22 @@
+-- Copyright (c) Facebook, Inc. and its affiliates.
+--
+-- This source code is licensed under the MIT license found in the
+-- LICENSE file in the root directory of this source tree.
+
+-- +goose Up
+
+CREATE TABLE inventory_targets (
+	target_id VARCHAR(64) NOT NULL,
+	fqdn VARCHAR(255) NOT NULL DEFAULT '',
+	primary_ipv4 VARCHAR(15) NOT NULL DEFAULT '',
+	primary_ipv6 VARCHAR(45) NOT NULL DEFAULT '',
+	labels TEXT NOT NULL,
+	attributes TEXT NOT NULL,
+	create_time TIMESTAMP(3) NOT NULL,
+	update_time TIMESTAMP(3) NOT NULL,
+	PRIMARY KEY (target_id)
+);
+
+-- +goose Down
+
+DROP TABLE inventory_targets;
@@ -0,0 +1,36 @@
+-- Copyright (c) Facebook, Inc. and its affiliates.
+--
+-- This source code is licensed under the MIT license found in the
+-- LICENSE file in the root directory of this source tree.
+
+-- +goose Up
+
+CREATE TABLE target_outcomes (
+	outcome_id BIGINT(20) UNSIGNED NOT NULL AUTO_INCREMENT,
+	target_id VARCHAR(64) NOT NULL,
+	job_id BIGINT(20) NOT NULL,
+	run_id BIGINT(20) NOT NULL,
+	test_name VARCHAR(32) NULL,
+	test_step_label VARCHAR(32) NULL,
+	outcome_time TIMESTAMP(3) NOT NULL,
+	error TEXT NOT NULL,
+	infrastructure BOOLEAN NOT NULL DEFAULT FALSE,
+	PRIMARY KEY (outcome_id),
+	INDEX target_id_idx (target_id, outcome_id)
+);
+
+CREATE TABLE target_quarantines (
+	target_id VARCHAR(64) NOT NULL,
+	reason TEXT NOT NULL,
+	requestor VARCHAR(32) NOT NULL DEFAULT '',
+	quarantine_time TIMESTAMP(3) NOT NULL,
+	release_reason TEXT NULL,
+	release_requestor VARCHAR(32) NULL,
+	release_time TIMESTAMP(3) NULL,
+	PRIMARY KEY (target_id)
+);
+
+-- +goose Down
+
+DROP TABLE target_outcomes;
+DROP TABLE target_quarantines;
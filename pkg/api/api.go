@@ -9,12 +9,19 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/storage/limits"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/metrics/perf"
 )
 
 // CurrentAPIVersion is the current version of the API that the clients must be
@@ -114,10 +121,17 @@ func (a *API) SendEvent(ev *Event, timeout *time.Duration) error {
 	if err := limits.NewValidator().ValidateRequestorName(string(ev.Msg.Requestor())); err != nil {
 		return err
 	}
+	if ev.Context != nil {
+		if metrics := ev.Context.Metrics(); metrics != nil {
+			method := strings.TrimPrefix(ev.Type.String(), "event_type_")
+			metrics.WithTags(nil).WithTag(perf.TAG_API_METHOD, method).Count(perf.API_REQUESTS).Add(1)
+		}
+	}
 	to := a.Config.EventTimeout
 	if timeout != nil {
 		to = *timeout
 	}
+	ev.authorizer = a.Config.Authorizer
 	select {
 	case a.Events <- ev:
 		return nil
@@ -164,6 +178,10 @@ func (a *API) SendReceiveEvent(ev *Event, timeout *time.Duration) (*EventRespons
 // invalid, and if the API version is incompatible.
 func (a *API) Start(ctx xcontext.Context, requestor EventRequestor, jobDescriptor string) (Response, error) {
 	resp := a.newResponse(ResponseTypeStart)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
 	ev := &Event{
 		// To allow jobs to finish we do not allow passing cancel and pause
 		// signals to the job's context (therefore: xcontext.WithResetSignalers).
@@ -187,9 +205,42 @@ func (a *API) Start(ctx xcontext.Context, requestor EventRequestor, jobDescripto
 	return resp, nil
 }
 
+// Validate checks a job descriptor like Start does, without submitting the
+// job. It reports all the problems found in the descriptor.
+func (a *API) Validate(ctx xcontext.Context, requestor EventRequestor, jobDescriptor string) (Response, error) {
+	resp := a.newResponse(ResponseTypeValidate)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "validate"),
+		Type:     EventTypeValidate,
+		ServerID: resp.ServerID,
+		Msg: EventValidateMsg{
+			requestor:     requestor,
+			JobDescriptor: jobDescriptor,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataValidate{
+		Problems: respEv.Problems,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
 // Stop requests a job cancellation by the given job ID.
 func (a *API) Stop(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID) (Response, error) {
 	resp := a.newResponse(ResponseTypeStop)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
 	ev := &Event{
 		Context:  ctx.WithTag("api_method", "stop"),
 		Type:     EventTypeStop,
@@ -213,6 +264,10 @@ func (a *API) Stop(ctx xcontext.Context, requestor EventRequestor, jobID types.J
 //object
 func (a *API) Status(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID) (Response, error) {
 	resp := a.newResponse(ResponseTypeStatus)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
 	ev := &Event{
 		Context:  ctx.WithTag("api_method", "status"),
 		Type:     EventTypeStatus,
@@ -234,17 +289,83 @@ func (a *API) Status(ctx xcontext.Context, requestor EventRequestor, jobID types
 	return resp, nil
 }
 
+// Pause requests to pause a running job by the given job ID. The job keeps
+// its targets locked, and its state is saved so that it can be resumed later.
+// Pausing is asynchronous, the job is paused once its state is JobStatePaused.
+func (a *API) Pause(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID) (Response, error) {
+	resp := a.newResponse(ResponseTypePause)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "pause"),
+		Type:     EventTypePause,
+		ServerID: resp.ServerID,
+		Msg: EventPauseMsg{
+			requestor: requestor,
+			JobID:     jobID,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataPause{}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// Resume resumes a job that was paused by the given job ID, re-acquiring the
+// targets it held when it was paused.
+func (a *API) Resume(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID) (Response, error) {
+	resp := a.newResponse(ResponseTypeResume)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		// The resumed job runs on this context, so it must not be cancelled
+		// when the request is over.
+		Context:  xcontext.WithResetSignalers(ctx).WithTag("api_method", "resume"),
+		Type:     EventTypeResume,
+		ServerID: resp.ServerID,
+		Msg: EventResumeMsg{
+			requestor: requestor,
+			JobID:     jobID,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataResume{}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
 // Retry will retry a job identified by its ID, using the same job
-// description. If the job is still running, an error is returned.
-func (a *API) Retry(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID) (Response, error) {
+// description. The retry is submitted as a new job, optionally restricted to
+// the failed tests and/or targets. If the job is still running, an error is
+// returned.
+func (a *API) Retry(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID, opts RetryOptions) (Response, error) {
 	resp := a.newResponse(ResponseTypeRetry)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
 	ev := &Event{
-		Context:  ctx.WithTag("api_method", "retry"),
+		// As in Start, the new job must not be cancelled when the request
+		// is over.
+		Context:  xcontext.WithResetSignalers(ctx).WithTag("api_method", "retry"),
 		Type:     EventTypeRetry,
 		ServerID: resp.ServerID,
 		Msg: EventRetryMsg{
 			requestor: requestor,
 			JobID:     jobID,
+			Options:   opts,
 		},
 		RespCh: make(chan *EventResponse, 1),
 	}
@@ -254,17 +375,21 @@ func (a *API) Retry(ctx xcontext.Context, requestor EventRequestor, jobID types.
 	}
 	resp.Data = ResponseDataRetry{
 		// this is the job ID of the job to retry, not the new job ID
-		JobID: jobID,
-		// TODO this should set the new Job ID
-		// NewJobID: ...
+		JobID:    jobID,
+		NewJobID: respEv.JobID,
 	}
 	resp.Err = respEv.Err
 	return resp, nil
 }
 
-// List will list jobs matching the specified criteria.
+// List will list jobs matching the specified criteria, returning their
+// summaries.
 func (a *API) List(ctx xcontext.Context, requestor EventRequestor, query *storage.JobQuery) (Response, error) {
 	resp := a.newResponse(ResponseTypeList)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
 	ev := &Event{
 		Context:  ctx.WithTag("api_method", "list"),
 		Type:     EventTypeList,
@@ -279,9 +404,687 @@ func (a *API) List(ctx xcontext.Context, requestor EventRequestor, query *storag
 	if err != nil {
 		return resp, err
 	}
+	jobIDs := make([]types.JobID, 0, len(respEv.Jobs))
+	for _, summary := range respEv.Jobs {
+		jobIDs = append(jobIDs, summary.JobID)
+	}
 	resp.Data = ResponseDataList{
-		JobIDs: respEv.JobIDs,
+		Jobs:   respEv.Jobs,
+		JobIDs: jobIDs,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// Schedule registers a job descriptor to be submitted periodically, as
+// dictated by a cron expression evaluated in UTC. Every time the schedule
+// fires, the descriptor goes through the same path as a Start request. If
+// skipIfRunning is set, the schedule does not submit a new job while the one
+// it submitted last is still queued or running. Schedules are persisted and
+// survive server restarts.
+func (a *API) Schedule(ctx xcontext.Context, requestor EventRequestor, jobDescriptor, cronExpression string, skipIfRunning bool) (Response, error) {
+	resp := a.newResponse(ResponseTypeSchedule)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "schedule"),
+		Type:     EventTypeSchedule,
+		ServerID: resp.ServerID,
+		Msg: EventScheduleMsg{
+			requestor:      requestor,
+			JobDescriptor:  jobDescriptor,
+			CronExpression: cronExpression,
+			SkipIfRunning:  skipIfRunning,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataSchedule{
+		ScheduleID: respEv.ScheduleID,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// Unschedule removes a schedule by its ID. Jobs already submitted by the
+// schedule are not affected.
+func (a *API) Unschedule(ctx xcontext.Context, requestor EventRequestor, scheduleID types.ScheduleID) (Response, error) {
+	resp := a.newResponse(ResponseTypeUnschedule)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "unschedule"),
+		Type:     EventTypeUnschedule,
+		ServerID: resp.ServerID,
+		Msg: EventUnscheduleMsg{
+			requestor:  requestor,
+			ScheduleID: scheduleID,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataUnschedule{}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// ListSchedules lists the schedules owned by this server.
+func (a *API) ListSchedules(ctx xcontext.Context, requestor EventRequestor) (Response, error) {
+	resp := a.newResponse(ResponseTypeListSchedules)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "listschedules"),
+		Type:     EventTypeListSchedules,
+		ServerID: resp.ServerID,
+		Msg: EventListSchedulesMsg{
+			requestor: requestor,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataListSchedules{
+		Schedules: respEv.Schedules,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// JUnit returns the results of a job as a JUnit XML document, as produced by
+// the reporters which render JUnit XML. Final reports are preferred since
+// they cover all the runs; if there are none yet, the run reports are merged.
+func (a *API) JUnit(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID) (Response, error) {
+	resp := a.newResponse(ResponseTypeJUnit)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "junit"),
+		Type:     EventTypeJUnit,
+		ServerID: resp.ServerID,
+		Msg: EventJUnitMsg{
+			requestor: requestor,
+			JobID:     jobID,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataJUnit{
+		JobID: jobID,
+		XML:   respEv.JUnit,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// Artifact returns an artifact uploaded by a test step of a job, along with
+// its content.
+func (a *API) Artifact(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID, artifactID uint64) (Response, error) {
+	resp := a.newResponse(ResponseTypeArtifact)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "artifact"),
+		Type:     EventTypeArtifact,
+		ServerID: resp.ServerID,
+		Msg: EventArtifactMsg{
+			requestor:  requestor,
+			JobID:      jobID,
+			ArtifactID: artifactID,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataArtifact{
+		Artifact: respEv.Artifact,
+		Content:  respEv.ArtifactContent,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// Logs returns the log entries captured while running a job, in the order
+// they were logged. Only the entries stored after afterSequenceID are
+// returned, so that the log of a running job can be followed by passing the
+// SequenceID of the last entry received. Unless a limit is given, up to
+// DefaultLogsLimit entries are returned.
+func (a *API) Logs(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID, afterSequenceID uint64, limit uint) (Response, error) {
+	resp := a.newResponse(ResponseTypeLogs)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if limit == 0 {
+		limit = DefaultLogsLimit
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "logs"),
+		Type:     EventTypeLogs,
+		ServerID: resp.ServerID,
+		Msg: EventLogsMsg{
+			requestor:       requestor,
+			JobID:           jobID,
+			AfterSequenceID: afterSequenceID,
+			Limit:           limit,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataLogs{
+		JobID:   jobID,
+		Entries: respEv.Logs,
+		Done:    respEv.LogsDone,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// DefaultLogsLimit is the number of log entries returned by Logs when the
+// request does not set a limit.
+const DefaultLogsLimit = 1000
+
+// AddTarget adds a target to the inventory of the server. Only admins may
+// modify the inventory when the API has an authorization policy.
+func (a *API) AddTarget(ctx xcontext.Context, requestor EventRequestor, t inventory.Target) (Response, error) {
+	resp := a.newResponse(ResponseTypeAddTarget)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if err := t.Validate(); err != nil {
+		return resp, fmt.Errorf("invalid target: %w", err)
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "addtarget"),
+		Type:     EventTypeAddTarget,
+		ServerID: resp.ServerID,
+		Msg: EventAddTargetMsg{
+			requestor: requestor,
+			Target:    t,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	var data ResponseDataAddTarget
+	if len(respEv.InventoryTargets) > 0 {
+		data.Target = respEv.InventoryTargets[0]
+	}
+	resp.Data = data
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// UpdateTarget replaces a target of the inventory, keeping its creation
+// time. Only admins may modify the inventory when the API has an
+// authorization policy.
+func (a *API) UpdateTarget(ctx xcontext.Context, requestor EventRequestor, t inventory.Target) (Response, error) {
+	resp := a.newResponse(ResponseTypeUpdateTarget)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if err := t.Validate(); err != nil {
+		return resp, fmt.Errorf("invalid target: %w", err)
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "updatetarget"),
+		Type:     EventTypeUpdateTarget,
+		ServerID: resp.ServerID,
+		Msg: EventUpdateTargetMsg{
+			requestor: requestor,
+			Target:    t,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	var data ResponseDataUpdateTarget
+	if len(respEv.InventoryTargets) > 0 {
+		data.Target = respEv.InventoryTargets[0]
+	}
+	resp.Data = data
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// RemoveTarget removes a target from the inventory. Jobs which already
+// acquired it are not affected. Only admins may modify the inventory when
+// the API has an authorization policy.
+func (a *API) RemoveTarget(ctx xcontext.Context, requestor EventRequestor, targetID string) (Response, error) {
+	resp := a.newResponse(ResponseTypeRemoveTarget)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if targetID == "" {
+		return resp, errors.New("target ID cannot be empty")
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "removetarget"),
+		Type:     EventTypeRemoveTarget,
+		ServerID: resp.ServerID,
+		Msg: EventRemoveTargetMsg{
+			requestor: requestor,
+			TargetID:  targetID,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataRemoveTarget{
+		TargetID: targetID,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// ListTargets lists the targets of the inventory matching the selector, see
+// inventory.ParseSelector. The empty selector lists all of them.
+func (a *API) ListTargets(ctx xcontext.Context, requestor EventRequestor, selector string) (Response, error) {
+	resp := a.newResponse(ResponseTypeListTargets)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	s, err := inventory.ParseSelector(selector)
+	if err != nil {
+		return resp, fmt.Errorf("invalid selector: %w", err)
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "listtargets"),
+		Type:     EventTypeListTargets,
+		ServerID: resp.ServerID,
+		Msg: EventListTargetsMsg{
+			requestor: requestor,
+			Selector:  s,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataListTargets{
+		Targets: respEv.InventoryTargets,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// QuarantineTarget quarantines a target, so that it is not handed out to
+// jobs until it is released. Only admins may quarantine targets when the API
+// has an authorization policy.
+func (a *API) QuarantineTarget(ctx xcontext.Context, requestor EventRequestor, targetID, reason string) (Response, error) {
+	resp := a.newResponse(ResponseTypeQuarantineTarget)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if targetID == "" {
+		return resp, errors.New("target ID cannot be empty")
+	}
+	if reason == "" {
+		return resp, errors.New("reason cannot be empty")
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "quarantine"),
+		Type:     EventTypeQuarantineTarget,
+		ServerID: resp.ServerID,
+		Msg: EventQuarantineTargetMsg{
+			requestor: requestor,
+			TargetID:  targetID,
+			Reason:    reason,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	var data ResponseDataQuarantineTarget
+	if len(respEv.Quarantines) > 0 {
+		data.Quarantine = respEv.Quarantines[0]
+	}
+	resp.Data = data
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// ReleaseTarget releases a target from quarantine. Only admins may release
+// targets when the API has an authorization policy.
+func (a *API) ReleaseTarget(ctx xcontext.Context, requestor EventRequestor, targetID, reason string) (Response, error) {
+	resp := a.newResponse(ResponseTypeReleaseTarget)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if targetID == "" {
+		return resp, errors.New("target ID cannot be empty")
+	}
+	if reason == "" {
+		return resp, errors.New("reason cannot be empty")
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "release"),
+		Type:     EventTypeReleaseTarget,
+		ServerID: resp.ServerID,
+		Msg: EventReleaseTargetMsg{
+			requestor: requestor,
+			TargetID:  targetID,
+			Reason:    reason,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	var data ResponseDataReleaseTarget
+	if len(respEv.Quarantines) > 0 {
+		data.Quarantine = respEv.Quarantines[0]
+	}
+	resp.Data = data
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// ListQuarantinedTargets lists the targets in quarantine, along with why and
+// when they were quarantined.
+func (a *API) ListQuarantinedTargets(ctx xcontext.Context, requestor EventRequestor) (Response, error) {
+	resp := a.newResponse(ResponseTypeListQuarantinedTargets)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "quarantined"),
+		Type:     EventTypeListQuarantinedTargets,
+		ServerID: resp.ServerID,
+		Msg: EventListQuarantinedTargetsMsg{
+			requestor: requestor,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataListQuarantinedTargets{
+		Quarantines: respEv.Quarantines,
 	}
 	resp.Err = respEv.Err
 	return resp, nil
 }
+
+// ListLocks lists the locks held on the given targets, or on all the targets
+// if none is given. Only admins may list the locks when the API has an
+// authorization policy.
+func (a *API) ListLocks(ctx xcontext.Context, requestor EventRequestor, targetIDs []string) (Response, error) {
+	resp := a.newResponse(ResponseTypeListLocks)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "locks"),
+		Type:     EventTypeListLocks,
+		ServerID: resp.ServerID,
+		Msg: EventListLocksMsg{
+			requestor: requestor,
+			TargetIDs: targetIDs,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataListLocks{
+		Locks: respEv.Locks,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// ForceUnlock unlocks the given targets whoever holds them, e.g. after the
+// server running the job which locked them crashed. Only admins may force
+// unlock targets when the API has an authorization policy, and every
+// override is recorded as a framework event of the job which held the lock.
+func (a *API) ForceUnlock(ctx xcontext.Context, requestor EventRequestor, targetIDs []string, reason string) (Response, error) {
+	resp := a.newResponse(ResponseTypeForceUnlock)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if len(targetIDs) == 0 {
+		return resp, errors.New("target IDs cannot be empty")
+	}
+	if reason == "" {
+		return resp, errors.New("reason cannot be empty")
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "forceunlock"),
+		Type:     EventTypeForceUnlock,
+		ServerID: resp.ServerID,
+		Msg: EventForceUnlockMsg{
+			requestor: requestor,
+			TargetIDs: targetIDs,
+			Reason:    reason,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataForceUnlock{
+		Locks: respEv.Locks,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// QueryEvents returns the test and framework events of a job matching the
+// queries, which must be restricted to a job ID. A nil query skips that kind
+// of events. Unless a query sets a limit, up to DefaultEventsLimit events of
+// each kind are returned. Events are sorted by SequenceID, so the next page
+// is queried with the SequenceID of the last event returned as
+// AfterSequenceID.
+func (a *API) QueryEvents(ctx xcontext.Context, requestor EventRequestor, testQuery testevent.QueryFields, frameworkQuery frameworkevent.QueryFields) (Response, error) {
+	resp := a.newResponse(ResponseTypeEvents)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	if testQuery != nil {
+		query, err := testQuery.BuildQuery()
+		if err != nil {
+			return resp, fmt.Errorf("invalid test event query: %w", err)
+		}
+		if err := checkEventQuery(&query.Query); err != nil {
+			return resp, fmt.Errorf("invalid test event query: %w", err)
+		}
+		if query.Limit == 0 {
+			testQuery = append(testQuery[:len(testQuery):len(testQuery)], testevent.QueryLimit(DefaultEventsLimit))
+		}
+	}
+	if frameworkQuery != nil {
+		query, err := frameworkQuery.BuildQuery()
+		if err != nil {
+			return resp, fmt.Errorf("invalid framework event query: %w", err)
+		}
+		if err := checkEventQuery(&query.Query); err != nil {
+			return resp, fmt.Errorf("invalid framework event query: %w", err)
+		}
+		if query.Limit == 0 {
+			frameworkQuery = append(frameworkQuery[:len(frameworkQuery):len(frameworkQuery)], frameworkevent.QueryLimit(DefaultEventsLimit))
+		}
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "events"),
+		Type:     EventTypeEvents,
+		ServerID: resp.ServerID,
+		Msg: EventEventsMsg{
+			requestor:      requestor,
+			TestQuery:      testQuery,
+			FrameworkQuery: frameworkQuery,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataEvents{
+		TestEvents:      respEv.TestEvents,
+		FrameworkEvents: respEv.FrameworkEvents,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// DefaultEventsLimit is the number of events of each kind returned by
+// QueryEvents if the query does not set a limit.
+const DefaultEventsLimit = 100
+
+// MaxEventsLimit is the maximum number of events of each kind which can be
+// requested at once from QueryEvents.
+const MaxEventsLimit = 1000
+
+func checkEventQuery(query *event.Query) error {
+	if query.JobID == 0 {
+		return errors.New("a job ID is required")
+	}
+	if query.Limit > MaxEventsLimit {
+		return fmt.Errorf("limit %d exceeds the maximum of %d", query.Limit, MaxEventsLimit)
+	}
+	return nil
+}
+
+// Subscribe streams the events of a job matching the filter, starting after
+// the cursor. The events are sent on the channel of the returned
+// subscription until the job reaches a final state or ctx is done, so ctx
+// must be cancelled once the caller is not interested anymore.
+func (a *API) Subscribe(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID, filter stream.Filter, cursor stream.Cursor) (Response, error) {
+	resp := a.newResponse(ResponseTypeSubscribe)
+	requestor, err := a.Authenticate(ctx, requestor)
+	if err != nil {
+		return resp, err
+	}
+	ev := &Event{
+		Context:  ctx.WithTag("api_method", "subscribe"),
+		Type:     EventTypeSubscribe,
+		ServerID: resp.ServerID,
+		Msg: EventSubscribeMsg{
+			requestor: requestor,
+			JobID:     jobID,
+			Filter:    filter,
+			Cursor:    cursor,
+		},
+		RespCh: make(chan *EventResponse, 1),
+	}
+	respEv, err := a.SendReceiveEvent(ev, nil)
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = ResponseDataSubscribe{
+		JobID:        jobID,
+		Subscription: respEv.Subscription,
+	}
+	resp.Err = respEv.Err
+	return resp, nil
+}
+
+// Tail returns the events of a job matching the filter emitted after the
+// cursor, for clients which cannot hold a subscription open. If there are
+// no such events yet, it waits up to the given duration for new ones. The
+// cursor of the response is to be passed to the next call, until Done.
+func (a *API) Tail(ctx xcontext.Context, requestor EventRequestor, jobID types.JobID, filter stream.Filter, cursor stream.Cursor, wait time.Duration) (Response, error) {
+	resp := a.newResponse(ResponseTypeTail)
+	subCtx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+	subResp, err := a.Subscribe(subCtx, requestor, jobID, filter, cursor)
+	if err != nil {
+		return resp, err
+	}
+	data := ResponseDataTail{JobID: jobID, Cursor: cursor}
+	resp.Data = data
+	if subResp.Err != nil {
+		resp.Err = subResp.Err
+		return resp, nil
+	}
+	sub := subResp.Data.(ResponseDataSubscribe).Subscription
+
+	timeout := time.NewTimer(wait)
+	defer timeout.Stop()
+	received := func(ev stream.Event) {
+		data.Events = append(data.Events, ev)
+		data.Cursor = data.Cursor.Position(ev)
+	}
+	// Wait for the first event, then return what is available right away.
+	select {
+	case ev, ok := <-sub.Events:
+		if !ok {
+			data.Done = sub.Err() == nil
+			resp.Data, resp.Err = data, sub.Err()
+			return resp, nil
+		}
+		received(ev)
+	case <-timeout.C:
+		return resp, nil
+	case <-ctx.Done():
+		return resp, ctx.Err()
+	}
+	for {
+		select {
+		case ev, ok := <-sub.Events:
+			if !ok {
+				data.Done = sub.Err() == nil
+				resp.Data, resp.Err = data, sub.Err()
+				return resp, nil
+			}
+			received(ev)
+		case <-time.After(tailDrainTimeout):
+			resp.Data = data
+			return resp, nil
+		}
+	}
+}
+
+// tailDrainTimeout is how long Tail waits for more events once it received
+// some, so that events emitted together are returned together.
+var tailDrainTimeout = 100 * time.Millisecond
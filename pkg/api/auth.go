@@ -0,0 +1,203 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var (
+	// ErrUnauthenticated is returned when the credentials of a request are
+	// missing or invalid.
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrPermissionDenied is returned when the requestor is not allowed to
+	// perform a request.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Credentials are what a client presented to a listener to prove who it is.
+type Credentials struct {
+	// BearerToken is the token of an "Authorization: Bearer" header.
+	BearerToken string
+	// PeerCertificates is the certificate chain presented by the client on a
+	// TLS connection, already verified by the listener. The first one is the
+	// client certificate.
+	PeerCertificates []*x509.Certificate
+}
+
+type credentialsKey struct{}
+
+// WithCredentials returns a copy of ctx carrying the credentials of a
+// request. Listeners attach them to the context they pass to the API methods,
+// which authenticate the requestor with them.
+func WithCredentials(ctx context.Context, creds Credentials) context.Context {
+	return context.WithValue(ctx, credentialsKey{}, creds)
+}
+
+// CredentialsFromContext returns the credentials attached to ctx by
+// WithCredentials, if any.
+func CredentialsFromContext(ctx context.Context) (Credentials, bool) {
+	creds, ok := ctx.Value(credentialsKey{}).(Credentials)
+	return creds, ok
+}
+
+// HTTPCredentials extracts the credentials of an HTTP request.
+func HTTPCredentials(r *http.Request) Credentials {
+	var creds Credentials
+	if auth := r.Header.Get("Authorization"); len(auth) > len("Bearer ") && strings.EqualFold(auth[:len("Bearer ")], "Bearer ") {
+		creds.BearerToken = strings.TrimSpace(auth[len("Bearer "):])
+	}
+	if r.TLS != nil {
+		creds.PeerCertificates = r.TLS.PeerCertificates
+	}
+	return creds
+}
+
+// CredentialsHandler wraps an HTTP handler so that the context of every
+// request carries its credentials.
+func CredentialsHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(WithCredentials(r.Context(), HTTPCredentials(r))))
+	})
+}
+
+// Authenticator derives the requestor of a request from its credentials.
+type Authenticator interface {
+	// Authenticate returns the requestor the credentials belong to, or an
+	// error wrapping ErrUnauthenticated if they are not valid.
+	Authenticate(creds Credentials) (EventRequestor, error)
+}
+
+// StaticTokens authenticates bearer tokens against a fixed set of tokens,
+// mapped to the requestor they belong to.
+type StaticTokens map[string]EventRequestor
+
+// Authenticate implements Authenticator.
+func (t StaticTokens) Authenticate(creds Credentials) (EventRequestor, error) {
+	if creds.BearerToken == "" {
+		return "", fmt.Errorf("%w: no bearer token", ErrUnauthenticated)
+	}
+	for token, requestor := range t {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(creds.BearerToken)) == 1 {
+			return requestor, nil
+		}
+	}
+	return "", fmt.Errorf("%w: invalid bearer token", ErrUnauthenticated)
+}
+
+// LoadStaticTokens reads the tokens for StaticTokens from a JSON file
+// mapping each token to its requestor.
+func LoadStaticTokens(path string) (StaticTokens, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read tokens file: %w", err)
+	}
+	var tokens StaticTokens
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("cannot parse tokens file %s: %w", path, err)
+	}
+	for token, requestor := range tokens {
+		if token == "" || requestor == "" {
+			return nil, fmt.Errorf("tokens file %s contains an empty token or requestor", path)
+		}
+	}
+	return tokens, nil
+}
+
+// ClientCertificates authenticates TLS client certificates, using the common
+// name of their subject as requestor. The certificates must have been
+// verified by the listener already, i.e. the listener requires and verifies
+// client certificates against a trusted CA.
+type ClientCertificates struct{}
+
+// Authenticate implements Authenticator.
+func (ClientCertificates) Authenticate(creds Credentials) (EventRequestor, error) {
+	if len(creds.PeerCertificates) == 0 {
+		return "", fmt.Errorf("%w: no client certificate", ErrUnauthenticated)
+	}
+	cn := creds.PeerCertificates[0].Subject.CommonName
+	if cn == "" {
+		return "", fmt.Errorf("%w: client certificate has no common name", ErrUnauthenticated)
+	}
+	return EventRequestor(cn), nil
+}
+
+// Authenticators tries each of its authenticators in order, and returns the
+// requestor of the first one accepting the credentials.
+type Authenticators []Authenticator
+
+// Authenticate implements Authenticator.
+func (as Authenticators) Authenticate(creds Credentials) (EventRequestor, error) {
+	errs := make([]string, 0, len(as))
+	for _, a := range as {
+		requestor, err := a.Authenticate(creds)
+		if err == nil {
+			return requestor, nil
+		}
+		errs = append(errs, err.Error())
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnauthenticated, strings.Join(errs, "; "))
+}
+
+// Authorizer decides whether a requestor may perform a request on a job.
+type Authorizer interface {
+	// Authorize returns nil if requestor may perform the request of the given
+	// type on a job requested by owner, or an error wrapping
+	// ErrPermissionDenied otherwise. An empty owner is used by the requests
+	// which are not about a job, e.g. the changes to the target inventory.
+	Authorize(requestor EventRequestor, eventType EventType, owner EventRequestor) error
+}
+
+// OwnerOrAdmin allows to act on a job only to the requestor who requested it
+// and to the admins.
+type OwnerOrAdmin struct {
+	Admins []EventRequestor
+}
+
+// Authorize implements Authorizer.
+func (p OwnerOrAdmin) Authorize(requestor EventRequestor, eventType EventType, owner EventRequestor) error {
+	if owner != "" && requestor == owner {
+		return nil
+	}
+	for _, admin := range p.Admins {
+		if requestor == admin {
+			return nil
+		}
+	}
+	if owner == "" {
+		return fmt.Errorf("%w: only admins can %s", ErrPermissionDenied, strings.TrimPrefix(eventType.String(), "event_type_"))
+	}
+	return fmt.Errorf("%w: %q cannot %s a job requested by %q", ErrPermissionDenied, requestor, strings.TrimPrefix(eventType.String(), "event_type_"), owner)
+}
+
+// Authenticate returns the requestor of a request, derived from the
+// credentials attached to ctx by the listener. The requestor declared by the
+// client can be empty, otherwise it must match the authenticated one. If no
+// Authenticator is configured, the declared requestor is trusted as is.
+// All the API methods authenticate their requestor, listeners only need to
+// call it to reject unauthenticated requests early.
+func (a *API) Authenticate(ctx context.Context, requestor EventRequestor) (EventRequestor, error) {
+	if a.Config.Authenticator == nil {
+		return requestor, nil
+	}
+	creds, _ := CredentialsFromContext(ctx)
+	authenticated, err := a.Config.Authenticator.Authenticate(creds)
+	if err != nil {
+		return "", err
+	}
+	if requestor != "" && requestor != authenticated {
+		return "", fmt.Errorf("%w: authenticated as %q, not as %q", ErrPermissionDenied, authenticated, requestor)
+	}
+	return authenticated, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticate(t *testing.T) {
+	tokens := StaticTokens{"secret": "alice"}
+
+	t.Run("noAuthenticator", func(t *testing.T) {
+		a, err := New(OptionServerID("unit-test"))
+		require.NoError(t, err)
+		requestor, err := a.Authenticate(context.Background(), "anybody")
+		require.NoError(t, err)
+		require.Equal(t, EventRequestor("anybody"), requestor)
+	})
+
+	a, err := New(OptionServerID("unit-test"), OptionAuthenticator{Authenticator: tokens})
+	require.NoError(t, err)
+	withToken := func(token string) context.Context {
+		return WithCredentials(context.Background(), Credentials{BearerToken: token})
+	}
+
+	t.Run("derived", func(t *testing.T) {
+		requestor, err := a.Authenticate(withToken("secret"), "")
+		require.NoError(t, err)
+		require.Equal(t, EventRequestor("alice"), requestor)
+	})
+	t.Run("declared", func(t *testing.T) {
+		requestor, err := a.Authenticate(withToken("secret"), "alice")
+		require.NoError(t, err)
+		require.Equal(t, EventRequestor("alice"), requestor)
+	})
+	t.Run("impersonation", func(t *testing.T) {
+		_, err := a.Authenticate(withToken("secret"), "bob")
+		require.ErrorIs(t, err, ErrPermissionDenied)
+	})
+	t.Run("invalidToken", func(t *testing.T) {
+		_, err := a.Authenticate(withToken("wrong"), "alice")
+		require.ErrorIs(t, err, ErrUnauthenticated)
+	})
+	t.Run("noCredentials", func(t *testing.T) {
+		_, err := a.Authenticate(context.Background(), "alice")
+		require.ErrorIs(t, err, ErrUnauthenticated)
+	})
+	t.Run("apiMethod", func(t *testing.T) {
+		_, err := a.Stop(ctx, "alice", 1)
+		require.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestAuthenticators(t *testing.T) {
+	authenticators := Authenticators{StaticTokens{"secret": "alice"}, ClientCertificates{}}
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "bob"}}
+
+	r := httptest.NewRequest("POST", "/stop", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	requestor, err := authenticators.Authenticate(HTTPCredentials(r))
+	require.NoError(t, err)
+	require.Equal(t, EventRequestor("alice"), requestor)
+
+	r = httptest.NewRequest("POST", "/stop", nil)
+	r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+	requestor, err = authenticators.Authenticate(HTTPCredentials(r))
+	require.NoError(t, err)
+	require.Equal(t, EventRequestor("bob"), requestor)
+
+	_, err = authenticators.Authenticate(Credentials{})
+	require.ErrorIs(t, err, ErrUnauthenticated)
+	_, err = ClientCertificates{}.Authenticate(Credentials{PeerCertificates: []*x509.Certificate{{}}})
+	require.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+func TestOwnerOrAdmin(t *testing.T) {
+	policy := OwnerOrAdmin{Admins: []EventRequestor{"root"}}
+	require.NoError(t, policy.Authorize("alice", EventTypeStop, "alice"))
+	require.NoError(t, policy.Authorize("root", EventTypeStop, "alice"))
+	require.ErrorIs(t, policy.Authorize("bob", EventTypeRetry, "alice"), ErrPermissionDenied)
+	require.NoError(t, policy.Authorize("root", EventTypeAddTarget, ""))
+	require.ErrorIs(t, policy.Authorize("bob", EventTypeAddTarget, ""), ErrPermissionDenied)
+	require.ErrorIs(t, policy.Authorize("", EventTypeAddTarget, ""), ErrPermissionDenied)
+
+	ev := &Event{Type: EventTypeStop, Msg: EventStopMsg{requestor: "bob"}}
+	require.NoError(t, ev.Authorize("alice"))
+	ev.authorizer = policy
+	require.ErrorIs(t, ev.Authorize("alice"), ErrPermissionDenied)
+}
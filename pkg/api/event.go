@@ -6,8 +6,15 @@
 package api
 
 import (
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
@@ -16,9 +23,10 @@ import (
 type EventType uint16
 
 // EventRequestor identifies who is sending a request. This is set on the client
-// side, but can be validated and overridden in the listener if necessary.
-// This is *not* authentication, it's just the client declaring who they are, and
-// obviously clients can change this field to whatever they want.
+// side and, unless an Authenticator is configured, it is *not* authentication,
+// it's just the client declaring who they are, and obviously clients can
+// change this field to whatever they want. With an Authenticator, the API
+// derives it from the credentials of the request instead.
 type EventRequestor string
 
 func (e EventType) String() string {
@@ -35,6 +43,28 @@ var eventTypeNames = map[EventType]string{
 	EventTypeRetry:  "event_type_retry",
 	EventTypeError:  "event_type_error",
 	EventTypeList:   "event_type_list",
+
+	EventTypeSchedule:      "event_type_schedule",
+	EventTypeUnschedule:    "event_type_unschedule",
+	EventTypeListSchedules: "event_type_list_schedules",
+	EventTypeJUnit:         "event_type_junit",
+	EventTypeSubscribe:     "event_type_subscribe",
+	EventTypeEvents:        "event_type_events",
+	EventTypePause:         "event_type_pause",
+	EventTypeResume:        "event_type_resume",
+	EventTypeValidate:      "event_type_validate",
+	EventTypeArtifact:      "event_type_artifact",
+	EventTypeLogs:          "event_type_logs",
+	EventTypeAddTarget:     "event_type_add_target",
+	EventTypeUpdateTarget:  "event_type_update_target",
+	EventTypeRemoveTarget:  "event_type_remove_target",
+	EventTypeListTargets:   "event_type_list_targets",
+
+	EventTypeQuarantineTarget:       "event_type_quarantine_target",
+	EventTypeReleaseTarget:          "event_type_release_target",
+	EventTypeListQuarantinedTargets: "event_type_list_quarantined_targets",
+	EventTypeListLocks:              "event_type_list_locks",
+	EventTypeForceUnlock:            "event_type_force_unlock",
 }
 
 // list of existing API event types.
@@ -45,6 +75,26 @@ const (
 	EventTypeRetry
 	EventTypeError
 	EventTypeList
+	EventTypeSchedule
+	EventTypeUnschedule
+	EventTypeListSchedules
+	EventTypeJUnit
+	EventTypeSubscribe
+	EventTypeEvents
+	EventTypePause
+	EventTypeResume
+	EventTypeValidate
+	EventTypeArtifact
+	EventTypeLogs
+	EventTypeAddTarget
+	EventTypeUpdateTarget
+	EventTypeRemoveTarget
+	EventTypeListTargets
+	EventTypeQuarantineTarget
+	EventTypeReleaseTarget
+	EventTypeListQuarantinedTargets
+	EventTypeListLocks
+	EventTypeForceUnlock
 )
 
 // Event represents an event that the API can generate. This is used by the API
@@ -59,6 +109,18 @@ type Event struct {
 	// what generated the event. E.g. if a job status is requested, the answer
 	// goes back to the caller in an EventResponse via this channel.
 	RespCh chan *EventResponse
+
+	// authorizer is the authorization policy of the API which sent the event.
+	authorizer Authorizer
+}
+
+// Authorize checks that the requestor of the event may perform it on a job
+// requested by owner, according to the authorization policy of the API.
+func (ev *Event) Authorize(owner EventRequestor) error {
+	if ev.authorizer == nil {
+		return nil
+	}
+	return ev.authorizer.Authorize(ev.Msg.Requestor(), ev.Type, owner)
 }
 
 // EventMsg defines various event messages for different event types.
@@ -79,6 +141,15 @@ type EventStartMsg struct {
 // Requestor returns the requestor of the API call as reported by the client.
 func (e EventStartMsg) Requestor() EventRequestor { return e.requestor }
 
+// EventValidateMsg contains the arguments for an event of type Validate.
+type EventValidateMsg struct {
+	requestor     EventRequestor
+	JobDescriptor string
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventValidateMsg) Requestor() EventRequestor { return e.requestor }
+
 // EventStatusMsg contains the arguments for an event of type Status.
 type EventStatusMsg struct {
 	requestor EventRequestor
@@ -97,10 +168,39 @@ type EventStopMsg struct {
 // Requestor returns the requestor of the API call as reported by the client.
 func (e EventStopMsg) Requestor() EventRequestor { return e.requestor }
 
+// EventPauseMsg contains the arguments for an event of type Pause.
+type EventPauseMsg struct {
+	requestor EventRequestor
+	JobID     types.JobID
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventPauseMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventResumeMsg contains the arguments for an event of type Resume.
+type EventResumeMsg struct {
+	requestor EventRequestor
+	JobID     types.JobID
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventResumeMsg) Requestor() EventRequestor { return e.requestor }
+
 // EventRetryMsg contains the arguments for an event of type Retry.
 type EventRetryMsg struct {
 	requestor EventRequestor
 	JobID     types.JobID
+	Options   RetryOptions
+}
+
+// RetryOptions narrows down what a Retry request submits again.
+type RetryOptions struct {
+	// OnlyFailedTests submits only the tests that had at least one failed
+	// target in the last run of the original job.
+	OnlyFailedTests bool
+	// OnlyFailedTargets restricts every test to the targets that failed it
+	// in the last run of the original job.
+	OnlyFailedTargets bool
 }
 
 // Requestor returns the requestor of the API call as reported by the client.
@@ -112,7 +212,29 @@ type EventResponse struct {
 	JobID     types.JobID
 	Err       error
 	Status    *job.Status
-	JobIDs    []types.JobID
+	Jobs      []job.Summary
+	Problems  []job.DescriptorProblem
+
+	ScheduleID types.ScheduleID
+	Schedules  []*job.Schedule
+	JUnit      string
+
+	Subscription *stream.Subscription
+
+	TestEvents      []testevent.Event
+	FrameworkEvents []frameworkevent.Event
+
+	Artifact        *artifact.Artifact
+	ArtifactContent []byte
+
+	Logs     []job.LogEntry
+	LogsDone bool
+
+	InventoryTargets []inventory.Target
+
+	Quarantines []health.Quarantine
+
+	Locks []target.Lock
 }
 
 // EventListMsg contains the arguments for an event of type List.
@@ -130,3 +252,175 @@ type EventListResponse struct {
 	Jobs      []types.JobID
 	Err       error
 }
+
+// EventScheduleMsg contains the arguments for an event of type Schedule.
+type EventScheduleMsg struct {
+	requestor      EventRequestor
+	JobDescriptor  string
+	CronExpression string
+	SkipIfRunning  bool
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventScheduleMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventUnscheduleMsg contains the arguments for an event of type Unschedule.
+type EventUnscheduleMsg struct {
+	requestor  EventRequestor
+	ScheduleID types.ScheduleID
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventUnscheduleMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventListSchedulesMsg contains the arguments for an event of type
+// ListSchedules.
+type EventListSchedulesMsg struct {
+	requestor EventRequestor
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventListSchedulesMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventJUnitMsg contains the arguments for an event of type JUnit.
+type EventJUnitMsg struct {
+	requestor EventRequestor
+	JobID     types.JobID
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventJUnitMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventArtifactMsg contains the arguments for an event of type Artifact.
+type EventArtifactMsg struct {
+	requestor  EventRequestor
+	JobID      types.JobID
+	ArtifactID uint64
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventArtifactMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventLogsMsg contains the arguments for an event of type Logs.
+type EventLogsMsg struct {
+	requestor       EventRequestor
+	JobID           types.JobID
+	AfterSequenceID uint64
+	Limit           uint
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventLogsMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventAddTargetMsg contains the arguments for an event of type AddTarget.
+type EventAddTargetMsg struct {
+	requestor EventRequestor
+	Target    inventory.Target
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventAddTargetMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventUpdateTargetMsg contains the arguments for an event of type
+// UpdateTarget.
+type EventUpdateTargetMsg struct {
+	requestor EventRequestor
+	Target    inventory.Target
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventUpdateTargetMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventRemoveTargetMsg contains the arguments for an event of type
+// RemoveTarget.
+type EventRemoveTargetMsg struct {
+	requestor EventRequestor
+	TargetID  string
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventRemoveTargetMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventListTargetsMsg contains the arguments for an event of type
+// ListTargets.
+type EventListTargetsMsg struct {
+	requestor EventRequestor
+	Selector  inventory.Selector
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventListTargetsMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventQuarantineTargetMsg contains the arguments for an event of type
+// QuarantineTarget.
+type EventQuarantineTargetMsg struct {
+	requestor EventRequestor
+	TargetID  string
+	Reason    string
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventQuarantineTargetMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventReleaseTargetMsg contains the arguments for an event of type
+// ReleaseTarget.
+type EventReleaseTargetMsg struct {
+	requestor EventRequestor
+	TargetID  string
+	Reason    string
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventReleaseTargetMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventListQuarantinedTargetsMsg contains the arguments for an event of type
+// ListQuarantinedTargets.
+type EventListQuarantinedTargetsMsg struct {
+	requestor EventRequestor
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventListQuarantinedTargetsMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventListLocksMsg contains the arguments for an event of type ListLocks.
+type EventListLocksMsg struct {
+	requestor EventRequestor
+	// TargetIDs restricts the listing to these targets, if not empty.
+	TargetIDs []string
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventListLocksMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventForceUnlockMsg contains the arguments for an event of type
+// ForceUnlock.
+type EventForceUnlockMsg struct {
+	requestor EventRequestor
+	TargetIDs []string
+	Reason    string
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventForceUnlockMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventSubscribeMsg contains the arguments for an event of type Subscribe.
+type EventSubscribeMsg struct {
+	requestor EventRequestor
+	JobID     types.JobID
+	Filter    stream.Filter
+	Cursor    stream.Cursor
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventSubscribeMsg) Requestor() EventRequestor { return e.requestor }
+
+// EventEventsMsg contains the arguments for an event of type Events. A nil
+// query skips that kind of events.
+type EventEventsMsg struct {
+	requestor      EventRequestor
+	TestQuery      testevent.QueryFields
+	FrameworkQuery frameworkevent.QueryFields
+}
+
+// Requestor returns the requestor of the API call as reported by the client.
+func (e EventEventsMsg) Requestor() EventRequestor { return e.requestor }
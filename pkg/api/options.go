@@ -25,6 +25,13 @@ type Config struct {
 
 	// ServerIDFunc defines a custom server ID in API responses.
 	ServerIDFunc ServerIDFunc
+
+	// Authenticator derives the requestor of the requests from their
+	// credentials. If nil, the requestor declared by the client is trusted.
+	Authenticator Authenticator
+
+	// Authorizer decides who may stop or retry a job. If nil, anybody can.
+	Authorizer Authorizer
 }
 
 // OptionEventTimeout defines time duration for API request to be processed.
@@ -47,6 +54,27 @@ func (opt OptionServerID) Apply(config *Config) {
 	}
 }
 
+// OptionAuthenticator defines how the requestor of the requests is
+// authenticated.
+type OptionAuthenticator struct {
+	Authenticator
+}
+
+// Apply implements Option.
+func (opt OptionAuthenticator) Apply(config *Config) {
+	config.Authenticator = opt.Authenticator
+}
+
+// OptionAuthorizer defines who may stop or retry a job.
+type OptionAuthorizer struct {
+	Authorizer
+}
+
+// Apply implements Option.
+func (opt OptionAuthorizer) Apply(config *Config) {
+	config.Authorizer = opt.Authorizer
+}
+
 // getConfig converts a set of Option-s into one structure "Config".
 func getConfig(opts ...Option) Config {
 	result := Config{
@@ -6,7 +6,14 @@
 package api
 
 import (
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/types"
 
 	"github.com/insomniacslk/xjson"
@@ -23,6 +30,27 @@ const (
 	ResponseTypeRetry
 	ResponseTypeVersion
 	ResponseTypeList
+	ResponseTypeSchedule
+	ResponseTypeUnschedule
+	ResponseTypeListSchedules
+	ResponseTypeJUnit
+	ResponseTypeSubscribe
+	ResponseTypeTail
+	ResponseTypeEvents
+	ResponseTypePause
+	ResponseTypeResume
+	ResponseTypeValidate
+	ResponseTypeArtifact
+	ResponseTypeLogs
+	ResponseTypeAddTarget
+	ResponseTypeUpdateTarget
+	ResponseTypeRemoveTarget
+	ResponseTypeListTargets
+	ResponseTypeQuarantineTarget
+	ResponseTypeReleaseTarget
+	ResponseTypeListQuarantinedTargets
+	ResponseTypeListLocks
+	ResponseTypeForceUnlock
 )
 
 // ResponseTypeToName maps response types to their names.
@@ -33,6 +61,29 @@ var ResponseTypeToName = map[ResponseType]string{
 	ResponseTypeRetry:   "ResponseTypeRetry",
 	ResponseTypeVersion: "ResponseTypeVersion",
 	ResponseTypeList:    "ResponseTypeList",
+
+	ResponseTypeSchedule:      "ResponseTypeSchedule",
+	ResponseTypeUnschedule:    "ResponseTypeUnschedule",
+	ResponseTypeListSchedules: "ResponseTypeListSchedules",
+	ResponseTypeJUnit:         "ResponseTypeJUnit",
+	ResponseTypeSubscribe:     "ResponseTypeSubscribe",
+	ResponseTypeTail:          "ResponseTypeTail",
+	ResponseTypeEvents:        "ResponseTypeEvents",
+	ResponseTypePause:         "ResponseTypePause",
+	ResponseTypeResume:        "ResponseTypeResume",
+	ResponseTypeValidate:      "ResponseTypeValidate",
+	ResponseTypeArtifact:      "ResponseTypeArtifact",
+	ResponseTypeLogs:          "ResponseTypeLogs",
+	ResponseTypeAddTarget:     "ResponseTypeAddTarget",
+	ResponseTypeUpdateTarget:  "ResponseTypeUpdateTarget",
+	ResponseTypeRemoveTarget:  "ResponseTypeRemoveTarget",
+	ResponseTypeListTargets:   "ResponseTypeListTargets",
+
+	ResponseTypeQuarantineTarget:       "ResponseTypeQuarantineTarget",
+	ResponseTypeReleaseTarget:          "ResponseTypeReleaseTarget",
+	ResponseTypeListQuarantinedTargets: "ResponseTypeListQuarantinedTargets",
+	ResponseTypeListLocks:              "ResponseTypeListLocks",
+	ResponseTypeForceUnlock:            "ResponseTypeForceUnlock",
 }
 
 // Response is the type returned to any API request.
@@ -58,6 +109,17 @@ func (r ResponseDataStart) Type() ResponseType {
 	return ResponseTypeStart
 }
 
+// ResponseDataValidate is the response type for a Validate request. The job
+// descriptor is valid if there are no problems.
+type ResponseDataValidate struct {
+	Problems []job.DescriptorProblem
+}
+
+// Type returns the response type.
+func (r ResponseDataValidate) Type() ResponseType {
+	return ResponseTypeValidate
+}
+
 // ResponseDataStop is the response type for a Stop request.
 type ResponseDataStop struct {
 }
@@ -67,6 +129,24 @@ func (r ResponseDataStop) Type() ResponseType {
 	return ResponseTypeStop
 }
 
+// ResponseDataPause is the response type for a Pause request.
+type ResponseDataPause struct {
+}
+
+// Type returns the response type.
+func (r ResponseDataPause) Type() ResponseType {
+	return ResponseTypePause
+}
+
+// ResponseDataResume is the response type for a Resume request.
+type ResponseDataResume struct {
+}
+
+// Type returns the response type.
+func (r ResponseDataResume) Type() ResponseType {
+	return ResponseTypeResume
+}
+
 // ResponseDataStatus is the response type for a Status request.
 type ResponseDataStatus struct {
 	Status *job.Status
@@ -90,6 +170,8 @@ func (r ResponseDataRetry) Type() ResponseType {
 
 // ResponseDataList is the response type for a List request.
 type ResponseDataList struct {
+	Jobs []job.Summary
+	// JobIDs are the IDs of Jobs, in the same order.
 	JobIDs []types.JobID
 }
 
@@ -98,6 +180,203 @@ func (r ResponseDataList) Type() ResponseType {
 	return ResponseTypeList
 }
 
+// ResponseDataSchedule is the response type for a Schedule request.
+type ResponseDataSchedule struct {
+	ScheduleID types.ScheduleID
+}
+
+// Type returns the response type.
+func (r ResponseDataSchedule) Type() ResponseType {
+	return ResponseTypeSchedule
+}
+
+// ResponseDataUnschedule is the response type for an Unschedule request.
+type ResponseDataUnschedule struct {
+}
+
+// Type returns the response type.
+func (r ResponseDataUnschedule) Type() ResponseType {
+	return ResponseTypeUnschedule
+}
+
+// ResponseDataListSchedules is the response type for a ListSchedules request.
+type ResponseDataListSchedules struct {
+	Schedules []*job.Schedule
+}
+
+// Type returns the response type.
+func (r ResponseDataListSchedules) Type() ResponseType {
+	return ResponseTypeListSchedules
+}
+
+// ResponseDataJUnit is the response type for a JUnit request.
+type ResponseDataJUnit struct {
+	JobID types.JobID
+	XML   string
+}
+
+// Type returns the response type.
+func (r ResponseDataJUnit) Type() ResponseType {
+	return ResponseTypeJUnit
+}
+
+// ResponseDataArtifact is the response type for an Artifact request.
+type ResponseDataArtifact struct {
+	Artifact *artifact.Artifact
+	Content  []byte
+}
+
+// Type returns the response type.
+func (r ResponseDataArtifact) Type() ResponseType {
+	return ResponseTypeArtifact
+}
+
+// ResponseDataLogs is the response type for a Logs request.
+type ResponseDataLogs struct {
+	JobID   types.JobID
+	Entries []job.LogEntry
+	// Done is set once the job is no longer queued or running on the
+	// server, and all of its log entries have been stored.
+	Done bool
+}
+
+// Type returns the response type.
+func (r ResponseDataLogs) Type() ResponseType {
+	return ResponseTypeLogs
+}
+
+// ResponseDataAddTarget is the response type for an AddTarget request.
+type ResponseDataAddTarget struct {
+	Target inventory.Target
+}
+
+// Type returns the response type.
+func (r ResponseDataAddTarget) Type() ResponseType {
+	return ResponseTypeAddTarget
+}
+
+// ResponseDataUpdateTarget is the response type for an UpdateTarget request.
+type ResponseDataUpdateTarget struct {
+	Target inventory.Target
+}
+
+// Type returns the response type.
+func (r ResponseDataUpdateTarget) Type() ResponseType {
+	return ResponseTypeUpdateTarget
+}
+
+// ResponseDataRemoveTarget is the response type for a RemoveTarget request.
+type ResponseDataRemoveTarget struct {
+	TargetID string
+}
+
+// Type returns the response type.
+func (r ResponseDataRemoveTarget) Type() ResponseType {
+	return ResponseTypeRemoveTarget
+}
+
+// ResponseDataListTargets is the response type for a ListTargets request.
+type ResponseDataListTargets struct {
+	Targets []inventory.Target
+}
+
+// Type returns the response type.
+func (r ResponseDataListTargets) Type() ResponseType {
+	return ResponseTypeListTargets
+}
+
+// ResponseDataQuarantineTarget is the response type for a QuarantineTarget
+// request.
+type ResponseDataQuarantineTarget struct {
+	Quarantine health.Quarantine
+}
+
+// Type returns the response type.
+func (r ResponseDataQuarantineTarget) Type() ResponseType {
+	return ResponseTypeQuarantineTarget
+}
+
+// ResponseDataReleaseTarget is the response type for a ReleaseTarget request.
+type ResponseDataReleaseTarget struct {
+	Quarantine health.Quarantine
+}
+
+// Type returns the response type.
+func (r ResponseDataReleaseTarget) Type() ResponseType {
+	return ResponseTypeReleaseTarget
+}
+
+// ResponseDataListQuarantinedTargets is the response type for a
+// ListQuarantinedTargets request.
+type ResponseDataListQuarantinedTargets struct {
+	Quarantines []health.Quarantine
+}
+
+// Type returns the response type.
+func (r ResponseDataListQuarantinedTargets) Type() ResponseType {
+	return ResponseTypeListQuarantinedTargets
+}
+
+// ResponseDataListLocks is the response type for a ListLocks request.
+type ResponseDataListLocks struct {
+	Locks []target.Lock
+}
+
+// Type returns the response type.
+func (r ResponseDataListLocks) Type() ResponseType {
+	return ResponseTypeListLocks
+}
+
+// ResponseDataForceUnlock is the response type for a ForceUnlock request.
+type ResponseDataForceUnlock struct {
+	// Locks are the locks which were removed.
+	Locks []target.Lock
+}
+
+// Type returns the response type.
+func (r ResponseDataForceUnlock) Type() ResponseType {
+	return ResponseTypeForceUnlock
+}
+
+// ResponseDataSubscribe is the response type for a Subscribe request.
+type ResponseDataSubscribe struct {
+	JobID        types.JobID
+	Subscription *stream.Subscription `json:"-"`
+}
+
+// Type returns the response type.
+func (r ResponseDataSubscribe) Type() ResponseType {
+	return ResponseTypeSubscribe
+}
+
+// ResponseDataTail is the response type for a Tail request.
+type ResponseDataTail struct {
+	JobID  types.JobID
+	Events []stream.Event
+	// Cursor is the position to resume from with the next Tail request.
+	Cursor stream.Cursor
+	// Done is set once the job reached a final state and all of its events
+	// were returned.
+	Done bool
+}
+
+// Type returns the response type.
+func (r ResponseDataTail) Type() ResponseType {
+	return ResponseTypeTail
+}
+
+// ResponseDataEvents is the response type for a QueryEvents request. Both
+// lists are sorted by SequenceID.
+type ResponseDataEvents struct {
+	TestEvents      []testevent.Event
+	FrameworkEvents []frameworkevent.Event
+}
+
+// Type returns the response type.
+func (r ResponseDataEvents) Type() ResponseType {
+	return ResponseTypeEvents
+}
+
 // ResponseDataVersion is the response type for a Version request.
 type ResponseDataVersion struct {
 	Version uint32
@@ -125,6 +404,13 @@ type StartResponse struct {
 	Err      *xjson.Error
 }
 
+// ValidateResponse is a typesafe version of Response with a Validate payload
+type ValidateResponse struct {
+	ServerID string
+	Data     ResponseDataValidate
+	Err      *xjson.Error
+}
+
 // StopResponse is a typesafe version of Response with a Status payload
 type StopResponse struct {
 	ServerID string
@@ -132,6 +418,20 @@ type StopResponse struct {
 	Err      *xjson.Error
 }
 
+// PauseResponse is a typesafe version of Response with a Pause payload
+type PauseResponse struct {
+	ServerID string
+	Data     ResponseDataPause
+	Err      *xjson.Error
+}
+
+// ResumeResponse is a typesafe version of Response with a Resume payload
+type ResumeResponse struct {
+	ServerID string
+	Data     ResponseDataResume
+	Err      *xjson.Error
+}
+
 // RetryResponse is a typesafe version of Response with a Status payload
 type RetryResponse struct {
 	ServerID string
@@ -146,6 +446,134 @@ type ListResponse struct {
 	Err      *xjson.Error
 }
 
+// ScheduleResponse is a typesafe version of Response with a Schedule payload
+type ScheduleResponse struct {
+	ServerID string
+	Data     ResponseDataSchedule
+	Err      *xjson.Error
+}
+
+// UnscheduleResponse is a typesafe version of Response with an Unschedule payload
+type UnscheduleResponse struct {
+	ServerID string
+	Data     ResponseDataUnschedule
+	Err      *xjson.Error
+}
+
+// ListSchedulesResponse is a typesafe version of Response with a ListSchedules payload
+type ListSchedulesResponse struct {
+	ServerID string
+	Data     ResponseDataListSchedules
+	Err      *xjson.Error
+}
+
+// JUnitResponse is a typesafe version of Response with a JUnit payload
+type JUnitResponse struct {
+	ServerID string
+	Data     ResponseDataJUnit
+	Err      *xjson.Error
+}
+
+// ArtifactResponse is a typesafe version of Response with an Artifact payload
+type ArtifactResponse struct {
+	ServerID string
+	Data     ResponseDataArtifact
+	Err      *xjson.Error
+}
+
+// LogsResponse is a typesafe version of Response with a Logs payload
+type LogsResponse struct {
+	ServerID string
+	Data     ResponseDataLogs
+	Err      *xjson.Error
+}
+
+// AddTargetResponse is a typesafe version of Response with an AddTarget
+// payload
+type AddTargetResponse struct {
+	ServerID string
+	Data     ResponseDataAddTarget
+	Err      *xjson.Error
+}
+
+// UpdateTargetResponse is a typesafe version of Response with an
+// UpdateTarget payload
+type UpdateTargetResponse struct {
+	ServerID string
+	Data     ResponseDataUpdateTarget
+	Err      *xjson.Error
+}
+
+// RemoveTargetResponse is a typesafe version of Response with a RemoveTarget
+// payload
+type RemoveTargetResponse struct {
+	ServerID string
+	Data     ResponseDataRemoveTarget
+	Err      *xjson.Error
+}
+
+// ListTargetsResponse is a typesafe version of Response with a ListTargets
+// payload
+type ListTargetsResponse struct {
+	ServerID string
+	Data     ResponseDataListTargets
+	Err      *xjson.Error
+}
+
+// QuarantineTargetResponse is a typesafe version of Response with a
+// QuarantineTarget payload
+type QuarantineTargetResponse struct {
+	ServerID string
+	Data     ResponseDataQuarantineTarget
+	Err      *xjson.Error
+}
+
+// ReleaseTargetResponse is a typesafe version of Response with a
+// ReleaseTarget payload
+type ReleaseTargetResponse struct {
+	ServerID string
+	Data     ResponseDataReleaseTarget
+	Err      *xjson.Error
+}
+
+// ListQuarantinedTargetsResponse is a typesafe version of Response with a
+// ListQuarantinedTargets payload
+type ListQuarantinedTargetsResponse struct {
+	ServerID string
+	Data     ResponseDataListQuarantinedTargets
+	Err      *xjson.Error
+}
+
+// ListLocksResponse is a typesafe version of Response with a ListLocks
+// payload
+type ListLocksResponse struct {
+	ServerID string
+	Data     ResponseDataListLocks
+	Err      *xjson.Error
+}
+
+// ForceUnlockResponse is a typesafe version of Response with a ForceUnlock
+// payload
+type ForceUnlockResponse struct {
+	ServerID string
+	Data     ResponseDataForceUnlock
+	Err      *xjson.Error
+}
+
+// TailResponse is a typesafe version of Response with a Tail payload
+type TailResponse struct {
+	ServerID string
+	Data     ResponseDataTail
+	Err      *xjson.Error
+}
+
+// EventsResponse is a typesafe version of Response with an Events payload
+type EventsResponse struct {
+	ServerID string
+	Data     ResponseDataEvents
+	Err      *xjson.Error
+}
+
 // VersionResponse is a typesafe version of Response with a Status payload
 type VersionResponse struct {
 	ServerID string
@@ -0,0 +1,148 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package artifact stores the files produced by the test steps, like logs or
+// firmware images read from a target, so that they can be downloaded after
+// the job has run.
+package artifact
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// ErrNoStore is returned when uploading an artifact from a context which has
+// no Store, i.e. the server was started without an artifact store.
+var ErrNoStore = errors.New("no artifact store configured")
+
+// ErrTooLarge is returned when an artifact exceeds the size limits of the
+// Store.
+var ErrTooLarge = errors.New("artifact too large")
+
+// ErrNotFound is returned when opening an artifact which does not exist.
+var ErrNotFound = errors.New("artifact not found")
+
+// Artifact describes a file uploaded by a test step for a target.
+type Artifact struct {
+	// ID identifies the artifact within its job.
+	ID            uint64
+	JobID         types.JobID
+	RunID         types.RunID
+	TestName      string
+	TestAttempt   uint32
+	TestStepLabel string
+	TargetID      string
+	// Name is the name given to the artifact by the test step, e.g. the name
+	// of the file it was read from.
+	Name string
+	Size int64
+	// SHA256 is the hex encoded hash of the content.
+	SHA256     string
+	CreateTime time.Time
+}
+
+// Store stores the content and the metadata of artifacts.
+type Store interface {
+	// Put stores the content read from r as a new artifact. The ID, size,
+	// hash and creation time of a are filled by the Store.
+	Put(ctx xcontext.Context, a Artifact, r io.Reader) (*Artifact, error)
+	// List returns the artifacts of a job sorted by ID.
+	List(ctx xcontext.Context, jobID types.JobID) ([]Artifact, error)
+	// Open returns an artifact of a job along with its content, which the
+	// caller has to close.
+	Open(ctx xcontext.Context, jobID types.JobID, id uint64) (*Artifact, io.ReadCloser, error)
+}
+
+type key string
+
+const keyScope = key("artifact_scope")
+
+// scope is what the context knows about the artifacts uploaded from it.
+type scope struct {
+	store  Store
+	header Artifact
+}
+
+func scopeFromContext(ctx xcontext.Context) (scope, bool) {
+	s, ok := ctx.Value(keyScope).(scope)
+	return s, ok && s.store != nil
+}
+
+// WithStore returns a context the test steps running in can upload artifacts
+// to store from. A nil store leaves the context unchanged.
+func WithStore(ctx xcontext.Context, store Store) xcontext.Context {
+	if store == nil {
+		return ctx
+	}
+	return xcontext.WithValue(ctx, keyScope, scope{store: store})
+}
+
+// WithTest records in the context the test the artifacts uploaded from it
+// belong to. It is a no-op if the context has no Store.
+func WithTest(ctx xcontext.Context, jobID types.JobID, runID types.RunID, testName string, testAttempt uint32) xcontext.Context {
+	s, ok := scopeFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	s.header.JobID = jobID
+	s.header.RunID = runID
+	s.header.TestName = testName
+	s.header.TestAttempt = testAttempt
+	return xcontext.WithValue(ctx, keyScope, s)
+}
+
+// WithTestStep records in the context the test step the artifacts uploaded
+// from it belong to. It is a no-op if the context has no Store.
+func WithTestStep(ctx xcontext.Context, testStepLabel string) xcontext.Context {
+	s, ok := scopeFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	s.header.TestStepLabel = testStepLabel
+	return xcontext.WithValue(ctx, keyScope, s)
+}
+
+// Upload is the helper test steps use to store the content read from r as an
+// artifact of a target. The job, run, test and step the artifact belongs to
+// are taken from the context which the step is run with. It returns
+// ErrNoStore if the server does not store artifacts.
+func Upload(ctx xcontext.Context, tgt *target.Target, name string, r io.Reader) (*Artifact, error) {
+	s, ok := scopeFromContext(ctx)
+	if !ok {
+		return nil, ErrNoStore
+	}
+	if tgt == nil {
+		return nil, errors.New("artifact target cannot be nil")
+	}
+	if name == "" {
+		return nil, errors.New("artifact name cannot be empty")
+	}
+	a := s.header
+	a.TargetID = tgt.ID
+	a.Name = name
+	uploaded, err := s.store.Put(ctx, a, r)
+	if err != nil {
+		return nil, fmt.Errorf("could not upload artifact %q for target %s: %w", name, tgt.ID, err)
+	}
+	ctx.Debugf("Uploaded artifact %d %q for target %s (%d bytes)", uploaded.ID, name, tgt.ID, uploaded.Size)
+	return uploaded, nil
+}
+
+// UploadFile works like Upload, with the content of a local file.
+func UploadFile(ctx xcontext.Context, tgt *target.Target, name, path string) (*Artifact, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open artifact file: %w", err)
+	}
+	defer f.Close()
+	return Upload(ctx, tgt, name, f)
+}
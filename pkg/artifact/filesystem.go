@@ -0,0 +1,207 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+const (
+	dataSuffix     = ".data"
+	metadataSuffix = ".json"
+)
+
+// FilesystemStore is a Store which keeps the artifacts in a directory, with
+// a subdirectory per job. Each artifact is made of a data file and of a JSON
+// metadata file, named after the ID of the artifact.
+type FilesystemStore struct {
+	dir        string
+	maxSize    int64
+	maxJobSize int64
+
+	// mu serializes the allocation of IDs and the accounting of job sizes.
+	mu sync.Mutex
+}
+
+// NewFilesystemStore returns a FilesystemStore keeping the artifacts in dir,
+// which is created if needed. Artifacts larger than maxSize bytes, or which
+// would take the artifacts of their job over maxJobSize bytes, are rejected.
+// Zero means no limit.
+func NewFilesystemStore(dir string, maxSize, maxJobSize int64) (*FilesystemStore, error) {
+	if maxSize < 0 || maxJobSize < 0 {
+		return nil, errors.New("artifact size limits cannot be negative")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("could not create artifact directory: %w", err)
+	}
+	return &FilesystemStore{dir: dir, maxSize: maxSize, maxJobSize: maxJobSize}, nil
+}
+
+func (s *FilesystemStore) jobDir(jobID types.JobID) string {
+	return filepath.Join(s.dir, jobID.String())
+}
+
+// Put implements Store.Put.
+func (s *FilesystemStore) Put(ctx xcontext.Context, a Artifact, r io.Reader) (*Artifact, error) {
+	dir := s.jobDir(a.JobID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("could not create job artifact directory: %w", err)
+	}
+
+	// Copy the content to a temporary file first, the size and the hash are
+	// only known once it has all been read.
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("could not create artifact file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	src := r
+	if s.maxSize > 0 {
+		src = io.LimitReader(r, s.maxSize+1)
+	}
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not write artifact file: %w", err)
+	}
+	if s.maxSize > 0 && size > s.maxSize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrTooLarge, s.maxSize)
+	}
+	a.Size = size
+	a.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	a.CreateTime = time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, err := s.list(a.JobID)
+	if err != nil {
+		return nil, err
+	}
+	var jobSize int64
+	for _, e := range existing {
+		jobSize += e.Size
+		if e.ID >= a.ID {
+			a.ID = e.ID + 1
+		}
+	}
+	if a.ID == 0 {
+		a.ID = 1
+	}
+	if s.maxJobSize > 0 && jobSize+size > s.maxJobSize {
+		return nil, fmt.Errorf("%w: the artifacts of job %d would exceed %d bytes", ErrTooLarge, a.JobID, s.maxJobSize)
+	}
+	// Link rather than rename, so that an ID is never taken twice, e.g. by
+	// another server sharing the directory.
+	if err := os.Link(tmp.Name(), s.path(a.JobID, a.ID, dataSuffix)); err != nil {
+		return nil, fmt.Errorf("could not store artifact: %w", err)
+	}
+	if err := s.writeMetadata(&a); err != nil {
+		_ = os.Remove(s.path(a.JobID, a.ID, dataSuffix))
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (s *FilesystemStore) path(jobID types.JobID, id uint64, suffix string) string {
+	return filepath.Join(s.jobDir(jobID), strconv.FormatUint(id, 10)+suffix)
+}
+
+// writeMetadata writes the metadata file atomically, an artifact is only
+// listed once its metadata file exists.
+func (s *FilesystemStore) writeMetadata(a *Artifact) error {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("could not encode artifact metadata: %w", err)
+	}
+	tmp := s.path(a.JobID, a.ID, metadataSuffix+".tmp")
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("could not write artifact metadata: %w", err)
+	}
+	if err := os.Rename(tmp, s.path(a.JobID, a.ID, metadataSuffix)); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("could not write artifact metadata: %w", err)
+	}
+	return nil
+}
+
+// List implements Store.List.
+func (s *FilesystemStore) List(ctx xcontext.Context, jobID types.JobID) ([]Artifact, error) {
+	return s.list(jobID)
+}
+
+func (s *FilesystemStore) list(jobID types.JobID) ([]Artifact, error) {
+	entries, err := os.ReadDir(s.jobDir(jobID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not list artifacts of job %d: %w", jobID, err)
+	}
+	var artifacts []Artifact
+	for _, entry := range entries {
+		idStr := strings.TrimSuffix(entry.Name(), metadataSuffix)
+		if idStr == entry.Name() {
+			continue
+		}
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		a, err := s.readMetadata(jobID, id)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, *a)
+	}
+	sort.Slice(artifacts, func(i, j int) bool { return artifacts[i].ID < artifacts[j].ID })
+	return artifacts, nil
+}
+
+func (s *FilesystemStore) readMetadata(jobID types.JobID, id uint64) (*Artifact, error) {
+	data, err := os.ReadFile(s.path(jobID, id, metadataSuffix))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: job %d has no artifact %d", ErrNotFound, jobID, id)
+		}
+		return nil, fmt.Errorf("could not read metadata of artifact %d: %w", id, err)
+	}
+	var a Artifact
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, fmt.Errorf("invalid metadata for artifact %d: %w", id, err)
+	}
+	return &a, nil
+}
+
+// Open implements Store.Open.
+func (s *FilesystemStore) Open(ctx xcontext.Context, jobID types.JobID, id uint64) (*Artifact, io.ReadCloser, error) {
+	a, err := s.readMetadata(jobID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(s.path(jobID, id, dataSuffix))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not open artifact %d: %w", id, err)
+	}
+	return a, f, nil
+}
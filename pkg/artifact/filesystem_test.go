@@ -0,0 +1,97 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package artifact
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+func TestFilesystemStore(t *testing.T) {
+	ctx := xcontext.Background()
+	store, err := NewFilesystemStore(t.TempDir(), 10, 14)
+	require.NoError(t, err)
+
+	a, err := store.Put(ctx, Artifact{JobID: 1, RunID: 2, TargetID: "t1", Name: "log"}, strings.NewReader("hello"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), a.ID)
+	require.Equal(t, int64(5), a.Size)
+	require.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", a.SHA256)
+
+	_, err = store.Put(ctx, Artifact{JobID: 1, Name: "big"}, strings.NewReader("more than ten"))
+	require.True(t, errors.Is(err, ErrTooLarge))
+	_, err = store.Put(ctx, Artifact{JobID: 1, Name: "second"}, strings.NewReader("0123456789"))
+	require.True(t, errors.Is(err, ErrTooLarge), "job limit")
+
+	// Other jobs are not affected by the limit of job 1.
+	_, err = store.Put(ctx, Artifact{JobID: 2, Name: "other"}, strings.NewReader("0123456789"))
+	require.NoError(t, err)
+	b, err := store.Put(ctx, Artifact{JobID: 1, Name: "second"}, strings.NewReader("bye"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), b.ID)
+
+	artifacts, err := store.List(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []Artifact{*a, *b}, stripTimes(artifacts, a, b))
+
+	got, rc, err := store.Open(ctx, 1, 2)
+	require.NoError(t, err)
+	content, err := io.ReadAll(rc)
+	require.NoError(t, rc.Close())
+	require.NoError(t, err)
+	require.Equal(t, "bye", string(content))
+	require.Equal(t, "second", got.Name)
+
+	_, _, err = store.Open(ctx, 1, 3)
+	require.True(t, errors.Is(err, ErrNotFound))
+	artifacts, err = store.List(ctx, 3)
+	require.NoError(t, err)
+	require.Empty(t, artifacts)
+}
+
+// stripTimes replaces the creation times read back from the metadata files,
+// which lose the monotonic clock reading, with the original ones.
+func stripTimes(artifacts []Artifact, originals ...*Artifact) []Artifact {
+	for i := range artifacts {
+		artifacts[i].CreateTime = originals[i].CreateTime
+	}
+	return artifacts
+}
+
+func TestUpload(t *testing.T) {
+	ctx := xcontext.Background()
+	tgt := &target.Target{ID: "t1"}
+	_, err := Upload(ctx, tgt, "log", strings.NewReader("hello"))
+	require.Equal(t, ErrNoStore, err)
+
+	store, err := NewFilesystemStore(t.TempDir(), 0, 0)
+	require.NoError(t, err)
+	ctx = WithStore(ctx, store)
+	ctx = WithTest(ctx, 3, 1, "test", 2)
+	ctx = WithTestStep(ctx, "step")
+	a, err := Upload(ctx, tgt, "log", strings.NewReader("hello"))
+	require.NoError(t, err)
+	require.Equal(t, Artifact{
+		ID:            1,
+		JobID:         3,
+		RunID:         1,
+		TestName:      "test",
+		TestAttempt:   2,
+		TestStepLabel: "step",
+		TargetID:      "t1",
+		Name:          "log",
+		Size:          5,
+		SHA256:        a.SHA256,
+		CreateTime:    a.CreateTime,
+	}, *a)
+}
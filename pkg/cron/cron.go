@@ -0,0 +1,205 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package cron implements parsing and evaluation of cron expressions, which
+// are used to submit jobs periodically.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expression is a parsed cron expression. It supports the standard five
+// fields (minute, hour, day of month, month, day of week), where every field
+// can be a `*`, a value, a range (`a-b`) or a comma-separated list of those,
+// each optionally followed by a step (`/n`). Months and days of week can also
+// be specified by their three-letter English names. The @yearly, @annually,
+// @monthly, @weekly, @daily, @midnight and @hourly shortcuts are supported as
+// well.
+type Expression struct {
+	minute, hour, dom, month, dow uint64
+	// domAny and dowAny are set when the corresponding field starts with
+	// `*`, such as `*` or `*/2`. If both day fields are restricted, a day
+	// matches when either of them does, as in the original cron
+	// implementation.
+	domAny, dowAny bool
+}
+
+type field struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// day of week accepts 7 as an alias for Sunday.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var shortcuts = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxSearch bounds the search for the next activation time, so that
+// expressions that never match (e.g. February 30th) do not loop forever.
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Parse parses a cron expression.
+func Parse(expr string) (*Expression, error) {
+	spec := strings.TrimSpace(expr)
+	if strings.HasPrefix(spec, "@") {
+		s, ok := shortcuts[strings.ToLower(spec)]
+		if !ok {
+			return nil, fmt.Errorf("unknown cron shortcut %q", spec)
+		}
+		spec = s
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields, got %d", expr, len(fields))
+	}
+	var (
+		e   Expression
+		err error
+	)
+	if e.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if e.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if e.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if e.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if e.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	// Sunday can be either 0 or 7, only keep 0.
+	if e.dow&(1<<7) != 0 {
+		e.dow = (e.dow | 1) &^ (1 << 7)
+	}
+	e.domAny = strings.HasPrefix(fields[2], "*")
+	e.dowAny = strings.HasPrefix(fields[4], "*")
+	return &e, nil
+}
+
+// parse returns the set of values matched by the field, as a bitmask.
+func (f field) parse(s string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(s, ",") {
+		rangeStr, step := item, uint(1)
+		if idx := strings.Index(item, "/"); idx >= 0 {
+			rangeStr = item[:idx]
+			n, err := strconv.ParseUint(item[idx+1:], 10, 8)
+			if err != nil || n == 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, item)
+			}
+			step = uint(n)
+		}
+		var lo, hi uint
+		switch {
+		case rangeStr == "*":
+			lo, hi = f.min, f.max
+		case strings.Contains(rangeStr, "-"):
+			parts := strings.SplitN(rangeStr, "-", 2)
+			var err error
+			if lo, err = f.value(parts[0]); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(parts[1]); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range in %s field %q", f.name, item)
+			}
+		default:
+			var err error
+			if lo, err = f.value(rangeStr); err != nil {
+				return 0, err
+			}
+			hi = lo
+			// `a/n` means every n starting from a.
+			if step > 1 {
+				hi = f.max
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func (f field) value(s string) (uint, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s field %q", f.name, s)
+	}
+	if uint(n) < f.min || uint(n) > f.max {
+		return 0, fmt.Errorf("%s value %d out of range [%d, %d]", f.name, n, f.min, f.max)
+	}
+	return uint(n), nil
+}
+
+// Next returns the first activation time strictly after t, in the location of
+// t. The zero time is returned if the expression never matches.
+func (e *Expression) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Add(maxSearch)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		if e.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !e.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if e.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if e.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (e *Expression) dayMatches(t time.Time) bool {
+	domMatch := e.dom&(1<<uint(t.Day())) != 0
+	dowMatch := e.dow&(1<<uint(t.Weekday())) != 0
+	if e.domAny || e.dowAny {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustTime(t *testing.T, s string) time.Time {
+	tm, err := time.Parse(time.RFC3339, s)
+	require.NoError(t, err)
+	return tm
+}
+
+func TestNext(t *testing.T) {
+	for _, tc := range []struct {
+		expr, from, next string
+	}{
+		{"* * * * *", "2022-01-01T10:00:30Z", "2022-01-01T10:01:00Z"},
+		{"*/15 * * * *", "2022-01-01T10:01:00Z", "2022-01-01T10:15:00Z"},
+		{"5/20 * * * *", "2022-01-01T10:26:00Z", "2022-01-01T10:45:00Z"},
+		{"0 2 * * *", "2022-01-01T02:00:00Z", "2022-01-02T02:00:00Z"},
+		{"30 1,13 * * *", "2022-01-01T02:00:00Z", "2022-01-01T13:30:00Z"},
+		{"0 9-17/4 * * *", "2022-01-01T14:00:00Z", "2022-01-01T17:00:00Z"},
+		{"0 0 * * mon-fri", "2022-01-01T00:00:00Z", "2022-01-03T00:00:00Z"},
+		{"0 0 * * 7", "2022-01-03T00:00:00Z", "2022-01-09T00:00:00Z"},
+		{"0 0 31 * *", "2022-02-01T00:00:00Z", "2022-03-31T00:00:00Z"},
+		{"0 0 29 feb *", "2022-01-01T00:00:00Z", "2024-02-29T00:00:00Z"},
+		// both day fields restricted: either one matches.
+		{"0 0 15 * sun", "2022-01-03T00:00:00Z", "2022-01-09T00:00:00Z"},
+		// a day field starting with `*` is unrestricted: both must match.
+		{"0 0 */2 * 1", "2022-01-03T00:00:00Z", "2022-01-17T00:00:00Z"},
+		{"@hourly", "2022-01-01T10:59:59Z", "2022-01-01T11:00:00Z"},
+		{"@weekly", "2022-01-01T00:00:00Z", "2022-01-02T00:00:00Z"},
+		{"@yearly", "2022-01-01T00:00:00Z", "2023-01-01T00:00:00Z"},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			e, err := Parse(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, mustTime(t, tc.next), e.Next(mustTime(t, tc.from)))
+		})
+	}
+}
+
+func TestNextNeverMatches(t *testing.T) {
+	e, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	require.True(t, e.Next(mustTime(t, "2022-01-01T00:00:00Z")).IsZero())
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@sometimes",
+	} {
+		_, err := Parse(expr)
+		require.Error(t, err, expr)
+	}
+}
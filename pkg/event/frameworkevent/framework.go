@@ -66,6 +66,8 @@ type queryFieldJobID types.JobID
 type queryFieldEventNames []event.Name
 type queryFieldEmittedStartTime time.Time
 type queryFieldEmittedEndTime time.Time
+type queryFieldAfterSequenceID uint64
+type queryFieldLimit uint
 
 // QueryJobID sets the JobID field of the Query object
 func QueryJobID(jobID types.JobID) QueryField                            { return queryFieldJobID(jobID) }
@@ -96,6 +98,18 @@ func (value queryFieldEmittedEndTime) queryFieldPointer(query *Query) interface{
 	return &query.EmittedEndTime
 }
 
+// QueryAfterSequenceID sets the AfterSequenceID field of the Query object
+func QueryAfterSequenceID(sequenceID uint64) QueryField {
+	return queryFieldAfterSequenceID(sequenceID)
+}
+func (value queryFieldAfterSequenceID) queryFieldPointer(query *Query) interface{} {
+	return &query.AfterSequenceID
+}
+
+// QueryLimit sets the Limit field of the Query object
+func QueryLimit(limit uint) QueryField                                   { return queryFieldLimit(limit) }
+func (value queryFieldLimit) queryFieldPointer(query *Query) interface{} { return &query.Limit }
+
 // Emitter defines the interface that emitter objects for framework vents must implement
 type Emitter interface {
 	Emit(ctx xcontext.Context, event Event) error
@@ -17,6 +17,13 @@ type Query struct {
 	EventNames       []Name
 	EmittedStartTime time.Time
 	EmittedEndTime   time.Time
+	// AfterSequenceID restricts the query to the events emitted after the
+	// event with this SequenceID.
+	AfterSequenceID uint64
+	// Limit is the maximum number of events returned, 0 means no limit.
+	// Together with AfterSequenceID it allows to paginate through the events,
+	// which are returned in SequenceID order.
+	Limit uint
 }
 
 type QueryField interface{}
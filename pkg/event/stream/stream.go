@@ -0,0 +1,362 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package stream implements live subscriptions to the events of a job.
+//
+// Events are always read back from the storage, so that they carry their
+// SequenceID and a subscriber can resume from where it left off after a
+// reconnect. The Broker only wakes up the subscriptions of a job when new
+// events of the job are emitted, which avoids polling the storage in a loop.
+package stream
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// PollInterval is how often subscriptions check the storage for new events
+// in the absence of notifications, e.g. for events emitted by other servers.
+var PollInterval = 5 * time.Second
+
+// finalEvents are the events after which a job does not emit events anymore,
+// at least until it is resumed.
+var finalEvents = append([]event.Name{job.EventJobPaused, job.EventJobPauseFailed}, job.JobCompletionEvents...)
+
+// Filter selects the events streamed by a subscription. Empty fields match
+// all the events. Framework events are only filtered by EventNames.
+type Filter struct {
+	RunID         types.RunID  `json:",omitempty"`
+	TestName      string       `json:",omitempty"`
+	TestStepLabel string       `json:",omitempty"`
+	TargetID      string       `json:",omitempty"`
+	EventNames    []event.Name `json:",omitempty"`
+}
+
+// Cursor is the position of a subscriber in the events of a job: the
+// SequenceIDs of the last test and framework events it received.
+type Cursor struct {
+	TestEventSequenceID      uint64
+	FrameworkEventSequenceID uint64
+}
+
+// Position returns the cursor of a subscriber which received ev last.
+func (c Cursor) Position(ev Event) Cursor {
+	if ev.TestEvent != nil && ev.TestEvent.SequenceID > c.TestEventSequenceID {
+		c.TestEventSequenceID = ev.TestEvent.SequenceID
+	}
+	if ev.FrameworkEvent != nil && ev.FrameworkEvent.SequenceID > c.FrameworkEventSequenceID {
+		c.FrameworkEventSequenceID = ev.FrameworkEvent.SequenceID
+	}
+	return c
+}
+
+// Event is either a test event or a framework event of the job.
+type Event struct {
+	TestEvent      *testevent.Event      `json:",omitempty"`
+	FrameworkEvent *frameworkevent.Event `json:",omitempty"`
+}
+
+func (e Event) emitTime() time.Time {
+	if e.TestEvent != nil {
+		return e.TestEvent.EmitTime
+	}
+	return e.FrameworkEvent.EmitTime
+}
+
+// Subscription streams the events of a job as they are emitted.
+type Subscription struct {
+	// Events is closed once the job reached a final state and all of its
+	// events were streamed, or when the context of the subscription is done.
+	Events <-chan Event
+
+	err error
+}
+
+// Err returns the error which terminated the subscription, if any. It must
+// only be called after Events is closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Broker notifies the subscriptions of a job when events of the job are
+// emitted. A nil Broker is valid and ignores notifications.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[types.JobID]map[chan struct{}]struct{}
+}
+
+// NewBroker returns a new Broker without subscribers.
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[types.JobID]map[chan struct{}]struct{})}
+}
+
+// Notify wakes up the subscriptions of a job.
+func (b *Broker) Notify(jobID types.JobID) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[jobID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// A wake up is already pending.
+		}
+	}
+}
+
+func (b *Broker) subscribe(jobID types.JobID) (<-chan struct{}, func()) {
+	if b == nil {
+		// Subscriptions only poll the storage.
+		return nil, func() {}
+	}
+	ch := make(chan struct{}, 1)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.subscribers[jobID] == nil {
+		b.subscribers[jobID] = make(map[chan struct{}]struct{})
+	}
+	b.subscribers[jobID][ch] = struct{}{}
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers[jobID], ch)
+		if len(b.subscribers[jobID]) == 0 {
+			delete(b.subscribers, jobID)
+		}
+	}
+}
+
+// Subscribe streams the events of a job matching the filter, starting after
+// the cursor, until the job reaches a final state or ctx is done.
+func (b *Broker) Subscribe(
+	ctx xcontext.Context,
+	jobID types.JobID,
+	filter Filter,
+	cursor Cursor,
+	testEvents testevent.Fetcher,
+	frameworkEvents frameworkevent.Fetcher,
+) *Subscription {
+	events := make(chan Event)
+	s := &Subscription{Events: events}
+	wakeUp, unsubscribe := b.subscribe(jobID)
+	go func() {
+		defer close(events)
+		defer unsubscribe()
+		sub := subscription{
+			jobID:           jobID,
+			filter:          filter,
+			cursor:          cursor,
+			testEvents:      testEvents,
+			frameworkEvents: frameworkEvents,
+			events:          events,
+		}
+		final, err := sub.finalBeforeCursor(ctx)
+		if err != nil {
+			s.err = err
+			return
+		}
+		for {
+			done, err := sub.fetch(ctx, final)
+			if err != nil {
+				s.err = err
+				return
+			}
+			if done {
+				return
+			}
+			select {
+			case <-wakeUp:
+			case <-time.After(PollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return s
+}
+
+type subscription struct {
+	jobID           types.JobID
+	filter          Filter
+	cursor          Cursor
+	testEvents      testevent.Fetcher
+	frameworkEvents frameworkevent.Fetcher
+	events          chan<- Event
+}
+
+// finalBeforeCursor returns whether the job already reached a final state
+// before the cursor, in which case there are no framework events left to
+// tell that the job is done.
+func (s *subscription) finalBeforeCursor(ctx xcontext.Context) (bool, error) {
+	if s.cursor.FrameworkEventSequenceID == 0 {
+		return false, nil
+	}
+	stateEvents, err := s.frameworkEvents.Fetch(ctx,
+		frameworkevent.QueryJobID(s.jobID),
+		frameworkevent.QueryEventNames(job.JobStateEvents),
+	)
+	if err != nil {
+		return false, err
+	}
+	var last *frameworkevent.Event
+	for i := range stateEvents {
+		if last == nil || stateEvents[i].SequenceID > last.SequenceID {
+			last = &stateEvents[i]
+		}
+	}
+	return last != nil && last.SequenceID <= s.cursor.FrameworkEventSequenceID && isFinal(last.EventName), nil
+}
+
+// fetch sends the events emitted since the last fetch and returns whether
+// the job reached a final state.
+func (s *subscription) fetch(ctx xcontext.Context, final bool) (bool, error) {
+	// Framework events are fetched first: once the job reached a final state,
+	// all of its test events are in the storage already.
+	frameworkQuery := []frameworkevent.QueryField{frameworkevent.QueryJobID(s.jobID)}
+	if s.cursor.FrameworkEventSequenceID != 0 {
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryAfterSequenceID(s.cursor.FrameworkEventSequenceID))
+	}
+	frameworkEvents, err := s.frameworkEvents.Fetch(ctx, frameworkQuery...)
+	if err != nil {
+		return false, err
+	}
+
+	testQuery := []testevent.QueryField{testevent.QueryJobID(s.jobID)}
+	if s.cursor.TestEventSequenceID != 0 {
+		testQuery = append(testQuery, testevent.QueryAfterSequenceID(s.cursor.TestEventSequenceID))
+	}
+	if s.filter.RunID != 0 {
+		testQuery = append(testQuery, testevent.QueryRunID(s.filter.RunID))
+	}
+	if s.filter.TestName != "" {
+		testQuery = append(testQuery, testevent.QueryTestName(s.filter.TestName))
+	}
+	if s.filter.TestStepLabel != "" {
+		testQuery = append(testQuery, testevent.QueryTestStepLabel(s.filter.TestStepLabel))
+	}
+	if s.filter.TargetID != "" {
+		testQuery = append(testQuery, testevent.QueryTargetID(s.filter.TargetID))
+	}
+	if len(s.filter.EventNames) != 0 {
+		testQuery = append(testQuery, testevent.QueryEventNames(s.filter.EventNames))
+	}
+	testEvents, err := s.testEvents.Fetch(ctx, testQuery...)
+	if err != nil {
+		return false, err
+	}
+
+	var batch []Event
+	for i := range frameworkEvents {
+		ev := &frameworkEvents[i]
+		if isFinal(ev.EventName) {
+			final = true
+		}
+		if s.filter.matchName(ev.EventName) {
+			batch = append(batch, Event{FrameworkEvent: ev})
+		}
+	}
+	for i := range testEvents {
+		batch = append(batch, Event{TestEvent: &testEvents[i]})
+	}
+	sort.SliceStable(batch, func(i, j int) bool {
+		return batch[i].emitTime().Before(batch[j].emitTime())
+	})
+
+	for _, ev := range batch {
+		select {
+		case s.events <- ev:
+		case <-ctx.Done():
+			return true, nil
+		}
+	}
+	// Events which were filtered out are skipped as well.
+	for _, ev := range frameworkEvents {
+		if ev.SequenceID > s.cursor.FrameworkEventSequenceID {
+			s.cursor.FrameworkEventSequenceID = ev.SequenceID
+		}
+	}
+	for _, ev := range testEvents {
+		if ev.SequenceID > s.cursor.TestEventSequenceID {
+			s.cursor.TestEventSequenceID = ev.SequenceID
+		}
+	}
+	return final, nil
+}
+
+func (f Filter) matchName(name event.Name) bool {
+	if len(f.EventNames) == 0 {
+		return true
+	}
+	for _, n := range f.EventNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isFinal(name event.Name) bool {
+	for _, n := range finalEvents {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+type testEventEmitter struct {
+	testevent.Emitter
+	broker *Broker
+	jobID  types.JobID
+}
+
+func (e testEventEmitter) Emit(ctx xcontext.Context, data testevent.Data) error {
+	if err := e.Emitter.Emit(ctx, data); err != nil {
+		return err
+	}
+	e.broker.Notify(e.jobID)
+	return nil
+}
+
+// TestEventEmitter wraps an emitter of test events of a job, so that the
+// subscriptions to the job are notified about the events.
+func (b *Broker) TestEventEmitter(jobID types.JobID, emitter testevent.Emitter) testevent.Emitter {
+	if b == nil {
+		return emitter
+	}
+	return testEventEmitter{Emitter: emitter, broker: b, jobID: jobID}
+}
+
+type frameworkEventEmitterFetcher struct {
+	frameworkevent.EmitterFetcher
+	broker *Broker
+}
+
+func (e frameworkEventEmitterFetcher) Emit(ctx xcontext.Context, ev frameworkevent.Event) error {
+	if err := e.EmitterFetcher.Emit(ctx, ev); err != nil {
+		return err
+	}
+	e.broker.Notify(ev.JobID)
+	return nil
+}
+
+// FrameworkEventEmitterFetcher wraps an emitter of framework events, so that
+// the subscriptions to the jobs are notified about the events.
+func (b *Broker) FrameworkEventEmitterFetcher(ef frameworkevent.EmitterFetcher) frameworkevent.EmitterFetcher {
+	if b == nil {
+		return ef
+	}
+	return frameworkEventEmitterFetcher{EmitterFetcher: ef, broker: b}
+}
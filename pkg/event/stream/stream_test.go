@@ -0,0 +1,181 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package stream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/plugins/storage/memory"
+)
+
+const jobID = types.JobID(1)
+
+type fixture struct {
+	ctx             xcontext.Context
+	broker          *Broker
+	vault           *storage.SimpleEngineVault
+	testEvents      testevent.Fetcher
+	frameworkEvents frameworkevent.EmitterFetcher
+}
+
+func newFixture(t *testing.T) *fixture {
+	ms, err := memory.New()
+	require.NoError(t, err)
+	vault := storage.NewSimpleEngineVault()
+	require.NoError(t, vault.StoreEngine(ms, storage.SyncEngine))
+	broker := NewBroker()
+	return &fixture{
+		ctx:             logrusctx.NewContext(logger.LevelDebug),
+		broker:          broker,
+		vault:           vault,
+		testEvents:      storage.NewTestEventFetcher(vault),
+		frameworkEvents: broker.FrameworkEventEmitterFetcher(storage.NewFrameworkEventEmitterFetcher(vault)),
+	}
+}
+
+func (f *fixture) emitTestEvent(t *testing.T, stepLabel, targetID string, name event.Name) {
+	emitter := f.broker.TestEventEmitter(jobID, storage.NewTestEventEmitter(f.vault, testevent.Header{
+		JobID:         jobID,
+		RunID:         1,
+		TestName:      "test",
+		TestStepLabel: stepLabel,
+	}))
+	require.NoError(t, emitter.Emit(f.ctx, testevent.Data{EventName: name, Target: &target.Target{ID: targetID}}))
+}
+
+func (f *fixture) emitFrameworkEvent(t *testing.T, name event.Name) {
+	require.NoError(t, f.frameworkEvents.Emit(f.ctx, frameworkevent.Event{JobID: jobID, EventName: name, EmitTime: time.Now()}))
+}
+
+func (f *fixture) subscribe(filter Filter, cursor Cursor) *Subscription {
+	return f.broker.Subscribe(f.ctx, jobID, filter, cursor, f.testEvents, f.frameworkEvents)
+}
+
+// eventNames receives all the events of the subscription.
+func eventNames(t *testing.T, sub *Subscription) []string {
+	var names []string
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case ev, ok := <-sub.Events:
+			if !ok {
+				require.NoError(t, sub.Err())
+				return names
+			}
+			if ev.TestEvent != nil {
+				names = append(names, ev.TestEvent.Header.TestStepLabel+"/"+ev.TestEvent.Data.Target.ID+"/"+string(ev.TestEvent.Data.EventName))
+			} else {
+				names = append(names, string(ev.FrameworkEvent.EventName))
+			}
+		case <-timeout:
+			require.FailNow(t, "subscription did not end", "received %v", names)
+		}
+	}
+}
+
+func TestSubscribeFilter(t *testing.T) {
+	f := newFixture(t)
+	f.emitFrameworkEvent(t, job.EventJobStarted)
+	f.emitTestEvent(t, "step1", "T1", "Start")
+	f.emitTestEvent(t, "step1", "T2", "Start")
+	f.emitTestEvent(t, "step2", "T1", "Start")
+	f.emitTestEvent(t, "step2", "T1", "End")
+	f.emitFrameworkEvent(t, job.EventJobCompleted)
+
+	require.Equal(t,
+		[]string{"JobStateStarted", "step1/T1/Start", "step1/T2/Start", "step2/T1/Start", "step2/T1/End", "JobStateCompleted"},
+		eventNames(t, f.subscribe(Filter{}, Cursor{})),
+	)
+	require.Equal(t,
+		[]string{"JobStateStarted", "step2/T1/Start", "step2/T1/End", "JobStateCompleted"},
+		eventNames(t, f.subscribe(Filter{TestStepLabel: "step2", TargetID: "T1"}, Cursor{})),
+	)
+	require.Equal(t,
+		[]string{"step1/T1/Start", "step1/T2/Start", "step2/T1/Start", "JobStateCompleted"},
+		eventNames(t, f.subscribe(Filter{EventNames: []event.Name{"Start", job.EventJobCompleted}}, Cursor{})),
+	)
+}
+
+func TestSubscribeResume(t *testing.T) {
+	f := newFixture(t)
+	f.emitFrameworkEvent(t, job.EventJobStarted)
+	f.emitTestEvent(t, "step1", "T1", "Start")
+	f.emitTestEvent(t, "step1", "T1", "End")
+
+	// Read the first two events, as a client which disconnects.
+	ctx, cancel := xcontext.WithCancel(f.ctx)
+	sub := f.broker.Subscribe(ctx, jobID, Filter{}, Cursor{}, f.testEvents, f.frameworkEvents)
+	var cursor Cursor
+	for i := 0; i < 2; i++ {
+		cursor = cursor.Position(<-sub.Events)
+	}
+	cancel()
+	for range sub.Events {
+	}
+
+	f.emitFrameworkEvent(t, job.EventJobCompleted)
+	require.Equal(t,
+		[]string{"step1/T1/End", "JobStateCompleted"},
+		eventNames(t, f.subscribe(Filter{}, cursor)),
+	)
+}
+
+func TestSubscribeLive(t *testing.T) {
+	pollInterval := PollInterval
+	PollInterval = time.Hour
+	defer func() { PollInterval = pollInterval }()
+
+	f := newFixture(t)
+	f.emitFrameworkEvent(t, job.EventJobStarted)
+	sub := f.subscribe(Filter{}, Cursor{})
+	require.Equal(t, job.EventJobStarted, (<-sub.Events).FrameworkEvent.EventName)
+
+	// Events are only streamed thanks to the notifications of the emitters.
+	f.emitTestEvent(t, "step1", "T1", "Start")
+	require.Equal(t, event.Name("Start"), (<-sub.Events).TestEvent.Data.EventName)
+	f.emitFrameworkEvent(t, job.EventJobPaused)
+	require.Equal(t, []string{"JobStatePaused"}, eventNames(t, sub))
+}
+
+func TestSubscribeAfterFinalEvent(t *testing.T) {
+	f := newFixture(t)
+	f.emitFrameworkEvent(t, job.EventJobStarted)
+	f.emitFrameworkEvent(t, job.EventJobCompleted)
+
+	sub := f.subscribe(Filter{}, Cursor{})
+	var cursor Cursor
+	for ev := range sub.Events {
+		cursor = cursor.Position(ev)
+	}
+	require.NoError(t, sub.Err())
+
+	// Nothing is left after the last event of a completed job.
+	require.Empty(t, eventNames(t, f.subscribe(Filter{}, cursor)))
+}
+
+func TestSubscribeCancel(t *testing.T) {
+	f := newFixture(t)
+	f.emitFrameworkEvent(t, job.EventJobStarted)
+
+	ctx, cancel := xcontext.WithCancel(f.ctx)
+	sub := f.broker.Subscribe(ctx, jobID, Filter{}, Cursor{}, f.testEvents, f.frameworkEvents)
+	require.Equal(t, job.EventJobStarted, (<-sub.Events).FrameworkEvent.EventName)
+	cancel()
+	require.Empty(t, eventNames(t, sub))
+}
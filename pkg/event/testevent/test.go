@@ -26,6 +26,9 @@ type Header struct {
 	TestName      string
 	TestAttempt   uint32
 	TestStepLabel string
+	// TestStepAttempt is the attempt of the step the event refers to, see
+	// test.StepRetryParameters.
+	TestStepAttempt uint32
 }
 
 // Data models the data of a test event. It is populated by the TestStep
@@ -56,6 +59,10 @@ type Query struct {
 	RunID         types.RunID
 	TestName      string
 	TestStepLabel string
+	TargetID      string
+	// PayloadContains restricts the query to the events whose payload
+	// contains this substring.
+	PayloadContains string
 }
 
 // QueryField defines a function type used to set a field's value on Query objects
@@ -88,9 +95,13 @@ type queryFieldJobID types.JobID
 type queryFieldEventNames []event.Name
 type queryFieldEmittedStartTime time.Time
 type queryFieldEmittedEndTime time.Time
+type queryFieldAfterSequenceID uint64
+type queryFieldLimit uint
 type queryFieldTestName string
 type queryFieldTestStepLabel string
 type queryFieldRunID types.RunID
+type queryFieldTargetID string
+type queryFieldPayloadContains string
 
 // QueryJobID sets the JobID field of the Query object
 func QueryJobID(jobID types.JobID) QueryField                            { return queryFieldJobID(jobID) }
@@ -121,6 +132,18 @@ func (value queryFieldEmittedEndTime) queryFieldPointer(query *Query) interface{
 	return &query.EmittedEndTime
 }
 
+// QueryAfterSequenceID sets the AfterSequenceID field of the Query object
+func QueryAfterSequenceID(sequenceID uint64) QueryField {
+	return queryFieldAfterSequenceID(sequenceID)
+}
+func (value queryFieldAfterSequenceID) queryFieldPointer(query *Query) interface{} {
+	return &query.AfterSequenceID
+}
+
+// QueryLimit sets the Limit field of the Query object
+func QueryLimit(limit uint) QueryField                                   { return queryFieldLimit(limit) }
+func (value queryFieldLimit) queryFieldPointer(query *Query) interface{} { return &query.Limit }
+
 // QueryTestName sets the TestName field of the Query object
 func QueryTestName(testName string) QueryField {
 	return queryFieldTestName(testName)
@@ -141,6 +164,20 @@ func QueryRunID(runID types.RunID) QueryField {
 }
 func (value queryFieldRunID) queryFieldPointer(query *Query) interface{} { return &query.RunID }
 
+// QueryTargetID sets the TargetID field of the Query object
+func QueryTargetID(targetID string) QueryField {
+	return queryFieldTargetID(targetID)
+}
+func (value queryFieldTargetID) queryFieldPointer(query *Query) interface{} { return &query.TargetID }
+
+// QueryPayloadContains sets the PayloadContains field of the Query object
+func QueryPayloadContains(substring string) QueryField {
+	return queryFieldPayloadContains(substring)
+}
+func (value queryFieldPayloadContains) queryFieldPointer(query *Query) interface{} {
+	return &query.PayloadContains
+}
+
 // Emitter defines the interface that emitter objects must implement
 type Emitter interface {
 	Emit(ctx xcontext.Context, event Data) error
@@ -44,3 +44,16 @@ func TestBuildQuery_NoZeroValues(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, errors.As(err, &event.ErrQueryFieldHasZeroValue{}))
 }
+
+func TestBuildQuery_TargetAndPayload(t *testing.T) {
+	query, err := QueryFields{
+		QueryJobID(1),
+		QueryTargetID("host1"),
+		QueryPayloadContains("error"),
+		QueryLimit(10),
+	}.BuildQuery()
+	assert.NoError(t, err)
+	assert.Equal(t, "host1", query.TargetID)
+	assert.Equal(t, "error", query.PayloadContains)
+	assert.Equal(t, uint(10), query.Limit)
+}
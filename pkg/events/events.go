@@ -15,6 +15,7 @@ const (
 	EventStdout = event.Name("Stdout")
 	EventStderr = event.Name("Stderr")
 	EventOutput = event.Name("Output")
+	EventMetric = event.Name("Metric")
 )
 
 // Events defines the events that a TestStep is allow to emit. Emitting an event
@@ -23,6 +24,7 @@ var Events = []event.Name{
 	EventStdout,
 	EventStderr,
 	EventOutput,
+	EventMetric,
 }
 
 type Component struct {
@@ -30,6 +32,14 @@ type Component struct {
 	Data []byte `json:"data"`
 }
 
+// Metric is the payload of a Metric event: a named measurement taken on the
+// target of the event, e.g. the events per second reported by a benchmark.
+type Metric struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit,omitempty"`
+}
+
 type payload struct {
 	Msg string
 }
@@ -84,3 +94,18 @@ func EmitOutput(ctx xcontext.Context, name string, data []byte, tgt *target.Targ
 
 	return nil
 }
+
+// EmitMetric emits a measurement taken on the target as a Metric event
+func EmitMetric(ctx xcontext.Context, name string, value float64, unit string, tgt *target.Target, ev testevent.Emitter) error {
+	payload := Metric{
+		Name:  name,
+		Value: value,
+		Unit:  unit,
+	}
+
+	if err := emitEvent(ctx, EventMetric, payload, tgt, ev); err != nil {
+		return fmt.Errorf("cannot emit event: %v", err)
+	}
+
+	return nil
+}
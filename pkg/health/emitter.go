@@ -0,0 +1,60 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package health
+
+import (
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+type testEventEmitter struct {
+	tracker *Tracker
+	header  testevent.Header
+	emitter testevent.Emitter
+}
+
+// TestEventEmitter returns an emitter which forwards the events to emitter,
+// and records the outcomes reported by the TargetOut and TargetErr events.
+func (t *Tracker) TestEventEmitter(header testevent.Header, emitter testevent.Emitter) testevent.Emitter {
+	return &testEventEmitter{tracker: t, header: header, emitter: emitter}
+}
+
+// Emit implements testevent.Emitter.
+func (e *testEventEmitter) Emit(ctx xcontext.Context, data testevent.Data) error {
+	if err := e.emitter.Emit(ctx, data); err != nil {
+		return err
+	}
+	if data.Target == nil || (data.EventName != target.EventTargetOut && data.EventName != target.EventTargetErr) {
+		return nil
+	}
+
+	outcome := Outcome{
+		TargetID:      data.Target.ID,
+		JobID:         e.header.JobID,
+		RunID:         e.header.RunID,
+		TestName:      e.header.TestName,
+		TestStepLabel: e.header.TestStepLabel,
+	}
+	if data.EventName == target.EventTargetErr {
+		outcome.Error = "unknown error"
+		if data.Payload != nil {
+			payload, err := target.UnmarshalErrPayload(*data.Payload)
+			if err != nil {
+				ctx.Warnf("Could not read error of target %s: %v", data.Target.ID, err)
+			} else if payload.Error != "" {
+				outcome.Error = payload.Error
+				outcome.Infrastructure = payload.Infrastructure
+			}
+		}
+	}
+	// The event is already stored, losing track of the health of the target
+	// must not fail the test.
+	if err := e.tracker.Record(ctx, outcome); err != nil {
+		ctx.Warnf("Could not track health of target %s: %v", data.Target.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package health keeps track of the outcomes of the test steps on each
+// target, and quarantines the targets which keep failing because of the
+// infrastructure around them, e.g. a DUT which bricked itself, so that
+// they are not handed out to other jobs until an operator releases them.
+package health
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// ErrNotFound is returned when a target has never been quarantined.
+var ErrNotFound = errors.New("target was never quarantined")
+
+// ErrQuarantined is returned when quarantining a target which is already in
+// quarantine, or when locking a target which is in quarantine.
+var ErrQuarantined = errors.New("target is quarantined")
+
+// ErrNotQuarantined is returned when releasing a target which is not in
+// quarantine.
+var ErrNotQuarantined = errors.New("target is not quarantined")
+
+// Outcome is the outcome of a test step on a target, as reported by the
+// TargetOut and TargetErr events.
+type Outcome struct {
+	TargetID      string
+	JobID         types.JobID
+	RunID         types.RunID
+	TestName      string
+	TestStepLabel string
+	Time          time.Time
+	// Error is empty if the target passed the test step.
+	Error string `json:",omitempty"`
+	// Infrastructure is set if the target failed because of the
+	// infrastructure rather than because of the test, see
+	// target.ErrInfrastructure.
+	Infrastructure bool `json:",omitempty"`
+}
+
+// Quarantine describes why and when a target was quarantined and, once it
+// is over, why and when it was released.
+type Quarantine struct {
+	TargetID string
+	Reason   string
+	// Requestor is who quarantined the target, empty if the target was
+	// quarantined automatically.
+	Requestor string `json:",omitempty"`
+	Time      time.Time
+	// Release is set once the target has been released.
+	Release *Release `json:",omitempty"`
+}
+
+// Release describes why and when a target was released from quarantine.
+type Release struct {
+	Reason    string
+	Requestor string
+	Time      time.Time
+}
+
+// Active returns whether the target is still in quarantine.
+func (q *Quarantine) Active() bool {
+	return q.Release == nil
+}
+
+// Store persists the outcomes and the quarantines of the targets.
+type Store interface {
+	// StoreTargetOutcome records the outcome of a test step on a target.
+	StoreTargetOutcome(ctx xcontext.Context, outcome *Outcome) error
+
+	// GetTargetOutcomes returns up to limit of the latest outcomes of a
+	// target, newest first. Zero means no limit.
+	GetTargetOutcomes(ctx xcontext.Context, targetID string, limit uint) ([]Outcome, error)
+
+	// QuarantineTarget quarantines a target, replacing its previous
+	// quarantine if it was released. It returns an error wrapping
+	// ErrQuarantined if the target is already in quarantine.
+	QuarantineTarget(ctx xcontext.Context, quarantine *Quarantine) error
+
+	// ReleaseTarget releases a target from quarantine. It returns an error
+	// wrapping ErrNotQuarantined if the target is not in quarantine.
+	ReleaseTarget(ctx xcontext.Context, targetID string, release *Release) error
+
+	// GetTargetQuarantine returns the latest quarantine of a target, which
+	// may have been released. It returns an error wrapping ErrNotFound if
+	// the target was never quarantined.
+	GetTargetQuarantine(ctx xcontext.Context, targetID string) (*Quarantine, error)
+
+	// ListQuarantinedTargets returns the targets in quarantine sorted by ID.
+	ListQuarantinedTargets(ctx xcontext.Context) ([]Quarantine, error)
+}
+
+// Tracker records the outcomes of the targets and quarantines the ones
+// which failed the last test steps they ran because of the infrastructure.
+type Tracker struct {
+	store Store
+	clock clock.Clock
+	// threshold is the number of consecutive infrastructure failures after
+	// which a target is quarantined, zero disables automatic quarantine.
+	threshold uint
+}
+
+// NewTracker returns a Tracker which quarantines the targets after threshold
+// consecutive infrastructure failures. A zero threshold only records the
+// outcomes, targets are then quarantined through the API only.
+func NewTracker(store Store, clk clock.Clock, threshold uint) *Tracker {
+	return &Tracker{store: store, clock: clk, threshold: threshold}
+}
+
+// Record stores the outcome of a test step on a target, and quarantines the
+// target if it reached the threshold of consecutive infrastructure failures
+// since it was last released.
+func (t *Tracker) Record(ctx xcontext.Context, outcome Outcome) error {
+	if outcome.Time.IsZero() {
+		outcome.Time = t.clock.Now()
+	}
+	if err := t.store.StoreTargetOutcome(ctx, &outcome); err != nil {
+		return fmt.Errorf("could not record outcome of target %s: %w", outcome.TargetID, err)
+	}
+	if t.threshold == 0 || !outcome.Infrastructure {
+		return nil
+	}
+
+	var since time.Time
+	q, err := t.store.GetTargetQuarantine(ctx, outcome.TargetID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+	case err != nil:
+		return fmt.Errorf("could not get quarantine of target %s: %w", outcome.TargetID, err)
+	case q.Active():
+		return nil
+	default:
+		since = q.Release.Time
+	}
+
+	outcomes, err := t.store.GetTargetOutcomes(ctx, outcome.TargetID, t.threshold)
+	if err != nil {
+		return fmt.Errorf("could not get outcomes of target %s: %w", outcome.TargetID, err)
+	}
+	if uint(len(outcomes)) < t.threshold {
+		return nil
+	}
+	for _, o := range outcomes {
+		// Failures which happened before the target was released do not
+		// count, the operator who released it presumably fixed it.
+		if !o.Infrastructure || !o.Time.After(since) {
+			return nil
+		}
+	}
+
+	err = t.store.QuarantineTarget(ctx, &Quarantine{
+		TargetID: outcome.TargetID,
+		Reason:   fmt.Sprintf("%d consecutive infrastructure failures, last one: %s", t.threshold, outcome.Error),
+		Time:     t.clock.Now(),
+	})
+	if errors.Is(err, ErrQuarantined) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not quarantine target %s: %w", outcome.TargetID, err)
+	}
+	ctx.Warnf("Target %s quarantined after %d consecutive infrastructure failures", outcome.TargetID, t.threshold)
+	return nil
+}
+
+// Quarantined returns the IDs of the targets in quarantine.
+func (t *Tracker) Quarantined(ctx xcontext.Context) (map[string]bool, error) {
+	quarantines, err := t.store.ListQuarantinedTargets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list quarantined targets: %w", err)
+	}
+	res := make(map[string]bool, len(quarantines))
+	for _, q := range quarantines {
+		res[q.TargetID] = true
+	}
+	return res, nil
+}
+
+type key string
+
+const keyTracker = key("health_tracker")
+
+// WithTracker returns a context the job runner records the outcomes of the
+// targets to, and checks the quarantined targets against.
+func WithTracker(ctx xcontext.Context, tracker *Tracker) xcontext.Context {
+	return xcontext.WithValue(ctx, keyTracker, tracker)
+}
+
+// TrackerFromContext returns the Tracker of the context, if any.
+func TrackerFromContext(ctx xcontext.Context) (*Tracker, bool) {
+	tracker, ok := ctx.Value(keyTracker).(*Tracker)
+	return tracker, ok && tracker != nil
+}
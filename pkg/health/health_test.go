@@ -0,0 +1,157 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package health_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/plugins/storage/memory"
+	"github.com/linuxboot/contest/plugins/targetlocker/inmemory"
+)
+
+var ctx = logrusctx.NewContext(logger.LevelDebug)
+
+func newStore(t *testing.T) storage.ResettableStorage {
+	store, err := memory.New()
+	require.NoError(t, err)
+	return store
+}
+
+func record(t *testing.T, tracker *health.Tracker, clk *clock.Mock, infra ...bool) {
+	for _, i := range infra {
+		clk.Add(time.Second)
+		outcome := health.Outcome{TargetID: "t1", JobID: 1, Infrastructure: i}
+		if i {
+			outcome.Error = "unreachable"
+		}
+		require.NoError(t, tracker.Record(ctx, outcome))
+	}
+}
+
+func isQuarantined(t *testing.T, tracker *health.Tracker) bool {
+	quarantined, err := tracker.Quarantined(ctx)
+	require.NoError(t, err)
+	return quarantined["t1"]
+}
+
+func TestTrackerQuarantine(t *testing.T) {
+	store := newStore(t)
+	clk := clock.NewMock()
+	tracker := health.NewTracker(store, clk, 3)
+
+	// Test failures and passes break the streak of infrastructure failures.
+	record(t, tracker, clk, true, true, false, true, true)
+	require.False(t, isQuarantined(t, tracker))
+	record(t, tracker, clk, true)
+	require.True(t, isQuarantined(t, tracker))
+
+	q, err := store.GetTargetQuarantine(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, "", q.Requestor)
+	require.Equal(t, clk.Now(), q.Time)
+	require.Contains(t, q.Reason, "3 consecutive infrastructure failures")
+	require.Contains(t, q.Reason, "unreachable")
+
+	outcomes, err := store.GetTargetOutcomes(ctx, "t1", 0)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(outcomes))
+
+	// Failures which happened before the release do not count.
+	require.NoError(t, store.ReleaseTarget(ctx, "t1", &health.Release{Reason: "reflashed", Requestor: "admin", Time: clk.Now()}))
+	record(t, tracker, clk, true, true)
+	require.False(t, isQuarantined(t, tracker))
+	record(t, tracker, clk, true)
+	require.True(t, isQuarantined(t, tracker))
+}
+
+func TestTrackerNoThreshold(t *testing.T) {
+	store := newStore(t)
+	clk := clock.NewMock()
+	tracker := health.NewTracker(store, clk, 0)
+
+	record(t, tracker, clk, true, true, true, true)
+	require.False(t, isQuarantined(t, tracker))
+	outcomes, err := store.GetTargetOutcomes(ctx, "t1", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(outcomes))
+	require.Equal(t, clk.Now(), outcomes[0].Time)
+}
+
+func TestTrackerLocker(t *testing.T) {
+	store := newStore(t)
+	clk := clock.NewMock()
+	tracker := health.NewTracker(store, clk, 0)
+	require.NoError(t, store.QuarantineTarget(ctx, &health.Quarantine{TargetID: "t1", Reason: "bricked", Time: clk.Now()}))
+
+	tl := tracker.Locker(inmemory.New(clk))
+	targets := []*target.Target{{ID: "t1"}, {ID: "t2"}}
+	locked, err := tl.TryLock(ctx, 1, time.Minute, targets, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"t2"}, locked)
+
+	wl := tl.(target.WaitLocker)
+	_, err = wl.WaitLock(ctx, 2, 0, time.Minute, targets, 2, 2)
+	require.Error(t, err)
+	locked, err = wl.WaitLock(ctx, 1, 0, time.Minute, targets, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"t2"}, locked)
+
+	err = tl.Lock(ctx, 1, time.Minute, targets)
+	require.True(t, errors.Is(err, health.ErrQuarantined))
+	require.NoError(t, tl.Lock(ctx, 1, time.Minute, targets[1:]))
+	require.NoError(t, tl.Unlock(ctx, 1, targets[1:]))
+}
+
+type nullEmitter struct{}
+
+func (nullEmitter) Emit(xcontext.Context, testevent.Data) error { return nil }
+
+func TestTrackerTestEventEmitter(t *testing.T) {
+	store := newStore(t)
+	clk := clock.NewMock()
+	tracker := health.NewTracker(store, clk, 1)
+
+	header := testevent.Header{JobID: 4, RunID: 2, TestName: "test", TestStepLabel: "step"}
+	emitter := tracker.TestEventEmitter(header, nullEmitter{})
+	tgt := &target.Target{ID: "t1"}
+	require.NoError(t, emitter.Emit(ctx, testevent.Data{Target: tgt, EventName: target.EventTargetIn}))
+	require.NoError(t, emitter.Emit(ctx, testevent.Data{Target: tgt, EventName: target.EventTargetOut}))
+
+	payload, err := json.Marshal(target.ErrPayload{Error: "exit status 1"})
+	require.NoError(t, err)
+	raw := json.RawMessage(payload)
+	require.NoError(t, emitter.Emit(ctx, testevent.Data{Target: tgt, EventName: target.EventTargetErr, Payload: &raw}))
+	require.False(t, isQuarantined(t, tracker))
+
+	payload, err = json.Marshal(target.ErrPayload{Error: "unreachable", Infrastructure: true})
+	require.NoError(t, err)
+	raw = json.RawMessage(payload)
+	require.NoError(t, emitter.Emit(ctx, testevent.Data{Target: tgt, EventName: target.EventTargetErr, Payload: &raw}))
+	require.True(t, isQuarantined(t, tracker))
+
+	outcomes, err := store.GetTargetOutcomes(ctx, "t1", 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(outcomes))
+	require.Equal(t, "unreachable", outcomes[0].Error)
+	require.Equal(t, "exit status 1", outcomes[1].Error)
+	require.False(t, outcomes[1].Infrastructure)
+	require.Equal(t, "", outcomes[2].Error)
+	require.Equal(t, "step", outcomes[2].TestStepLabel)
+	require.Equal(t, header.JobID, outcomes[2].JobID)
+}
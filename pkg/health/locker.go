@@ -0,0 +1,100 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package health
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+type locker struct {
+	target.Locker
+	tracker *Tracker
+}
+
+// Locker returns a locker which never locks the targets in quarantine:
+// TryLock and WaitLock skip them, so that the target managers pick other
+// targets, and Lock fails. Other methods are forwarded to tl.
+func (t *Tracker) Locker(tl target.Locker) target.Locker {
+	return &locker{Locker: tl, tracker: t}
+}
+
+// Lock implements target.Locker.
+func (l *locker) Lock(ctx xcontext.Context, jobID types.JobID, duration time.Duration, targets []*target.Target) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	quarantined, err := l.tracker.Quarantined(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		if quarantined[t.ID] {
+			return fmt.Errorf("cannot lock target %s: %w", t.ID, ErrQuarantined)
+		}
+	}
+	return l.Locker.Lock(ctx, jobID, duration, targets)
+}
+
+// TryLock implements target.Locker.
+func (l *locker) TryLock(ctx xcontext.Context, jobID types.JobID, duration time.Duration, targets []*target.Target, limit uint) ([]string, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	healthy, err := l.healthy(ctx, targets)
+	if err != nil {
+		return nil, err
+	}
+	return l.Locker.TryLock(ctx, jobID, duration, healthy, limit)
+}
+
+// WaitLock implements target.WaitLocker. If the wrapped locker cannot make
+// the jobs wait, it only tries once to lock the targets.
+func (l *locker) WaitLock(ctx xcontext.Context, jobID types.JobID, priority int, duration time.Duration, targets []*target.Target, min, limit uint) ([]string, error) {
+	healthy, err := l.healthy(ctx, targets)
+	if err != nil {
+		return nil, err
+	}
+	if wl, ok := l.Locker.(target.WaitLocker); ok {
+		return wl.WaitLock(ctx, jobID, priority, duration, healthy, min, limit)
+	}
+	return target.WaitLock(ctx, l.Locker, jobID, duration, healthy, min, limit)
+}
+
+// Waiters implements target.WaitLocker.
+func (l *locker) Waiters() []target.Waiter {
+	if wl, ok := l.Locker.(target.WaitLocker); ok {
+		return wl.Waiters()
+	}
+	return nil
+}
+
+// healthy returns the targets which are not in quarantine.
+func (l *locker) healthy(ctx xcontext.Context, targets []*target.Target) ([]*target.Target, error) {
+	quarantined, err := l.tracker.Quarantined(ctx)
+	if err != nil {
+		return nil, err
+	}
+	healthy := make([]*target.Target, 0, len(targets))
+	for _, t := range targets {
+		if quarantined[t.ID] {
+			ctx.Debugf("Skipping quarantined target %s", t.ID)
+			continue
+		}
+		healthy = append(healthy, t)
+	}
+	return healthy, nil
+}
+
+// Close implements target.Locker. The wrapped locker is shared with other
+// jobs and is left open.
+func (l *locker) Close() error {
+	return nil
+}
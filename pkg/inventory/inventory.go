@@ -0,0 +1,148 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package inventory describes the targets known to the server, along with the
+// labels used to select them and the attributes the test steps need to reach
+// them, so that jobs can ask for targets by label instead of hard-coding
+// hosts.
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// ErrNotFound is returned when a target is not in the inventory.
+var ErrNotFound = errors.New("target not found in inventory")
+
+// ErrAlreadyExists is returned when adding a target whose ID is already in
+// the inventory.
+var ErrAlreadyExists = errors.New("target already in inventory")
+
+// MaxIDLen is the maximum length of the ID of a target, as stored by the
+// storage engines.
+const MaxIDLen = 64
+
+// Target is a target of the inventory.
+type Target struct {
+	ID          string
+	FQDN        string `json:",omitempty"`
+	PrimaryIPv4 net.IP `json:",omitempty"`
+	PrimaryIPv6 net.IP `json:",omitempty"`
+	// Labels are used to select the targets, e.g. "board=tioga" or
+	// "pool=nightly".
+	Labels map[string]string `json:",omitempty"`
+	// Attributes hold what the test steps need to know about the target,
+	// e.g. the host of its BMC, its UART device or its firmware slot.
+	Attributes map[string]string `json:",omitempty"`
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+var labelRegexp = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_./-]{0,61}[A-Za-z0-9])?$`)
+
+// Validate checks that the target can be stored in the inventory.
+func (t *Target) Validate() error {
+	if t.ID == "" {
+		return errors.New("target ID cannot be empty")
+	}
+	if len(t.ID) > MaxIDLen {
+		return fmt.Errorf("target ID cannot be longer than %d characters", MaxIDLen)
+	}
+	if t.PrimaryIPv4 != nil && t.PrimaryIPv4.To4() == nil {
+		return fmt.Errorf("invalid IPv4 address %q", t.PrimaryIPv4)
+	}
+	if t.PrimaryIPv6 != nil && t.PrimaryIPv6.To16() == nil {
+		return fmt.Errorf("invalid IPv6 address %q", t.PrimaryIPv6)
+	}
+	for k, v := range t.Labels {
+		if !labelRegexp.MatchString(k) {
+			return fmt.Errorf("invalid label key %q", k)
+		}
+		if v != "" && !labelRegexp.MatchString(v) {
+			return fmt.Errorf("invalid value %q for label %q", v, k)
+		}
+	}
+	for k := range t.Attributes {
+		if k == "" {
+			return errors.New("attribute keys cannot be empty")
+		}
+	}
+	return nil
+}
+
+// ToTarget returns the target handed to the test steps for t, along with a
+// copy of its labels and attributes.
+func (t *Target) ToTarget() *target.Target {
+	return &target.Target{
+		ID:          t.ID,
+		FQDN:        t.FQDN,
+		PrimaryIPv4: t.PrimaryIPv4,
+		PrimaryIPv6: t.PrimaryIPv6,
+		Labels:      copyMap(t.Labels),
+		Attributes:  copyMap(t.Attributes),
+	}
+}
+
+func copyMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+// Query selects targets of the inventory. The zero value selects all of them.
+type Query struct {
+	// IDs, if not empty, restricts the query to these targets.
+	IDs      []string
+	Selector Selector
+}
+
+// Matches returns whether t is selected by the query.
+func (q *Query) Matches(t *Target) bool {
+	if len(q.IDs) > 0 {
+		found := false
+		for _, id := range q.IDs {
+			found = found || id == t.ID
+		}
+		if !found {
+			return false
+		}
+	}
+	return q.Selector.Matches(t.Labels)
+}
+
+// Store gives access to the targets of the inventory.
+type Store interface {
+	// ListInventoryTargets returns the targets selected by query sorted by
+	// ID.
+	ListInventoryTargets(ctx xcontext.Context, query *Query) ([]Target, error)
+}
+
+type key string
+
+const keyStore = key("inventory_store")
+
+// WithStore returns a context the target managers running in can look up
+// targets of the inventory from.
+func WithStore(ctx xcontext.Context, store Store) xcontext.Context {
+	return xcontext.WithValue(ctx, keyStore, store)
+}
+
+// StoreFromContext returns the Store of the context, if any.
+func StoreFromContext(ctx xcontext.Context) (Store, bool) {
+	store, ok := ctx.Value(keyStore).(Store)
+	return store, ok && store != nil
+}
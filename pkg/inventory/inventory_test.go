@@ -0,0 +1,34 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToTarget(t *testing.T) {
+	entry := Target{
+		ID:         "t1",
+		FQDN:       "t1.example.com",
+		Labels:     map[string]string{"board": "tioga"},
+		Attributes: map[string]string{"bmc_host": "t1-bmc"},
+	}
+	tgt := entry.ToTarget()
+	require.Equal(t, "t1", tgt.ID)
+	require.Equal(t, "t1.example.com", tgt.FQDN)
+	require.Equal(t, entry.Labels, tgt.Labels)
+	require.Equal(t, entry.Attributes, tgt.Attributes)
+
+	// The target cannot change the inventory entry.
+	tgt.Labels["board"] = "yosemite"
+	tgt.Attributes["bmc_host"] = "other-bmc"
+	require.Equal(t, "tioga", entry.Labels["board"])
+	require.Equal(t, "t1-bmc", entry.Attributes["bmc_host"])
+
+	require.Nil(t, (&Target{ID: "t2"}).ToTarget().Labels)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package inventory
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operator is the comparison a Requirement makes on a label.
+type Operator string
+
+// The operators of the requirements.
+const (
+	OperatorEquals       Operator = "="
+	OperatorNotEquals    Operator = "!="
+	OperatorExists       Operator = "exists"
+	OperatorDoesNotExist Operator = "!"
+)
+
+// Requirement is a condition on a label of the targets.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	// Value is only used by OperatorEquals and OperatorNotEquals.
+	Value string
+}
+
+// Matches returns whether labels satisfy the requirement. A target without
+// the label satisfies a OperatorNotEquals requirement.
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch r.Operator {
+	case OperatorEquals:
+		return ok && value == r.Value
+	case OperatorNotEquals:
+		return !ok || value != r.Value
+	case OperatorExists:
+		return ok
+	case OperatorDoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+func (r Requirement) String() string {
+	switch r.Operator {
+	case OperatorEquals, OperatorNotEquals:
+		return r.Key + string(r.Operator) + r.Value
+	case OperatorDoesNotExist:
+		return "!" + r.Key
+	}
+	return r.Key
+}
+
+// Selector selects targets by their labels, it matches the targets which
+// satisfy all of its requirements. The empty Selector matches all targets.
+type Selector []Requirement
+
+// Matches returns whether labels satisfy all the requirements of the
+// selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Selector) String() string {
+	parts := make([]string, 0, len(s))
+	for _, r := range s {
+		parts = append(parts, r.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseSelector parses a comma-separated list of requirements, each of them
+// being one of:
+//
+//	key=value   the label is set to value ("==" is also accepted)
+//	key!=value  the label is not set to value, or is not set
+//	key         the label is set
+//	!key        the label is not set
+//
+// e.g. "board=tioga,slot!=b,!broken".
+func ParseSelector(s string) (Selector, error) {
+	var selector Selector
+	if strings.TrimSpace(s) == "" {
+		return selector, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		var r Requirement
+		switch {
+		case strings.Contains(part, "!="):
+			kv := strings.SplitN(part, "!=", 2)
+			r = Requirement{Key: kv[0], Operator: OperatorNotEquals, Value: kv[1]}
+		case strings.Contains(part, "="):
+			kv := strings.SplitN(strings.Replace(part, "==", "=", 1), "=", 2)
+			r = Requirement{Key: kv[0], Operator: OperatorEquals, Value: kv[1]}
+		case strings.HasPrefix(part, "!"):
+			r = Requirement{Key: part[1:], Operator: OperatorDoesNotExist}
+		default:
+			r = Requirement{Key: part, Operator: OperatorExists}
+		}
+		r.Key, r.Value = strings.TrimSpace(r.Key), strings.TrimSpace(r.Value)
+		if !labelRegexp.MatchString(r.Key) {
+			return nil, fmt.Errorf("invalid label key in requirement %q", part)
+		}
+		if r.Value != "" && !labelRegexp.MatchString(r.Value) {
+			return nil, fmt.Errorf("invalid label value in requirement %q", part)
+		}
+		selector = append(selector, r)
+	}
+	return selector, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSelector(t *testing.T) {
+	s, err := ParseSelector(" board==tioga, slot!=b,bmc ,!broken")
+	require.NoError(t, err)
+	require.Equal(t, Selector{
+		{Key: "board", Operator: OperatorEquals, Value: "tioga"},
+		{Key: "slot", Operator: OperatorNotEquals, Value: "b"},
+		{Key: "bmc", Operator: OperatorExists},
+		{Key: "broken", Operator: OperatorDoesNotExist},
+	}, s)
+	require.Equal(t, "board=tioga,slot!=b,bmc,!broken", s.String())
+
+	s, err = ParseSelector("")
+	require.NoError(t, err)
+	require.Empty(t, s)
+
+	for _, invalid := range []string{"=tioga", "board=tio ga", "board,,slot", "!", "a=b=c"} {
+		_, err := ParseSelector(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	s, err := ParseSelector("board=tioga,slot!=b,bmc,!broken")
+	require.NoError(t, err)
+	require.True(t, s.Matches(map[string]string{"board": "tioga", "bmc": ""}))
+	require.True(t, s.Matches(map[string]string{"board": "tioga", "bmc": "", "slot": "a"}))
+	require.False(t, s.Matches(map[string]string{"board": "tioga", "bmc": "", "slot": "b"}))
+	require.False(t, s.Matches(map[string]string{"board": "tioga", "bmc": "", "broken": "yes"}))
+	require.False(t, s.Matches(map[string]string{"board": "tioga"}))
+	require.False(t, s.Matches(nil))
+	require.True(t, Selector(nil).Matches(nil))
+}
+
+func TestTargetValidate(t *testing.T) {
+	require.NoError(t, (&Target{ID: "t1", Labels: map[string]string{"board": "tioga", "bmc": ""}}).Validate())
+	require.Error(t, (&Target{}).Validate())
+	require.Error(t, (&Target{ID: "t1", Labels: map[string]string{"board": "tioga pass"}}).Validate())
+	require.Error(t, (&Target{ID: "t1", Attributes: map[string]string{"": "x"}}).Validate())
+
+	q := Query{IDs: []string{"t1"}, Selector: Selector{{Key: "board", Operator: OperatorExists}}}
+	require.True(t, q.Matches(&Target{ID: "t1", Labels: map[string]string{"board": "tioga"}}))
+	require.False(t, q.Matches(&Target{ID: "t2", Labels: map[string]string{"board": "tioga"}}))
+	require.False(t, q.Matches(&Target{ID: "t1"}))
+}
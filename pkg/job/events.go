@@ -15,6 +15,10 @@ import (
 	"github.com/linuxboot/contest/pkg/types"
 )
 
+// EventJobQueued indicates that a Job has been accepted and is waiting to be
+// started by the JobManager
+var EventJobQueued = event.Name("JobStateQueued")
+
 // EventJobStarted indicates that a Job is beginning execution
 var EventJobStarted = event.Name("JobStateStarted")
 
@@ -48,6 +52,16 @@ var JobCompletionEvents = []event.Name{
 	EventJobCancellationFailed,
 }
 
+// IsJobCompletionEvent returns whether the event marks the end of a job.
+func IsJobCompletionEvent(ev event.Name) bool {
+	for _, e := range JobCompletionEvents {
+		if e == ev {
+			return true
+		}
+	}
+	return false
+}
+
 // JobStateEvents gathers all event names which track the state of a job
 var JobStateEvents = []event.Name{
 	EventJobStarted,
@@ -58,6 +72,7 @@ var JobStateEvents = []event.Name{
 	EventJobCancelling,
 	EventJobCancelled,
 	EventJobCancellationFailed,
+	EventJobQueued,
 }
 
 // States corresponding to events.
@@ -70,6 +85,7 @@ var jobStates = []State{
 	JobStateCancelling,
 	JobStateCancelled,
 	JobStateCancellationFailed,
+	JobStateQueued,
 }
 
 func EventNameToJobState(ev event.Name) (State, error) {
@@ -20,7 +20,7 @@ func TestEventToJobStateMapping(t *testing.T) {
 		require.NoError(t, err)
 		m[st] = e
 	}
-	require.Equal(t, 8, len(m))
+	require.Equal(t, 9, len(m))
 	st, err := EventNameToJobState(event.Name("foo"))
 	require.Error(t, err)
 	require.Equal(t, JobStateUnknown, st)
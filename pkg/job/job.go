@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/test"
 	"github.com/linuxboot/contest/pkg/types"
 
@@ -36,6 +37,10 @@ type Descriptor struct {
 	Reporting                   Reporting
 	TargetManagerAcquireTimeout *xjson.Duration // optional
 	TargetManagerReleaseTimeout *xjson.Duration // optional
+	// Priority orders the job in the queue of the JobManager, higher values
+	// are started first. Jobs with the same priority are started in order of
+	// submission.
+	Priority int
 }
 
 // Validate performs sanity checks on the job descriptor
@@ -97,6 +102,24 @@ func (d *Descriptor) CheckVersion() error {
 	return nil
 }
 
+// DescriptorProblem is a problem found while validating a job descriptor.
+// Path locates the offending field, e.g. "Tags[1]" or
+// "TestDescriptors[0].TestFetcherFetchParameters.Steps[2]". The steps fetched
+// from elsewhere than the descriptor, e.g. by the URI test fetcher, are under
+// "TestDescriptors[0].FetchedTest.Steps". An empty path refers to the whole
+// descriptor.
+type DescriptorProblem struct {
+	Path    string
+	Message string
+}
+
+func (p DescriptorProblem) String() string {
+	if p.Path == "" {
+		return p.Message
+	}
+	return fmt.Sprintf("%s: %s", p.Path, p.Message)
+}
+
 // CurrentDescriptorVersion returns current JobDescriptor version as a string
 // e.g "1.0"
 func CurrentDescriptorVersion() string {
@@ -112,6 +135,9 @@ func CurrentDescriptorVersion() string {
 type ExtendedDescriptor struct {
 	Descriptor
 	TestStepsDescriptors []test.TestStepsDescriptors
+
+	// RetryOf is the ID of the job this one is a retry of, if any.
+	RetryOf types.JobID `json:",omitempty"`
 }
 
 // Job is used to run a type of test job on a given set of targets.
@@ -138,6 +164,10 @@ type Job struct {
 	// TargetManagerReleaseTimeout represents the maximum time that JobManager should wait for the execution of the Release function from the chosen TargetManager.
 	TargetManagerReleaseTimeout time.Duration
 
+	// Priority orders the job in the queue of the JobManager, see
+	// Descriptor.Priority.
+	Priority int
+
 	// ExtendedDescriptor represents the descriptor submitted by the client that
 	// resulted in the creation of this ConTest job.
 	ExtendedDescriptor *ExtendedDescriptor
@@ -164,10 +194,11 @@ const (
 	JobStateCancelling               // 6
 	JobStateCancelled                // 7
 	JobStateCancellationFailed       // 8
+	JobStateQueued                   // 9
 )
 
 func (js State) String() string {
-	if js > 8 {
+	if js > 9 {
 		return fmt.Sprintf("JobState%d", js)
 	}
 	return []string{
@@ -180,9 +211,29 @@ func (js State) String() string {
 		string(EventJobCancelling),
 		string(EventJobCancelled),
 		string(EventJobCancellationFailed),
+		string(EventJobQueued),
 	}[js]
 }
 
+// MarshalText renders the state by name, so that it reads well in JSON.
+func (js State) MarshalText() ([]byte, error) {
+	return []byte(js.String()), nil
+}
+
+// UnmarshalText parses a state rendered by MarshalText.
+func (js *State) UnmarshalText(text []byte) error {
+	if string(text) == JobStateUnknown.String() {
+		*js = JobStateUnknown
+		return nil
+	}
+	st, err := EventNameToJobState(event.Name(text))
+	if err != nil {
+		return err
+	}
+	*js = st
+	return nil
+}
+
 // InfoFetcher defines how to fetch job information
 type InfoFetcher interface {
 	FetchJob(types.JobID) (*Job, error)
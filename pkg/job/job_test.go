@@ -1,6 +1,7 @@
 package job
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -78,3 +79,16 @@ func TestInvalidVersion(t *testing.T) {
 		)
 	}
 }
+
+func TestStateJSON(t *testing.T) {
+	for _, st := range []State{JobStateUnknown, JobStateCompleted, JobStateQueued} {
+		data, err := json.Marshal(st)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("%q", st.String()), string(data))
+		var decoded State
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		require.Equal(t, st, decoded)
+	}
+	var st State
+	require.Error(t, json.Unmarshal([]byte(`"JobStateBogus"`), &st))
+}
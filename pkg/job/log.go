@@ -0,0 +1,26 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package job
+
+import (
+	"time"
+
+	"github.com/linuxboot/contest/pkg/types"
+)
+
+// LogEntry is a line logged by the server while running a job, e.g. by the
+// JobRunner or by the test steps. The log lines of a job are captured so that
+// they can be retrieved after the job has run.
+type LogEntry struct {
+	// SequenceID orders the log entries, it is assigned by the storage
+	// layer and increases across all the jobs.
+	SequenceID uint64
+	JobID      types.JobID
+	Time       time.Time
+	// Level is the name of the log level, e.g. "info" or "error".
+	Level   string
+	Message string
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package job
+
+import (
+	"time"
+
+	"github.com/linuxboot/contest/pkg/types"
+)
+
+// Schedule represents a job descriptor which is submitted periodically, as
+// dictated by a cron expression. It is persisted in storage so that it
+// survives server restarts.
+type Schedule struct {
+	ID types.ScheduleID
+
+	// CronExpression is a five-field cron expression, or one of the
+	// @hourly/@daily/... shortcuts. It is evaluated in UTC.
+	CronExpression string
+
+	// JobDescriptor is the raw descriptor submitted every time the schedule
+	// fires, exactly as with a regular start request.
+	JobDescriptor string
+
+	Requestor  string
+	ServerID   string
+	CreateTime time.Time
+
+	// SkipIfRunning prevents the schedule from submitting a new job while the
+	// job it submitted last is still queued or running.
+	SkipIfRunning bool
+
+	// LastJobID and LastRunTime describe the last job submitted by the
+	// schedule, they are zero if the schedule never fired.
+	LastJobID   types.JobID
+	LastRunTime time.Time
+}
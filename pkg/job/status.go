@@ -8,6 +8,7 @@ package job
 import (
 	"time"
 
+	"github.com/linuxboot/contest/pkg/artifact"
 	"github.com/linuxboot/contest/pkg/event/testevent"
 	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/types"
@@ -45,6 +46,9 @@ type TargetStatus struct {
 	InTime  time.Time
 	OutTime time.Time
 	Error   string
+	// TestStepAttempt is the last attempt of the step, if the step retries
+	// the targets which fail it.
+	TestStepAttempt uint32
 	// these are events that have an associated target. For events
 	// that are not associated to a target, see TestStepStatus.Events .
 	Events []testevent.Event
@@ -101,4 +105,19 @@ type Status struct {
 
 	// Job report information
 	JobReport *JobReport
+
+	// QueuePosition is the 1-based position of the job in the queue of the
+	// JobManager while the job is queued, 0 otherwise.
+	QueuePosition int
+
+	// RetryOf is the ID of the job this one is a retry of, or 0.
+	RetryOf types.JobID
+
+	// Artifacts lists the files uploaded by the test steps of the job, each
+	// with the run, step and target it belongs to.
+	Artifacts []artifact.Artifact
+
+	// TargetWait is set while the job waits for targets to become
+	// available, see target.WaitLocker.
+	TargetWait *target.Waiter `json:",omitempty"`
 }
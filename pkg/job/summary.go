@@ -0,0 +1,32 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package job
+
+import (
+	"time"
+
+	"github.com/linuxboot/contest/pkg/types"
+)
+
+// Summary is the short description of a job returned when listing jobs.
+type Summary struct {
+	JobID     types.JobID
+	Name      string
+	State     State
+	Requestor string
+	ServerID  string
+	// Tags are the tags of the job, sorted.
+	Tags []string
+
+	RequestTime time.Time
+
+	// StartTime indicates when the job was last started. A value of 0
+	// indicates "not started yet".
+	StartTime time.Time
+
+	// EndTime indicates when the job ended, nil if it has not ended yet.
+	EndTime *time.Time
+}
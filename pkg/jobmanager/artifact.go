@@ -0,0 +1,62 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) artifact(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentEventually)
+	msg := ev.Msg.(api.EventArtifactMsg)
+	evResp := &api.EventResponse{
+		JobID:     msg.JobID,
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	if jm.config.artifactStore == nil {
+		evResp.Err = artifact.ErrNoStore
+		return evResp
+	}
+	if _, err := jm.jsm.GetJobRequest(ctx, msg.JobID); err != nil {
+		evResp.Err = fmt.Errorf("failed to fetch request for job ID %d: %w", msg.JobID, err)
+		return evResp
+	}
+	a, rc, err := jm.config.artifactStore.Open(ctx, msg.JobID, msg.ArtifactID)
+	if err != nil {
+		evResp.Err = err
+		return evResp
+	}
+	defer rc.Close()
+	// The whole content goes into the response, so the artifacts which are
+	// too large to be held in memory are refused.
+	maxSize := jm.config.artifactMaxDownload
+	if maxSize > 0 && a.Size > maxSize {
+		evResp.Err = fmt.Errorf("%w: artifact %d is %d bytes, more than the %d bytes which can be downloaded", artifact.ErrTooLarge, msg.ArtifactID, a.Size, maxSize)
+		return evResp
+	}
+	var r io.Reader = rc
+	if maxSize > 0 {
+		r = io.LimitReader(rc, maxSize+1)
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		evResp.Err = fmt.Errorf("could not read artifact %d: %w", msg.ArtifactID, err)
+		return evResp
+	}
+	if maxSize > 0 && int64(len(content)) > maxSize {
+		evResp.Err = fmt.Errorf("%w: artifact %d is more than the %d bytes which can be downloaded", artifact.ErrTooLarge, msg.ArtifactID, maxSize)
+		return evResp
+	}
+	evResp.Artifact = a
+	evResp.ArtifactContent = content
+	return evResp
+}
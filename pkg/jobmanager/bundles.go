@@ -99,5 +99,8 @@ func newStepBundles(ctx xcontext.Context, descriptors test.TestStepsDescriptors,
 		}
 		labels[bundle.TestStepLabel] = true
 	}
+	if _, err := test.StepDependencies(testStepBundles); err != nil {
+		return nil, fmt.Errorf("invalid step dependencies: %w", err)
+	}
 	return testStepBundles, nil
 }
@@ -0,0 +1,39 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) events(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentEventually)
+	msg := ev.Msg.(api.EventEventsMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	if msg.TestQuery != nil {
+		testEvents, err := jm.testEvManager.Fetch(ctx, msg.TestQuery...)
+		if err != nil {
+			evResp.Err = fmt.Errorf("could not fetch test events: %w", err)
+			return evResp
+		}
+		evResp.TestEvents = testEvents
+	}
+	if msg.FrameworkQuery != nil {
+		frameworkEvents, err := jm.frameworkEvManager.Fetch(ctx, msg.FrameworkQuery...)
+		if err != nil {
+			evResp.Err = fmt.Errorf("could not fetch framework events: %w", err)
+			return evResp
+		}
+		evResp.FrameworkEvents = frameworkEvents
+	}
+	return evResp
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) quarantineTarget(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventQuarantineTargetMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	// Quarantines affect the jobs of everybody, only admins may change them.
+	if err := ev.Authorize(""); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+
+	q := health.Quarantine{
+		TargetID:  msg.TargetID,
+		Reason:    msg.Reason,
+		Requestor: string(msg.Requestor()),
+		Time:      jm.config.clock.Now(),
+	}
+	if err := jm.hsm.QuarantineTarget(ctx, &q); err != nil {
+		evResp.Err = fmt.Errorf("could not quarantine target %q: %w", msg.TargetID, err)
+		return evResp
+	}
+	ctx.Infof("Quarantined target %q: %s", msg.TargetID, msg.Reason)
+	evResp.Quarantines = []health.Quarantine{q}
+	return evResp
+}
+
+func (jm *JobManager) releaseTarget(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventReleaseTargetMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	if err := ev.Authorize(""); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+
+	release := health.Release{
+		Reason:    msg.Reason,
+		Requestor: string(msg.Requestor()),
+		Time:      jm.config.clock.Now(),
+	}
+	if err := jm.hsm.ReleaseTarget(ctx, msg.TargetID, &release); err != nil {
+		evResp.Err = fmt.Errorf("could not release target %q: %w", msg.TargetID, err)
+		return evResp
+	}
+	ctx.Infof("Released target %q from quarantine: %s", msg.TargetID, msg.Reason)
+
+	q, err := jm.hsm.GetTargetQuarantine(ctx, msg.TargetID)
+	if err != nil {
+		evResp.Err = fmt.Errorf("could not fetch quarantine of target %q: %w", msg.TargetID, err)
+		return evResp
+	}
+	evResp.Quarantines = []health.Quarantine{*q}
+	return evResp
+}
+
+func (jm *JobManager) listQuarantinedTargets(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentEventually)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	quarantines, err := jm.hsm.ListQuarantinedTargets(ctx)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to list quarantined targets: %w", err)
+		return evResp
+	}
+	evResp.Quarantines = quarantines
+	return evResp
+}
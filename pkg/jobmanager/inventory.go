@@ -0,0 +1,107 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) addTarget(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventAddTargetMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	// The inventory is not owned by anybody, only admins may change it.
+	if err := ev.Authorize(""); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+
+	t := msg.Target
+	t.CreateTime = jm.config.clock.Now()
+	t.UpdateTime = t.CreateTime
+	if err := jm.ism.AddInventoryTarget(ctx, &t); err != nil {
+		evResp.Err = fmt.Errorf("could not add target %q: %w", t.ID, err)
+		return evResp
+	}
+	ctx.Infof("Added target %q to the inventory", t.ID)
+	return jm.inventoryTarget(ev, evResp, t.ID)
+}
+
+func (jm *JobManager) updateTarget(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventUpdateTargetMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	if err := ev.Authorize(""); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+
+	t := msg.Target
+	t.UpdateTime = jm.config.clock.Now()
+	if err := jm.ism.UpdateInventoryTarget(ctx, &t); err != nil {
+		evResp.Err = fmt.Errorf("could not update target %q: %w", t.ID, err)
+		return evResp
+	}
+	ctx.Infof("Updated target %q of the inventory", t.ID)
+	return jm.inventoryTarget(ev, evResp, t.ID)
+}
+
+// inventoryTarget sets the stored version of a target in the response, so
+// that clients get the fields filled in by the storage, e.g. the creation
+// time of an updated target.
+func (jm *JobManager) inventoryTarget(ev *api.Event, evResp *api.EventResponse, targetID string) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	targets, err := jm.ism.ListInventoryTargets(ctx, &inventory.Query{IDs: []string{targetID}})
+	if err != nil {
+		evResp.Err = fmt.Errorf("could not fetch target %q: %w", targetID, err)
+		return evResp
+	}
+	evResp.InventoryTargets = targets
+	return evResp
+}
+
+func (jm *JobManager) removeTarget(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventRemoveTargetMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	if err := ev.Authorize(""); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+
+	if err := jm.ism.DeleteInventoryTarget(ctx, msg.TargetID); err != nil {
+		evResp.Err = fmt.Errorf("could not remove target %q: %w", msg.TargetID, err)
+		return evResp
+	}
+	ctx.Infof("Removed target %q from the inventory", msg.TargetID)
+	return evResp
+}
+
+func (jm *JobManager) listTargets(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentEventually)
+	msg := ev.Msg.(api.EventListTargetsMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	targets, err := jm.ism.ListInventoryTargets(ctx, &inventory.Query{Selector: msg.Selector})
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to list targets: %w", err)
+		return evResp
+	}
+	evResp.InventoryTargets = targets
+	return evResp
+}
@@ -85,6 +85,7 @@ func newJob(ctx xcontext.Context, registry *pluginregistry.PluginRegistry, jobDe
 			TestFetcherBundle:   bundleTestFetcher,
 			TestStepsBundles:    bundleTest,
 			RetryParameters:     td.RetryParameters,
+			TargetIDs:           td.TargetIDs,
 		}
 		tests = append(tests, &test)
 	}
@@ -111,6 +112,7 @@ func newJob(ctx xcontext.Context, registry *pluginregistry.PluginRegistry, jobDe
 		Name:                        jobDescriptor.JobName,
 		Tags:                        jobDescriptor.Tags,
 		Runs:                        jobDescriptor.Runs,
+		Priority:                    jobDescriptor.Priority,
 		RunInterval:                 time.Duration(jobDescriptor.RunInterval),
 		TargetManagerAcquireTimeout: targetManagerAcquireTimeout,
 		TargetManagerReleaseTimeout: targetManagerReleaseTimeout,
@@ -0,0 +1,151 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
+)
+
+const (
+	// logFlushInterval is how often the captured log lines of a running job
+	// are written to storage.
+	logFlushInterval = time.Second
+	// maxLogMessageLen is the size in bytes above which captured log
+	// messages are truncated.
+	maxLogMessageLen = 4096
+)
+
+// logCapture collects the lines logged while running a job and writes them
+// to storage in batches, so that logging never waits for the storage layer.
+type logCapture struct {
+	ctx        xcontext.Context
+	ls         storage.LogStorage
+	jobID      types.JobID
+	maxEntries uint
+
+	mu        sync.Mutex
+	pending   []job.LogEntry
+	captured  uint
+	truncated bool
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// captureLogs returns a context whose logger also captures the lines logged
+// for the job, along with a function to call once the job is over, which
+// writes the remaining lines to storage. The context is returned unchanged
+// if job logs are not captured.
+func (jm *JobManager) captureLogs(ctx xcontext.Context, jobID types.JobID) (xcontext.Context, func()) {
+	if jm.config.jobLogLevel == logger.LevelUndefined || ctx.Logger() == nil {
+		return ctx, func() {}
+	}
+	c := &logCapture{
+		// Storage is accessed with the original context, as what it logs
+		// must not be captured again.
+		ctx:        ctx,
+		ls:         jm.lsm,
+		jobID:      jobID,
+		maxEntries: jm.config.jobLogMaxEntries,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	if c.maxEntries > 0 {
+		c.seed()
+	}
+	go c.flushLoop()
+	return ctx.WithLogger(logger.WithHook(ctx.Logger(), jm.config.jobLogLevel, c.hook)), c.close
+}
+
+// seed accounts for the lines stored by earlier runs of a resumed job, so
+// that the job as a whole never stores more than maxEntries lines.
+func (c *logCapture) seed() {
+	// At most maxEntries lines and the truncation notice are stored.
+	stored, err := c.ls.GetJobLogs(c.ctx, &storage.LogQuery{JobID: c.jobID, Limit: c.maxEntries + 1})
+	if err != nil {
+		c.ctx.Warnf("Could not count the stored log lines of job %d: %v", c.jobID, err)
+		return
+	}
+	c.captured = uint(len(stored))
+	if c.captured > c.maxEntries {
+		c.captured = c.maxEntries
+		c.truncated = true
+	}
+}
+
+func (c *logCapture) hook(level logger.Level, message string) {
+	if len(message) > maxLogMessageLen {
+		// Cut at a rune boundary so that the message stays valid UTF-8.
+		n := maxLogMessageLen
+		for n > 0 && !utf8.RuneStart(message[n]) {
+			n--
+		}
+		message = message[:n] + "... (truncated)"
+	}
+	entry := job.LogEntry{
+		JobID:   c.jobID,
+		Time:    time.Now(),
+		Level:   level.String(),
+		Message: message,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.maxEntries > 0 && c.captured >= c.maxEntries {
+		if !c.truncated {
+			// Record once that the following lines were dropped.
+			c.truncated = true
+			entry.Level = logger.LevelWarning.String()
+			entry.Message = fmt.Sprintf("Log truncated after %d lines", c.maxEntries)
+			c.pending = append(c.pending, entry)
+		}
+		return
+	}
+	c.captured++
+	c.pending = append(c.pending, entry)
+}
+
+func (c *logCapture) flushLoop() {
+	defer close(c.done)
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.flush()
+		case <-c.stop:
+			c.flush()
+			return
+		}
+	}
+}
+
+func (c *logCapture) flush() {
+	c.mu.Lock()
+	entries := c.pending
+	c.pending = nil
+	c.mu.Unlock()
+	if len(entries) == 0 {
+		return
+	}
+	if err := c.ls.StoreJobLogs(c.ctx, entries); err != nil {
+		c.ctx.Warnf("Could not store %d log lines of job %d: %v", len(entries), c.jobID, err)
+	}
+}
+
+func (c *logCapture) close() {
+	close(c.stop)
+	<-c.done
+}
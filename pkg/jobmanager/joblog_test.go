@@ -0,0 +1,76 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/plugins/storage/memory"
+)
+
+func newLogCapture(ctx xcontext.Context, ls storage.LogStorage, jobID types.JobID, maxEntries uint) *logCapture {
+	c := &logCapture{ctx: ctx, ls: ls, jobID: jobID, maxEntries: maxEntries}
+	if maxEntries > 0 {
+		c.seed()
+	}
+	return c
+}
+
+func TestLogCaptureTruncatesAtRuneBoundary(t *testing.T) {
+	ctx := xcontext.Background()
+	ls, err := memory.New()
+	require.NoError(t, err)
+
+	c := newLogCapture(ctx, ls, 1, 0)
+	// The 2-byte rune straddles maxLogMessageLen.
+	c.hook(logger.LevelInfo, strings.Repeat("a", maxLogMessageLen-1)+strings.Repeat("é", 2))
+	c.flush()
+
+	entries, err := ls.GetJobLogs(ctx, &storage.LogQuery{JobID: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.True(t, utf8.ValidString(entries[0].Message))
+	require.Equal(t, strings.Repeat("a", maxLogMessageLen-1)+"... (truncated)", entries[0].Message)
+}
+
+func TestLogCaptureMaxEntriesAcrossResume(t *testing.T) {
+	ctx := xcontext.Background()
+	ls, err := memory.New()
+	require.NoError(t, err)
+
+	c := newLogCapture(ctx, ls, 1, 3)
+	c.hook(logger.LevelInfo, "first")
+	c.hook(logger.LevelInfo, "second")
+	c.flush()
+
+	// The job is resumed, only one more line fits.
+	c = newLogCapture(ctx, ls, 1, 3)
+	c.hook(logger.LevelInfo, "third")
+	c.hook(logger.LevelInfo, "fourth")
+	c.hook(logger.LevelInfo, "fifth")
+	c.flush()
+
+	// Resumed again, the truncation notice is not repeated.
+	c = newLogCapture(ctx, ls, 1, 3)
+	c.hook(logger.LevelInfo, "sixth")
+	c.flush()
+
+	entries, err := ls.GetJobLogs(ctx, &storage.LogQuery{JobID: 1})
+	require.NoError(t, err)
+	var messages []string
+	for _, entry := range entries {
+		messages = append(messages, entry.Message)
+	}
+	require.Equal(t, []string{"first", "second", "third", "Log truncated after 3 lines"}, messages)
+}
@@ -17,13 +17,16 @@ import (
 	"github.com/linuxboot/contest/pkg/api"
 	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
 	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/pluginregistry"
 	"github.com/linuxboot/contest/pkg/runner"
 	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/metrics/perf"
 )
 
 // ErrorEventPayload represents the payload carried by a failure event (e.g. JobStateFailed, JobStateCancelled, etc.)
@@ -39,7 +42,13 @@ type ErrorEventPayload struct {
 // * fetching targets, via target managers
 // * fetching test definitions, via test fetchers
 // * enqueuing new job requests, and handling their status
-// * starting, stopping, and retrying jobs
+// * starting, stopping, pausing, resuming and retrying jobs
+// * submitting jobs periodically, as configured by schedules
+// * serving the artifacts uploaded by the test steps
+// * capturing the lines logged while running each job
+// * managing the inventory of targets jobs can select by label
+// * tracking the health of the targets, and quarantining the broken ones
+// * inspecting the target locks, and overriding them on admin request
 type JobManager struct {
 	config
 
@@ -48,11 +57,29 @@ type JobManager struct {
 
 	jobsMu sync.Mutex
 
+	// queue holds the jobs waiting to be started, in start order. It is
+	// protected by jobsMu as well.
+	queue       []*queuedJob
+	queueClosed bool
+
 	jsm storage.JobStorageManager
+	ssm storage.ScheduleStorageManager
+	lsm storage.LogStorageManager
+	ism storage.InventoryStorageManager
+	hsm storage.HealthStorageManager
+
+	// healthTracker records the outcomes of the targets of the jobs.
+	healthTracker *health.Tracker
+
+	// scheduleCh wakes up the scheduler when the schedules change.
+	scheduleCh chan struct{}
 
 	frameworkEvManager frameworkevent.EmitterFetcher
 	testEvManager      testevent.Fetcher
 
+	// eventBroker notifies the subscriptions to a job about its events.
+	eventBroker *stream.Broker
+
 	apiListener    api.Listener
 	pluginRegistry *pluginregistry.PluginRegistry
 
@@ -73,7 +100,8 @@ func New(l api.Listener, pr *pluginregistry.PluginRegistry, storageEngineVault s
 	}
 	jsm := storage.NewJobStorageManager(storageEngineVault)
 
-	frameworkEvManager := storage.NewFrameworkEventEmitterFetcher(storageEngineVault)
+	eventBroker := stream.NewBroker()
+	frameworkEvManager := eventBroker.FrameworkEventEmitterFetcher(storage.NewFrameworkEventEmitterFetcher(storageEngineVault))
 	testEvManager := storage.NewTestEventFetcher(storageEngineVault)
 
 	cfg := getConfig(opts...)
@@ -86,16 +114,25 @@ func New(l api.Listener, pr *pluginregistry.PluginRegistry, storageEngineVault s
 		}
 	}
 
+	hsm := storage.NewHealthStorageManager(storageEngineVault)
+
 	jm := JobManager{
 		config:             cfg,
 		apiListener:        l,
 		pluginRegistry:     pr,
 		jobs:               make(map[types.JobID]*jobInfo),
 		jsm:                jsm,
+		ssm:                storage.NewScheduleStorageManager(storageEngineVault),
+		lsm:                storage.NewLogStorageManager(storageEngineVault),
+		ism:                storage.NewInventoryStorageManager(storageEngineVault),
+		hsm:                hsm,
+		healthTracker:      health.NewTracker(hsm, cfg.clock, cfg.quarantineThreshold),
+		scheduleCh:         make(chan struct{}, 1),
 		frameworkEvManager: frameworkEvManager,
 		testEvManager:      testEvManager,
+		eventBroker:        eventBroker,
 	}
-	jm.jobRunner = runner.NewJobRunner(jsm, storageEngineVault, cfg.clock, cfg.targetLockDuration)
+	jm.jobRunner = runner.NewJobRunner(jsm, storageEngineVault, cfg.clock, cfg.targetLockDuration, cfg.pausedLockTimeout, eventBroker)
 	return &jm, nil
 }
 
@@ -105,14 +142,54 @@ func (jm *JobManager) handleEvent(ev *api.Event) {
 	switch ev.Type {
 	case api.EventTypeStart:
 		resp = jm.start(ev)
+	case api.EventTypeValidate:
+		resp = jm.validate(ev)
 	case api.EventTypeStatus:
 		resp = jm.status(ev)
 	case api.EventTypeStop:
 		resp = jm.stop(ev)
 	case api.EventTypeRetry:
 		resp = jm.retry(ev)
+	case api.EventTypePause:
+		resp = jm.pause(ev)
+	case api.EventTypeResume:
+		resp = jm.resume(ev)
 	case api.EventTypeList:
 		resp = jm.list(ev)
+	case api.EventTypeSchedule:
+		resp = jm.schedule(ev)
+	case api.EventTypeUnschedule:
+		resp = jm.unschedule(ev)
+	case api.EventTypeListSchedules:
+		resp = jm.listSchedules(ev)
+	case api.EventTypeJUnit:
+		resp = jm.junit(ev)
+	case api.EventTypeArtifact:
+		resp = jm.artifact(ev)
+	case api.EventTypeLogs:
+		resp = jm.logs(ev)
+	case api.EventTypeSubscribe:
+		resp = jm.subscribe(ev)
+	case api.EventTypeEvents:
+		resp = jm.events(ev)
+	case api.EventTypeAddTarget:
+		resp = jm.addTarget(ev)
+	case api.EventTypeUpdateTarget:
+		resp = jm.updateTarget(ev)
+	case api.EventTypeRemoveTarget:
+		resp = jm.removeTarget(ev)
+	case api.EventTypeListTargets:
+		resp = jm.listTargets(ev)
+	case api.EventTypeQuarantineTarget:
+		resp = jm.quarantineTarget(ev)
+	case api.EventTypeReleaseTarget:
+		resp = jm.releaseTarget(ev)
+	case api.EventTypeListQuarantinedTargets:
+		resp = jm.listQuarantinedTargets(ev)
+	case api.EventTypeListLocks:
+		resp = jm.listLocks(ev)
+	case api.EventTypeForceUnlock:
+		resp = jm.forceUnlock(ev)
 	default:
 		resp = &api.EventResponse{
 			Requestor: ev.Msg.Requestor(),
@@ -149,7 +226,7 @@ func (jm *JobManager) Run(ctx xcontext.Context, resumeJobs bool) error {
 		ctx.Errorf("failed to fail jobs: %v", err)
 	}
 
-	// First, resume paused jobs.
+	// First, resume paused jobs, then queue again the jobs that were waiting.
 	if resumeJobs {
 		if err := jm.resumeJobs(ctx, a.ServerID()); err != nil {
 			return fmt.Errorf("failed to resume jobs: %w", err)
@@ -159,6 +236,14 @@ func (jm *JobManager) Run(ctx xcontext.Context, resumeJobs bool) error {
 	apiCtx, apiCancel := xcontext.WithCancel(ctx)
 	jm.apiCancel = apiCancel
 
+	// The scheduler submits jobs like the API does, so it follows its
+	// lifecycle.
+	schedulerDone := make(chan struct{})
+	go func() {
+		jm.runScheduler(apiCtx, a.ServerID())
+		close(schedulerDone)
+	}()
+
 	errCh := make(chan error, 1)
 	go func() {
 		lErr := jm.apiListener.Serve(apiCtx, a)
@@ -193,8 +278,12 @@ loop:
 			break loop
 		}
 	}
-	// Stop the API (if not already)
+	// Stop the API (if not already) and stop starting queued jobs.
 	jm.StopAPI()
+	<-schedulerDone
+	jm.jobsMu.Lock()
+	jm.closeQueueLocked()
+	jm.jobsMu.Unlock()
 	<-errCh
 	// Wait for event handler completion
 	handlerWg.Wait()
@@ -253,7 +342,11 @@ func (jm *JobManager) listMyJobs(ctx xcontext.Context, serverID string, jobState
 	if err != nil {
 		return nil, fmt.Errorf("failed to list jobs: %w", err)
 	}
-	return jobs, nil
+	jobIDs := make([]types.JobID, 0, len(jobs))
+	for _, summary := range jobs {
+		jobIDs = append(jobIDs, summary.JobID)
+	}
+	return jobIDs, nil
 }
 
 func (jm *JobManager) checkIdle(ctx xcontext.Context) bool {
@@ -292,6 +385,7 @@ func (jm *JobManager) StopAPI() {
 func (jm *JobManager) CancelAll(ctx xcontext.Context) {
 	jm.jobsMu.Lock()
 	defer jm.jobsMu.Unlock()
+	jm.closeQueueLocked()
 	for jobID, ji := range jm.jobs {
 		ctx.Debugf("JobManager: cancelling job %d", jobID)
 		ji.cancel()
@@ -302,6 +396,7 @@ func (jm *JobManager) CancelAll(ctx xcontext.Context) {
 func (jm *JobManager) PauseAll(ctx xcontext.Context) {
 	jm.jobsMu.Lock()
 	defer jm.jobsMu.Unlock()
+	jm.closeQueueLocked()
 	for jobID, ji := range jm.jobs {
 		ctx.Debugf("JobManager: pausing job %d", jobID)
 		ji.pause()
@@ -327,6 +422,11 @@ func (jm *JobManager) emitEventPayload(ctx xcontext.Context, jobID types.JobID,
 		ctx.Warnf("Could not emit event %s for job %d: %v", eventName, jobID, err)
 		return err
 	}
+	if state, err := job.EventNameToJobState(eventName); err == nil {
+		if metrics := ctx.Metrics(); metrics != nil {
+			metrics.WithTags(nil).WithTag(perf.TAG_JOB_STATE, state.String()).Count(perf.JOB_STATES).Add(1)
+		}
+	}
 	return nil
 }
 
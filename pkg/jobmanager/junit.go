@@ -0,0 +1,79 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/lib/junit"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) junit(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentEventually)
+	msg := ev.Msg.(api.EventJUnitMsg)
+	evResp := &api.EventResponse{
+		JobID:     msg.JobID,
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	if _, err := jm.jsm.GetJobRequest(ctx, msg.JobID); err != nil {
+		evResp.Err = fmt.Errorf("failed to fetch request for job ID %d: %w", msg.JobID, err)
+		return evResp
+	}
+	report, err := jm.jsm.GetJobReport(ctx, msg.JobID)
+	if err != nil {
+		evResp.Err = fmt.Errorf("could not fetch job report: %w", err)
+		return evResp
+	}
+
+	// Final reports cover all the runs, fall back to the run reports while
+	// the job is still running or if it has no final JUnit reporter.
+	docs, err := junitDocs(report.FinalReports)
+	if err != nil {
+		evResp.Err = err
+		return evResp
+	}
+	if len(docs) == 0 {
+		for _, runReports := range report.RunReports {
+			runDocs, err := junitDocs(runReports)
+			if err != nil {
+				evResp.Err = err
+				return evResp
+			}
+			docs = append(docs, runDocs...)
+		}
+	}
+	if len(docs) == 0 {
+		evResp.Err = fmt.Errorf("job %d has no JUnit report", msg.JobID)
+		return evResp
+	}
+	evResp.JUnit, evResp.Err = junit.Merge(docs[0].Name, docs...).Marshal()
+	return evResp
+}
+
+// junitDocs returns the JUnit XML documents of the reports of the JUnit
+// reporter, the reports of other reporters are ignored.
+func junitDocs(reports []*job.Report) ([]*junit.TestSuites, error) {
+	var docs []*junit.TestSuites
+	for _, report := range reports {
+		if report.ReporterName != junit.ReporterName {
+			continue
+		}
+		data, ok := report.Data.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s report, expected a string, got %T", report.ReporterName, report.Data)
+		}
+		doc, err := junit.Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s report: %w", report.ReporterName, err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
@@ -0,0 +1,31 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/lib/junit"
+)
+
+func TestJUnitDocs(t *testing.T) {
+	doc := `<testsuites name="job"><testsuite name="test"></testsuite></testsuites>`
+	docs, err := junitDocs([]*job.Report{
+		{ReporterName: "TargetSuccess", Data: "<testsuites>not JUnit</testsuites>"},
+		{ReporterName: junit.ReporterName, Data: doc},
+	})
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+	require.Equal(t, "job", docs[0].Name)
+
+	_, err = junitDocs([]*job.Report{{ReporterName: junit.ReporterName, Data: "not JUnit"}})
+	require.Error(t, err)
+	_, err = junitDocs([]*job.Report{{ReporterName: junit.ReporterName, Data: 42}})
+	require.Error(t, err)
+}
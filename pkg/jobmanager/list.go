@@ -32,6 +32,6 @@ func (jm *JobManager) list(ev *api.Event) *api.EventResponse {
 		evResp.Err = fmt.Errorf("failed to list jobs: %w", err)
 		return evResp
 	}
-	evResp.JobIDs = res
+	evResp.Jobs = res
 	return evResp
 }
@@ -0,0 +1,103 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/target"
+)
+
+// lockLister returns the target locker if it can list its locks.
+func lockLister() (target.LockLister, error) {
+	ll, ok := target.GetLocker().(target.LockLister)
+	if !ok {
+		return nil, errors.New("the target locker cannot list its locks")
+	}
+	return ll, nil
+}
+
+func (jm *JobManager) listLocks(ev *api.Event) *api.EventResponse {
+	msg := ev.Msg.(api.EventListLocksMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	if err := ev.Authorize(""); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+
+	ll, err := lockLister()
+	if err != nil {
+		evResp.Err = err
+		return evResp
+	}
+	locks, err := ll.ListLocks(ev.Context, msg.TargetIDs)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to list locks: %w", err)
+		return evResp
+	}
+	evResp.Locks = locks
+	return evResp
+}
+
+func (jm *JobManager) forceUnlock(ev *api.Event) *api.EventResponse {
+	ctx := ev.Context
+	msg := ev.Msg.(api.EventForceUnlockMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	// Overriding a lock affects the job of somebody else, only admins may
+	// do it.
+	if err := ev.Authorize(""); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+
+	ll, err := lockLister()
+	if err != nil {
+		evResp.Err = err
+		return evResp
+	}
+	locks, err := ll.ListLocks(ctx, msg.TargetIDs)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to list locks: %w", err)
+		return evResp
+	}
+	locked := make(map[string]bool, len(locks))
+	for _, l := range locks {
+		locked[l.TargetID] = true
+	}
+	for _, targetID := range msg.TargetIDs {
+		if !locked[targetID] {
+			evResp.Err = fmt.Errorf("target %q is not locked", targetID)
+			return evResp
+		}
+	}
+
+	for _, l := range locks {
+		// The override is recorded before it happens, so that none goes
+		// unaudited.
+		payload := target.ForceUnlockPayload{
+			Lock:      l,
+			Requestor: string(msg.Requestor()),
+			Reason:    msg.Reason,
+		}
+		if err := jm.emitEventPayload(ctx, l.JobID, target.EventTargetForceUnlocked, &payload); err != nil {
+			evResp.Err = fmt.Errorf("could not record the forced unlock of target %q: %w", l.TargetID, err)
+			return evResp
+		}
+		if err := ll.Unlock(ctx, l.JobID, []*target.Target{{ID: l.TargetID}}); err != nil {
+			evResp.Err = fmt.Errorf("could not unlock target %q held by job %d: %w", l.TargetID, l.JobID, err)
+			return evResp
+		}
+		ctx.Warnf("Target %q of job %d forcibly unlocked by %s: %s", l.TargetID, l.JobID, msg.Requestor(), msg.Reason)
+		evResp.Locks = append(evResp.Locks, l)
+	}
+	return evResp
+}
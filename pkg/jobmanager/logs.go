@@ -0,0 +1,47 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) logs(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentEventually)
+	msg := ev.Msg.(api.EventLogsMsg)
+	evResp := &api.EventResponse{
+		JobID:     msg.JobID,
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	if _, err := jm.jsm.GetJobRequest(ctx, msg.JobID); err != nil {
+		evResp.Err = fmt.Errorf("failed to fetch request for job ID %d: %w", msg.JobID, err)
+		return evResp
+	}
+	// Check whether the job is over before fetching the entries, the last
+	// ones are stored before the job leaves the running jobs.
+	jm.jobsMu.Lock()
+	_, running := jm.jobs[msg.JobID]
+	done := !running && jm.queuePositionLocked(msg.JobID) == 0
+	jm.jobsMu.Unlock()
+
+	entries, err := jm.lsm.GetJobLogs(ctx, &storage.LogQuery{
+		JobID:           msg.JobID,
+		AfterSequenceID: msg.AfterSequenceID,
+		Limit:           msg.Limit,
+	})
+	if err != nil {
+		evResp.Err = fmt.Errorf("could not get logs of job %d: %w", msg.JobID, err)
+		return evResp
+	}
+	evResp.Logs = entries
+	// More entries may be left if the limit was reached.
+	evResp.LogsDone = done && (msg.Limit == 0 || uint(len(entries)) < msg.Limit)
+	return evResp
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/benbjohnson/clock"
 
 	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/artifact"
 	configPkg "github.com/linuxboot/contest/pkg/config"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
 )
 
 // Option is an additional argument to method New to change the behavior
@@ -21,10 +23,18 @@ type Option interface {
 }
 
 type config struct {
-	apiOptions         []api.Option
-	instanceTag        string
-	targetLockDuration time.Duration
-	clock              clock.Clock
+	apiOptions           []api.Option
+	instanceTag          string
+	targetLockDuration   time.Duration
+	pausedLockTimeout    time.Duration
+	clock                clock.Clock
+	maxConcurrentJobs    uint
+	tagConcurrencyLimits map[string]uint
+	artifactStore        artifact.Store
+	artifactMaxDownload  int64
+	jobLogLevel          logger.Level
+	jobLogMaxEntries     uint
+	quarantineThreshold  uint
 }
 
 // OptionAPI wraps api.Option to implement Option.
@@ -57,6 +67,95 @@ func (opt OptionTargetLockDuration) apply(config *config) {
 	config.targetLockDuration = time.Duration(opt)
 }
 
+// DefaultPausedLockTimeout is the default amount of time a paused job keeps
+// its targets locked.
+const DefaultPausedLockTimeout = 24 * time.Hour
+
+// OptionPausedLockTimeout limits the amount of time a paused job keeps its
+// targets locked, DefaultPausedLockTimeout by default. Zero means the locks
+// are kept until the server exits.
+type OptionPausedLockTimeout time.Duration
+
+func (opt OptionPausedLockTimeout) apply(config *config) {
+	config.pausedLockTimeout = time.Duration(opt)
+}
+
+// OptionMaxConcurrentJobs limits the number of jobs this JobManager runs at
+// the same time. Jobs beyond the limit are queued. Zero means no limit.
+type OptionMaxConcurrentJobs uint
+
+func (opt OptionMaxConcurrentJobs) apply(config *config) {
+	config.maxConcurrentJobs = uint(opt)
+}
+
+// OptionTagConcurrencyLimit limits the number of running jobs carrying the
+// given tag. It can be specified multiple times, once per tag.
+type OptionTagConcurrencyLimit struct {
+	Tag string
+	Max uint
+}
+
+func (opt OptionTagConcurrencyLimit) apply(config *config) {
+	if config.tagConcurrencyLimits == nil {
+		config.tagConcurrencyLimits = make(map[string]uint)
+	}
+	config.tagConcurrencyLimits[opt.Tag] = opt.Max
+}
+
+type optionArtifactStore struct {
+	store artifact.Store
+}
+
+func (opt optionArtifactStore) apply(config *config) {
+	config.artifactStore = opt.store
+}
+
+// OptionArtifactStore sets the store the test steps upload their artifacts
+// to. Without it, the test steps cannot upload artifacts.
+func OptionArtifactStore(store artifact.Store) Option {
+	return optionArtifactStore{store: store}
+}
+
+// DefaultArtifactMaxDownloadSize is the default size, in bytes, of the
+// largest artifact which can be downloaded through the API.
+const DefaultArtifactMaxDownloadSize = 64 << 20
+
+// OptionArtifactMaxDownloadSize sets the size, in bytes, of the largest
+// artifact which can be downloaded through the API, as the content is held in
+// memory by the API response. Zero means no limit.
+type OptionArtifactMaxDownloadSize int64
+
+func (opt OptionArtifactMaxDownloadSize) apply(config *config) {
+	config.artifactMaxDownload = int64(opt)
+}
+
+type optionJobLogs struct {
+	level      logger.Level
+	maxEntries uint
+}
+
+func (opt optionJobLogs) apply(config *config) {
+	config.jobLogLevel = opt.level
+	config.jobLogMaxEntries = opt.maxEntries
+}
+
+// OptionJobLogs enables the capture of the lines logged while running each
+// job, so that they can be retrieved with the logs API. Only the lines of the
+// given level or more severe are captured, and at most maxEntries lines are
+// kept per job. Zero means no limit. Without it, job logs are not captured.
+func OptionJobLogs(level logger.Level, maxEntries uint) Option {
+	return optionJobLogs{level: level, maxEntries: maxEntries}
+}
+
+// OptionQuarantineThreshold quarantines the targets after the given number of
+// consecutive infrastructure failures, see target.ErrInfrastructure. Zero,
+// the default, never quarantines targets automatically.
+type OptionQuarantineThreshold uint
+
+func (opt OptionQuarantineThreshold) apply(config *config) {
+	config.quarantineThreshold = uint(opt)
+}
+
 type optionClock struct {
 	clock clock.Clock
 }
@@ -73,8 +172,10 @@ func OptionClock(clk clock.Clock) Option {
 // getConfig converts a set of Option-s into one structure "Config".
 func getConfig(opts ...Option) config {
 	result := config{
-		targetLockDuration: configPkg.DefaultTargetLockDuration,
-		clock:              clock.New(),
+		targetLockDuration:  configPkg.DefaultTargetLockDuration,
+		pausedLockTimeout:   DefaultPausedLockTimeout,
+		clock:               clock.New(),
+		artifactMaxDownload: DefaultArtifactMaxDownloadSize,
 	}
 	for _, opt := range opts {
 		opt.apply(&result)
@@ -0,0 +1,120 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) pause(ev *api.Event) *api.EventResponse {
+	ctx := ev.Context
+	msg := ev.Msg.(api.EventPauseMsg)
+	jobID := msg.JobID
+	req, err := jm.jsm.GetJobRequest(storage.WithConsistencyModel(ctx, storage.ConsistentReadAfterWrite), jobID)
+	if err != nil {
+		return &api.EventResponse{Err: fmt.Errorf("could not fetch request for job %d: %w", jobID, err)}
+	}
+	if err := ev.Authorize(api.EventRequestor(req.Requestor)); err != nil {
+		return &api.EventResponse{Err: err}
+	}
+	jm.jobsMu.Lock()
+	defer jm.jobsMu.Unlock()
+	// Only the jobs we are actively handling can be paused, queued jobs have
+	// nothing to save yet.
+	ji, ok := jm.jobs[jobID]
+	if !ok {
+		if jm.queuePositionLocked(jobID) > 0 {
+			return &api.EventResponse{Err: fmt.Errorf("job %d is queued, it cannot be paused", jobID)}
+		}
+		return &api.EventResponse{Err: fmt.Errorf("job %d is not running", jobID)}
+	}
+	// Pausing is asynchronous like cancellation: the JobRunner saves the
+	// state of the job and keeps its targets locked, then JobStatePaused is
+	// emitted.
+	ctx.Infof("Pausing job %d", jobID)
+	ji.pause()
+	return &api.EventResponse{
+		JobID:     jobID,
+		Requestor: ev.Msg.Requestor(),
+		Status: &job.Status{
+			Name:  req.JobName,
+			State: string(job.EventJobPaused),
+		},
+	}
+}
+
+func (jm *JobManager) resume(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventResumeMsg)
+	jobID := msg.JobID
+	req, err := jm.jsm.GetJobRequest(ctx, jobID)
+	if err != nil {
+		return &api.EventResponse{Err: fmt.Errorf("could not fetch request for job %d: %w", jobID, err)}
+	}
+	if err := ev.Authorize(api.EventRequestor(req.Requestor)); err != nil {
+		return &api.EventResponse{Err: err}
+	}
+	if req.ExtendedDescriptor == nil {
+		return &api.EventResponse{Err: fmt.Errorf("job %d has no extended descriptor, cannot resume", jobID)}
+	}
+	// The paused state and the targets of the job are only handed over to the
+	// server which ran it, like on server restart.
+	if req.ServerID != ev.ServerID {
+		return &api.EventResponse{Err: fmt.Errorf("job %d was paused by server %q, this is %q", jobID, req.ServerID, ev.ServerID)}
+	}
+	if err := jm.checkInstance(jobID, req.ExtendedDescriptor); err != nil {
+		return &api.EventResponse{Err: err}
+	}
+	last, err := jm.lastJobStateEvent(ctx, jobID)
+	if err != nil {
+		return &api.EventResponse{Err: err}
+	}
+	if last.EventName != job.EventJobPaused {
+		return &api.EventResponse{Err: fmt.Errorf("job %d is not paused (state %s)", jobID, last.EventName)}
+	}
+	j, resumeState, err := jm.loadPausedJob(ctx, jobID)
+	if err != nil {
+		return &api.EventResponse{Err: fmt.Errorf("could not resume job %d: %w", jobID, err)}
+	}
+
+	jm.jobsMu.Lock()
+	defer jm.jobsMu.Unlock()
+	if jm.queueClosed {
+		return &api.EventResponse{Err: fmt.Errorf("server is shutting down, cannot resume job %d", jobID)}
+	}
+	// The job may still be winding down after a pause, or be resumed by a
+	// concurrent request.
+	if _, running := jm.jobs[jobID]; running {
+		return &api.EventResponse{Err: fmt.Errorf("job %d is still running", jobID)}
+	}
+	// It may also have been stopped meanwhile, see stopPausedJob.
+	if last, err := jm.lastJobStateEvent(ctx, jobID); err != nil || last.EventName != job.EventJobPaused {
+		return &api.EventResponse{Err: fmt.Errorf("job %d is not paused anymore", jobID)}
+	}
+	// Unlike on server restart, the concurrency limits apply. The job is not
+	// queued as it still holds its targets, the resume can be retried once a
+	// slot is free.
+	if !jm.canStartLocked(j) {
+		return &api.EventResponse{Err: fmt.Errorf("job %d cannot be resumed now, the concurrency limits are reached", jobID)}
+	}
+	// The resumed job re-acquires its targets right away.
+	ctx.Infof("Resuming job %d (run %d, %d targets)", jobID, resumeState.RunID, len(resumeState.Targets))
+	jm.startJobLocked(ev.Context, j, resumeState)
+	return &api.EventResponse{
+		JobID:     jobID,
+		Requestor: ev.Msg.Requestor(),
+		Status: &job.Status{
+			Name:      j.Name,
+			State:     string(job.EventJobStarted),
+			StartTime: time.Now(),
+		},
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// queuedJob is a job which has been accepted and stored, but is waiting for
+// a free slot to be started.
+type queuedJob struct {
+	ctx         xcontext.Context
+	job         *job.Job
+	requestTime time.Time
+}
+
+// before tells whether qj has to be started before other: higher priority
+// first, then older requests first.
+func (qj *queuedJob) before(other *queuedJob) bool {
+	if qj.job.Priority != other.job.Priority {
+		return qj.job.Priority > other.job.Priority
+	}
+	if !qj.requestTime.Equal(other.requestTime) {
+		return qj.requestTime.Before(other.requestTime)
+	}
+	return qj.job.ID < other.job.ID
+}
+
+// submitJob marks a freshly stored job as queued and starts it right away if
+// the concurrency limits allow it. It returns the resulting job status.
+func (jm *JobManager) submitJob(ctx xcontext.Context, j *job.Job, requestTime time.Time) (*job.Status, error) {
+	if err := jm.emitEvent(ctx, j.ID, job.EventJobQueued); err != nil {
+		return nil, fmt.Errorf("could not queue job %d: %w", j.ID, err)
+	}
+	jm.jobsMu.Lock()
+	defer jm.jobsMu.Unlock()
+	jm.queueJobLocked(ctx, j, requestTime)
+	status := &job.Status{
+		Name:  j.Name,
+		State: string(job.EventJobQueued),
+	}
+	if _, ok := jm.jobs[j.ID]; ok {
+		status.State = string(job.EventJobStarted)
+		status.StartTime = time.Now()
+	} else {
+		status.QueuePosition = jm.queuePositionLocked(j.ID)
+	}
+	return status, nil
+}
+
+// requeueJob puts back in the queue a job which was already in the queued
+// state, e.g. when the server restarts.
+func (jm *JobManager) requeueJob(ctx xcontext.Context, jobID types.JobID) error {
+	req, err := jm.jsm.GetJobRequest(ctx, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve job descriptor for %d: %w", jobID, err)
+	}
+	j, err := NewJobFromExtendedDescriptor(ctx, jm.pluginRegistry, req.ExtendedDescriptor)
+	if err != nil {
+		return fmt.Errorf("failed to create job %d: %w", jobID, err)
+	}
+	j.ID = jobID
+	jm.jobsMu.Lock()
+	defer jm.jobsMu.Unlock()
+	jm.queueJobLocked(ctx, j, req.RequestTime)
+	return nil
+}
+
+func (jm *JobManager) queueJobLocked(ctx xcontext.Context, j *job.Job, requestTime time.Time) {
+	qj := &queuedJob{ctx: ctx, job: j, requestTime: requestTime}
+	idx := sort.Search(len(jm.queue), func(i int) bool { return qj.before(jm.queue[i]) })
+	jm.queue = append(jm.queue, nil)
+	copy(jm.queue[idx+1:], jm.queue[idx:])
+	jm.queue[idx] = qj
+	ctx.Debugf("Job %d queued at position %d", j.ID, idx+1)
+	jm.dispatchLocked()
+}
+
+// dispatchLocked starts, in queue order, all the queued jobs that fit within
+// the concurrency limits. A job held back by a tag limit does not prevent
+// jobs with other tags from starting.
+func (jm *JobManager) dispatchLocked() {
+	if jm.queueClosed {
+		return
+	}
+	var remaining []*queuedJob
+	for _, qj := range jm.queue {
+		if jm.canStartLocked(qj.job) {
+			jm.startJobLocked(qj.ctx, qj.job, nil)
+		} else {
+			remaining = append(remaining, qj)
+		}
+	}
+	jm.queue = remaining
+}
+
+func (jm *JobManager) canStartLocked(j *job.Job) bool {
+	if max := jm.config.maxConcurrentJobs; max > 0 && uint(len(jm.jobs)) >= max {
+		return false
+	}
+	for tag, max := range jm.config.tagConcurrencyLimits {
+		if !hasTag(j.Tags, tag) {
+			continue
+		}
+		var running uint
+		for _, ji := range jm.jobs {
+			if hasTag(ji.job.Tags, tag) {
+				running++
+			}
+		}
+		if running >= max {
+			return false
+		}
+	}
+	return true
+}
+
+// queuePosition returns the 1-based position of the job in the queue, or 0
+// if the job is not queued on this instance.
+func (jm *JobManager) queuePosition(jobID types.JobID) int {
+	jm.jobsMu.Lock()
+	defer jm.jobsMu.Unlock()
+	return jm.queuePositionLocked(jobID)
+}
+
+func (jm *JobManager) queuePositionLocked(jobID types.JobID) int {
+	for idx, qj := range jm.queue {
+		if qj.job.ID == jobID {
+			return idx + 1
+		}
+	}
+	return 0
+}
+
+// dequeueJob removes a job from the queue. It returns false if the job is not
+// queued on this instance.
+func (jm *JobManager) dequeueJob(jobID types.JobID) bool {
+	jm.jobsMu.Lock()
+	defer jm.jobsMu.Unlock()
+	for idx, qj := range jm.queue {
+		if qj.job.ID == jobID {
+			jm.queue = append(jm.queue[:idx], jm.queue[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// closeQueueLocked prevents any further queued job from being started. Jobs
+// left in the queue stay in the queued state in storage and are picked up
+// again on resume.
+func (jm *JobManager) closeQueueLocked() {
+	jm.queueClosed = true
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -29,10 +29,35 @@ func (jm *JobManager) resumeJobs(ctx xcontext.Context, serverID string) error {
 			}
 		}
 	}
+
+	queuedJobs, err := jm.listMyJobs(ctx, serverID, job.JobStateQueued)
+	if err != nil {
+		return fmt.Errorf("failed to list queued jobs: %w", err)
+	}
+	ctx.Infof("Found %d queued jobs for %s/%s", len(queuedJobs), jm.config.instanceTag, serverID)
+	for _, jobID := range queuedJobs {
+		if err := jm.requeueJob(ctx, jobID); err != nil {
+			ctx.Errorf("failed to queue job %d again: %v, failing it", jobID, err)
+			if err = jm.emitErrEvent(ctx, jobID, job.EventJobFailed, fmt.Errorf("failed to queue job %d again: %w", jobID, err)); err != nil {
+				ctx.Warnf("Failed to emit event for %d: %v", jobID, err)
+			}
+		}
+	}
 	return nil
 }
 
 func (jm *JobManager) resumeJob(ctx xcontext.Context, jobID types.JobID) error {
+	j, resumeState, err := jm.loadPausedJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	ctx.Debugf("running resumed job %d", j.ID)
+	jm.startJob(ctx, j, resumeState)
+	return nil
+}
+
+// loadPausedJob rebuilds a paused job and the state to resume it from.
+func (jm *JobManager) loadPausedJob(ctx xcontext.Context, jobID types.JobID) (*job.Job, *job.PauseEventPayload, error) {
 	ctx.Debugf("attempting to resume job %d", jobID)
 	results, err := jm.frameworkEvManager.Fetch(
 		ctx,
@@ -40,10 +65,10 @@ func (jm *JobManager) resumeJob(ctx xcontext.Context, jobID types.JobID) error {
 		frameworkevent.QueryEventName(job.EventJobPaused),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to query resume state for job %d: %w", jobID, err)
+		return nil, nil, fmt.Errorf("failed to query resume state for job %d: %w", jobID, err)
 	}
 	if len(results) == 0 {
-		return fmt.Errorf("no resume state found for job %d", jobID)
+		return nil, nil, fmt.Errorf("no resume state found for job %d", jobID)
 	}
 
 	// get the latest event by id
@@ -55,25 +80,23 @@ func (jm *JobManager) resumeJob(ctx xcontext.Context, jobID types.JobID) error {
 	}
 	var resumeState job.PauseEventPayload
 	if results[lastEventIdx].Payload == nil {
-		return fmt.Errorf("invald resume state for job %d: %+v", jobID, results[0])
+		return nil, nil, fmt.Errorf("invald resume state for job %d: %+v", jobID, results[0])
 	}
 	if err := json.Unmarshal(*results[lastEventIdx].Payload, &resumeState); err != nil {
-		return fmt.Errorf("invald resume state for job %d: %w", jobID, err)
+		return nil, nil, fmt.Errorf("invald resume state for job %d: %w", jobID, err)
 	}
 	if resumeState.Version != job.CurrentPauseEventPayloadVersion {
-		return fmt.Errorf("incompatible resume state version (want %d, got %d)",
+		return nil, nil, fmt.Errorf("incompatible resume state version (want %d, got %d)",
 			job.CurrentPauseEventPayloadVersion, resumeState.Version)
 	}
 	req, err := jm.jsm.GetJobRequest(ctx, jobID)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve job descriptor for %d: %w", jobID, err)
+		return nil, nil, fmt.Errorf("failed to retrieve job descriptor for %d: %w", jobID, err)
 	}
 	j, err := NewJobFromExtendedDescriptor(ctx, jm.pluginRegistry, req.ExtendedDescriptor)
 	if err != nil {
-		return fmt.Errorf("failed to create job %d: %w", jobID, err)
+		return nil, nil, fmt.Errorf("failed to create job %d: %w", jobID, err)
 	}
 	j.ID = jobID
-	ctx.Debugf("running resumed job %d", j.ID)
-	jm.startJob(ctx, j, &resumeState)
-	return nil
+	return j, &resumeState, nil
 }
@@ -6,13 +6,225 @@
 package jobmanager
 
 import (
+	"encoding/json"
 	"fmt"
+	"time"
+
 	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/test"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
 )
 
 func (jm *JobManager) retry(ev *api.Event) *api.EventResponse {
-	return &api.EventResponse{
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventRetryMsg)
+	jobID := msg.JobID
+	evResp := api.EventResponse{
 		Requestor: ev.Msg.Requestor(),
-		Err:       fmt.Errorf("Not implemented"),
 	}
+
+	req, err := jm.jsm.GetJobRequest(ctx, jobID)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to fetch request for job ID %d: %w", jobID, err)
+		return &evResp
+	}
+	if err := ev.Authorize(api.EventRequestor(req.Requestor)); err != nil {
+		evResp.Err = err
+		return &evResp
+	}
+	if req.ExtendedDescriptor == nil {
+		evResp.Err = fmt.Errorf("job %d has no extended descriptor, cannot retry", jobID)
+		return &evResp
+	}
+
+	if err := jm.checkInstance(jobID, req.ExtendedDescriptor); err != nil {
+		evResp.Err = err
+		return &evResp
+	}
+
+	if err := jm.checkJobFinished(ctx, jobID); err != nil {
+		evResp.Err = err
+		return &evResp
+	}
+
+	// Work on a copy of the stored descriptor, so that the original request
+	// is left untouched.
+	var ed job.ExtendedDescriptor
+	edJSON, err := json.Marshal(req.ExtendedDescriptor)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to copy extended descriptor of job %d: %w", jobID, err)
+		return &evResp
+	}
+	if err := json.Unmarshal(edJSON, &ed); err != nil {
+		evResp.Err = fmt.Errorf("failed to copy extended descriptor of job %d: %w", jobID, err)
+		return &evResp
+	}
+	if msg.Options.OnlyFailedTests || msg.Options.OnlyFailedTargets {
+		if err := jm.restrictToFailures(ctx, jobID, &ed, msg.Options); err != nil {
+			evResp.Err = err
+			return &evResp
+		}
+	}
+
+	j, err := NewJobFromExtendedDescriptor(ctx, jm.pluginRegistry, &ed)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to build job object from job %d: %w", jobID, err)
+		return &evResp
+	}
+	j.ExtendedDescriptor.RetryOf = jobID
+	jdJSON, err := json.MarshalIndent(&ed.Descriptor, "", "    ")
+	if err != nil {
+		evResp.Err = err
+		return &evResp
+	}
+
+	request := job.Request{
+		JobName:            j.Name,
+		JobDescriptor:      string(jdJSON),
+		ExtendedDescriptor: j.ExtendedDescriptor,
+		Requestor:          string(ev.Msg.Requestor()),
+		ServerID:           ev.ServerID,
+		RequestTime:        time.Now(),
+	}
+	newJobID, err := jm.jsm.StoreJobRequest(ctx, &request)
+	if err != nil {
+		evResp.Err = fmt.Errorf("could not create job request: %v", err)
+		return &evResp
+	}
+	j.ID = newJobID
+	ctx.Infof("Job %d is a retry of job %d", newJobID, jobID)
+
+	evResp.JobID = newJobID
+	status, err := jm.submitJob(ev.Context, j, request.RequestTime)
+	if err != nil {
+		evResp.Err = err
+		return &evResp
+	}
+	status.RetryOf = jobID
+	evResp.Status = status
+	return &evResp
+}
+
+// checkJobFinished returns an error unless the job has reached one of the
+// completion states.
+func (jm *JobManager) checkJobFinished(ctx xcontext.Context, jobID types.JobID) error {
+	jm.jobsMu.Lock()
+	_, running := jm.jobs[jobID]
+	jm.jobsMu.Unlock()
+	if running {
+		return fmt.Errorf("job %d is still running", jobID)
+	}
+
+	last, err := jm.lastJobStateEvent(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	for _, name := range job.JobCompletionEvents {
+		if last.EventName == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("job %d is not finished (state %s)", jobID, last.EventName)
+}
+
+// checkInstance returns an error if the job described by ed was not created
+// by this instance, according to the instance tag.
+func (jm *JobManager) checkInstance(jobID types.JobID, ed *job.ExtendedDescriptor) error {
+	if jm.config.instanceTag == "" {
+		return nil
+	}
+	for _, tag := range ed.Tags {
+		if tag == jm.config.instanceTag {
+			return nil
+		}
+	}
+	return fmt.Errorf("job %d belongs to a different instance, this is %q",
+		jobID, jm.config.instanceTag)
+}
+
+// lastJobStateEvent returns the latest event which changed the state of the
+// job.
+func (jm *JobManager) lastJobStateEvent(ctx xcontext.Context, jobID types.JobID) (*frameworkevent.Event, error) {
+	jobEvents, err := jm.frameworkEvManager.Fetch(ctx,
+		frameworkevent.QueryJobID(jobID),
+		frameworkevent.QueryEventNames(job.JobStateEvents),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch events associated to job state: %v", err)
+	}
+	if len(jobEvents) == 0 {
+		return nil, fmt.Errorf("job %d has not started yet", jobID)
+	}
+	last := jobEvents[0]
+	for _, ev := range jobEvents {
+		if ev.SequenceID > last.SequenceID {
+			last = ev
+		}
+	}
+	return &last, nil
+}
+
+// restrictToFailures drops from the descriptor the tests that did not fail in
+// the last run of the job and, if requested, restricts the remaining tests to
+// their failed targets.
+func (jm *JobManager) restrictToFailures(ctx xcontext.Context, jobID types.JobID, ed *job.ExtendedDescriptor, opts api.RetryOptions) error {
+	origJob, err := NewJobFromExtendedDescriptor(ctx, jm.pluginRegistry, ed)
+	if err != nil {
+		return fmt.Errorf("failed to build job object from job %d: %w", jobID, err)
+	}
+	origJob.ID = jobID
+	runStatuses, err := jm.jobRunner.BuildRunStatuses(ctx, origJob)
+	if err != nil {
+		return fmt.Errorf("could not rebuild the statuses of job %d: %w", jobID, err)
+	}
+	if len(runStatuses) == 0 {
+		return fmt.Errorf("job %d has no runs to retry", jobID)
+	}
+	lastRun := runStatuses[len(runStatuses)-1]
+
+	// For each test with failures, the IDs of the targets that failed it. A nil
+	// list means that the failure is not bound to any target, e.g. the targets
+	// could not be acquired.
+	failures := make(map[string][]string)
+	for _, ts := range lastRun.TestStatuses {
+		for _, tgs := range ts.TargetStatuses {
+			if tgs.Error == "" {
+				continue
+			}
+			ids := failures[ts.TestName]
+			if tgs.Target != nil {
+				ids = append(ids, tgs.Target.ID)
+			}
+			failures[ts.TestName] = ids
+		}
+	}
+
+	var (
+		testDescriptors  []*test.TestDescriptor
+		stepsDescriptors []test.TestStepsDescriptors
+	)
+	for idx, td := range ed.TestDescriptors {
+		if td.Disabled || idx >= len(ed.TestStepsDescriptors) {
+			continue
+		}
+		failedTargets, failed := failures[ed.TestStepsDescriptors[idx].TestName]
+		if !failed {
+			continue
+		}
+		if opts.OnlyFailedTargets && len(failedTargets) > 0 {
+			td.TargetIDs = failedTargets
+		}
+		testDescriptors = append(testDescriptors, td)
+		stepsDescriptors = append(stepsDescriptors, ed.TestStepsDescriptors[idx])
+	}
+	if len(testDescriptors) == 0 {
+		return fmt.Errorf("job %d has no failed tests to retry", jobID)
+	}
+	ed.TestDescriptors = testDescriptors
+	ed.TestStepsDescriptors = stepsDescriptors
+	return nil
 }
@@ -0,0 +1,222 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/cron"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+func (jm *JobManager) schedule(ev *api.Event) *api.EventResponse {
+	ctx := ev.Context
+	msg := ev.Msg.(api.EventScheduleMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	if _, err := cron.Parse(msg.CronExpression); err != nil {
+		evResp.Err = fmt.Errorf("invalid cron expression: %w", err)
+		return evResp
+	}
+	// Validate the descriptor now rather than every time the schedule fires.
+	if _, _, err := jm.buildJob(ctx, msg.JobDescriptor); err != nil {
+		evResp.Err = fmt.Errorf("invalid job descriptor: %w", err)
+		return evResp
+	}
+
+	schedule := job.Schedule{
+		CronExpression: msg.CronExpression,
+		JobDescriptor:  msg.JobDescriptor,
+		Requestor:      string(ev.Msg.Requestor()),
+		ServerID:       ev.ServerID,
+		CreateTime:     jm.config.clock.Now(),
+		SkipIfRunning:  msg.SkipIfRunning,
+	}
+	scheduleID, err := jm.ssm.StoreSchedule(ctx, &schedule)
+	if err != nil {
+		evResp.Err = fmt.Errorf("could not store schedule: %w", err)
+		return evResp
+	}
+	ctx.Infof("Created schedule %d (%q)", scheduleID, msg.CronExpression)
+	jm.notifyScheduler()
+	evResp.ScheduleID = scheduleID
+	return evResp
+}
+
+func (jm *JobManager) unschedule(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	msg := ev.Msg.(api.EventUnscheduleMsg)
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	schedule, err := jm.ssm.GetSchedule(ctx, msg.ScheduleID)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to fetch schedule %d: %w", msg.ScheduleID, err)
+		return evResp
+	}
+	// Like jobs, a schedule can only be deleted by its owner or an admin.
+	if err := ev.Authorize(api.EventRequestor(schedule.Requestor)); err != nil {
+		evResp.Err = err
+		return evResp
+	}
+	if schedule.ServerID != ev.ServerID {
+		evResp.Err = fmt.Errorf("schedule %d belongs to a different server (%s)", msg.ScheduleID, schedule.ServerID)
+		return evResp
+	}
+	if err := jm.ssm.DeleteSchedule(ctx, msg.ScheduleID); err != nil {
+		evResp.Err = fmt.Errorf("failed to delete schedule %d: %w", msg.ScheduleID, err)
+		return evResp
+	}
+	ctx.Infof("Deleted schedule %d", msg.ScheduleID)
+	jm.notifyScheduler()
+	return evResp
+}
+
+func (jm *JobManager) listSchedules(ev *api.Event) *api.EventResponse {
+	evResp := &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+	}
+	schedules, err := jm.ssm.ListSchedules(ev.Context, ev.ServerID)
+	if err != nil {
+		evResp.Err = fmt.Errorf("failed to list schedules: %w", err)
+		return evResp
+	}
+	evResp.Schedules = schedules
+	return evResp
+}
+
+// notifyScheduler wakes up the scheduler so that it reloads the schedules.
+func (jm *JobManager) notifyScheduler() {
+	select {
+	case jm.scheduleCh <- struct{}{}:
+	default:
+		// A reload is already pending.
+	}
+}
+
+// activeSchedule is a schedule tracked by the scheduler.
+type activeSchedule struct {
+	schedule *job.Schedule
+	expr     *cron.Expression
+	next     time.Time
+}
+
+// runScheduler submits the jobs of the schedules owned by serverID when they
+// are due, until ctx is done. Firings missed while the server was down are
+// not caught up on.
+func (jm *JobManager) runScheduler(ctx xcontext.Context, serverID string) {
+	ctx = ctx.WithField("server_id", serverID)
+	schedules := make(map[types.ScheduleID]*activeSchedule)
+	reload := true
+	for {
+		if reload {
+			schedules = jm.loadSchedules(ctx, serverID, schedules)
+			reload = false
+		}
+
+		var next time.Time
+		for _, as := range schedules {
+			if !as.next.IsZero() && (next.IsZero() || as.next.Before(next)) {
+				next = as.next
+			}
+		}
+		var (
+			timer   *clock.Timer
+			timerCh <-chan time.Time
+		)
+		if !next.IsZero() {
+			timer = jm.config.clock.Timer(next.Sub(jm.config.clock.Now()))
+			timerCh = timer.C
+		}
+
+		stop := false
+		select {
+		case <-ctx.Done():
+			stop = true
+		case <-jm.scheduleCh:
+			reload = true
+		case <-timerCh:
+			now := jm.config.clock.Now().UTC()
+			for _, as := range schedules {
+				if as.next.IsZero() || as.next.After(now) {
+					continue
+				}
+				jm.fireSchedule(ctx, as.schedule, now)
+				as.next = as.expr.Next(now)
+			}
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if stop {
+			return
+		}
+	}
+}
+
+// loadSchedules fetches the schedules from storage. The activation times of
+// the schedules which are already known are preserved.
+func (jm *JobManager) loadSchedules(ctx xcontext.Context, serverID string, known map[types.ScheduleID]*activeSchedule) map[types.ScheduleID]*activeSchedule {
+	stored, err := jm.ssm.ListSchedules(ctx, serverID)
+	if err != nil {
+		ctx.Errorf("Failed to load schedules: %v", err)
+		return known
+	}
+	now := jm.config.clock.Now().UTC()
+	schedules := make(map[types.ScheduleID]*activeSchedule, len(stored))
+	for _, s := range stored {
+		if as, ok := known[s.ID]; ok {
+			as.schedule = s
+			schedules[s.ID] = as
+			continue
+		}
+		expr, err := cron.Parse(s.CronExpression)
+		if err != nil {
+			ctx.Errorf("Ignoring schedule %d: %v", s.ID, err)
+			continue
+		}
+		schedules[s.ID] = &activeSchedule{schedule: s, expr: expr, next: expr.Next(now)}
+	}
+	ctx.Debugf("Loaded %d schedules", len(schedules))
+	return schedules
+}
+
+// fireSchedule submits the job of a schedule, unless the skip policy of the
+// schedule prevents it.
+func (jm *JobManager) fireSchedule(ctx xcontext.Context, s *job.Schedule, now time.Time) {
+	ctx = ctx.WithField("schedule_id", s.ID)
+	if s.SkipIfRunning && s.LastJobID != 0 {
+		if err := jm.checkJobFinished(ctx, s.LastJobID); err != nil {
+			ctx.Infof("Skipping schedule %d: %v", s.ID, err)
+			return
+		}
+	}
+	// Jobs must not be canceled or paused along with the scheduler, see
+	// api.Start.
+	jobCtx := xcontext.WithResetSignalers(ctx).WithTag("api_method", "schedule")
+	jobID, _, err := jm.submitDescriptor(jobCtx, s.JobDescriptor, api.EventRequestor(s.Requestor), s.ServerID)
+	if err != nil {
+		ctx.Errorf("Schedule %d failed to submit job: %v", s.ID, err)
+		return
+	}
+	ctx.Infof("Schedule %d submitted job %d", s.ID, jobID)
+	if err := jm.ssm.UpdateScheduleLastRun(ctx, s.ID, jobID, now); err != nil {
+		ctx.Errorf("Failed to update schedule %d: %v", s.ID, err)
+		return
+	}
+	s.LastJobID = jobID
+	s.LastRunTime = now
+}
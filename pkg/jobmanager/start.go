@@ -11,36 +11,63 @@ import (
 	"time"
 
 	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 	"github.com/linuxboot/contest/pkg/xcontext/metrics/perf"
 )
 
 func (jm *JobManager) start(ev *api.Event) *api.EventResponse {
 	msg := ev.Msg.(api.EventStartMsg)
+	jobID, status, err := jm.submitDescriptor(ev.Context, msg.JobDescriptor, ev.Msg.Requestor(), ev.ServerID)
+	return &api.EventResponse{
+		JobID:     jobID,
+		Requestor: ev.Msg.Requestor(),
+		Err:       err,
+		Status:    status,
+	}
+}
 
+// buildJob validates a job descriptor and builds the corresponding job
+// object. It returns the job along with the normalized descriptor.
+func (jm *JobManager) buildJob(ctx xcontext.Context, jobDescriptor string) (*job.Job, *job.Descriptor, error) {
 	var jd job.Descriptor
-	if err := json.Unmarshal([]byte(msg.JobDescriptor), &jd); err != nil {
-		return &api.EventResponse{Err: err}
+	if err := json.Unmarshal([]byte(jobDescriptor), &jd); err != nil {
+		return nil, nil, err
 	}
 	// Check the compatibility of the JobDescriptor
 	if err := jd.CheckVersion(); err != nil {
-		return &api.EventResponse{Err: err}
+		return nil, nil, err
 	}
 	if err := job.CheckTags(jd.Tags, false /* allowInternal */); err != nil {
-		return &api.EventResponse{Err: err}
+		return nil, nil, err
 	}
 	// Add instance tag, if specified.
 	if jm.config.instanceTag != "" {
 		jd.Tags = job.AddTags(jd.Tags, jm.config.instanceTag)
 	}
-	j, err := NewJobFromDescriptor(ev.Context, jm.pluginRegistry, &jd)
+	j, err := NewJobFromDescriptor(ctx, jm.pluginRegistry, &jd)
+	if err != nil {
+		return nil, nil, err
+	}
+	return j, &jd, nil
+}
+
+// submitDescriptor validates a job descriptor, stores the resulting job
+// request and submits the job. It is shared by the start API and the
+// scheduler. The job ID is returned as soon as the request is stored, even if
+// the job could not be submitted.
+func (jm *JobManager) submitDescriptor(ctx xcontext.Context, jobDescriptor string, requestor api.EventRequestor, serverID string) (types.JobID, *job.Status, error) {
+	j, jd, err := jm.buildJob(ctx, jobDescriptor)
 	if err != nil {
-		return &api.EventResponse{Err: err}
+		return 0, nil, err
 	}
-	jdJSON, err := json.MarshalIndent(&jd, "", "    ")
+	jdJSON, err := json.MarshalIndent(jd, "", "    ")
 	if err != nil {
-		return &api.EventResponse{Err: err}
+		return 0, nil, err
 	}
 
 	// The job descriptor has been validated correctly, now use the JobRequestEmitter
@@ -49,36 +76,31 @@ func (jm *JobManager) start(ev *api.Event) *api.EventResponse {
 		JobName:            j.Name,
 		JobDescriptor:      string(jdJSON),
 		ExtendedDescriptor: j.ExtendedDescriptor,
-		Requestor:          string(ev.Msg.Requestor()),
-		ServerID:           ev.ServerID,
+		Requestor:          string(requestor),
+		ServerID:           serverID,
 		RequestTime:        time.Now(),
 	}
-	jobID, err := jm.jsm.StoreJobRequest(ev.Context, &request)
+	jobID, err := jm.jsm.StoreJobRequest(ctx, &request)
 	if err != nil {
-		return &api.EventResponse{
-			Requestor: ev.Msg.Requestor(),
-			Err:       fmt.Errorf("could not create job request: %v", err)}
+		return 0, nil, fmt.Errorf("could not create job request: %v", err)
 	}
 
 	j.ID = jobID
 
-	jm.startJob(ev.Context, j, nil)
-
-	return &api.EventResponse{
-		JobID:     j.ID,
-		Requestor: ev.Msg.Requestor(),
-		Err:       nil,
-		Status: &job.Status{
-			Name:      j.Name,
-			State:     string(job.EventJobStarted),
-			StartTime: time.Now(),
-		},
+	status, err := jm.submitJob(ctx, j, request.RequestTime)
+	if err != nil {
+		return j.ID, nil, err
 	}
+	return j.ID, status, nil
 }
 
 func (jm *JobManager) startJob(ctx xcontext.Context, j *job.Job, resumeState *job.PauseEventPayload) {
 	jm.jobsMu.Lock()
 	defer jm.jobsMu.Unlock()
+	jm.startJobLocked(ctx, j, resumeState)
+}
+
+func (jm *JobManager) startJobLocked(ctx xcontext.Context, j *job.Job, resumeState *job.PauseEventPayload) {
 	jobCtx, jobCancel := xcontext.WithCancel(ctx)
 	jobCtx, jobPause := xcontext.WithNotify(jobCtx, xcontext.ErrPaused)
 	jm.jobs[j.ID] = &jobInfo{job: j, pause: jobPause, cancel: jobCancel}
@@ -89,6 +111,8 @@ func (jm *JobManager) runJob(ctx xcontext.Context, j *job.Job, resumeState *job.
 	defer func() {
 		jm.jobsMu.Lock()
 		delete(jm.jobs, j.ID)
+		// A slot has been freed, start the next queued jobs.
+		jm.dispatchLocked()
 		jm.jobsMu.Unlock()
 	}()
 
@@ -100,6 +124,13 @@ func (jm *JobManager) runJob(ctx xcontext.Context, j *job.Job, resumeState *job.
 	}
 
 	ctx = ctx.WithField("job_id", j.ID)
+	ctx = artifact.WithStore(ctx, jm.config.artifactStore)
+	ctx = inventory.WithStore(ctx, jm.ism)
+	ctx = health.WithTracker(ctx, jm.healthTracker)
+	// The captured lines are stored before the job is removed from the
+	// running jobs, so that the logs API reports them all once it is done.
+	ctx, closeLogs := jm.captureLogs(ctx, j.ID)
+	defer closeLogs()
 
 	if err := jm.emitEvent(ctx, j.ID, job.EventJobStarted); err != nil {
 		ctx.Errorf("failed to emit event: %v", err)
@@ -17,6 +17,8 @@ import (
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
 )
 
 func (jm *JobManager) status(ev *api.Event) *api.EventResponse {
@@ -136,6 +138,7 @@ func (jm *JobManager) status(ev *api.Event) *api.EventResponse {
 		State:       state,
 		StateErrMsg: stateErrMsg,
 		JobReport:   report,
+		RetryOf:     req.ExtendedDescriptor.RetryOf,
 	}
 
 	jobStatus.RunStatuses, err = jm.jobRunner.BuildRunStatuses(ctx, currentJob)
@@ -144,6 +147,20 @@ func (jm *JobManager) status(ev *api.Event) *api.EventResponse {
 		return &evResp
 	}
 
+	if jm.config.artifactStore != nil {
+		jobStatus.Artifacts, err = jm.config.artifactStore.List(ctx, jobID)
+		if err != nil {
+			evResp.Err = fmt.Errorf("could not list the artifacts of the job: %w", err)
+			return &evResp
+		}
+	}
+
+	if jobStatus.State == string(job.EventJobQueued) {
+		jobStatus.QueuePosition = jm.queuePosition(jobID)
+	} else {
+		jobStatus.TargetWait = targetWait(jobID)
+	}
+
 	if len(jobStatus.RunStatuses) > 0 {
 		// NOTE: deprecated, keeping for backwards compat
 		jobStatus.RunStatus = &jobStatus.RunStatuses[len(jobStatus.RunStatuses)-1]
@@ -153,3 +170,18 @@ func (jm *JobManager) status(ev *api.Event) *api.EventResponse {
 	evResp.Err = nil
 	return &evResp
 }
+
+// targetWait returns where the job is in the queue of the jobs waiting for
+// targets, if the locker has one and the job is in it.
+func targetWait(jobID types.JobID) *target.Waiter {
+	wl, ok := target.GetLocker().(target.WaitLocker)
+	if !ok {
+		return nil
+	}
+	for _, w := range wl.Waiters() {
+		if w.JobID == jobID {
+			return &w
+		}
+	}
+	return nil
+}
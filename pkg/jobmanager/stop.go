@@ -11,19 +11,46 @@ import (
 
 	"github.com/linuxboot/contest/pkg/api"
 	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
 )
 
 func (jm *JobManager) stop(ev *api.Event) *api.EventResponse {
 	ctx := ev.Context
 	msg := ev.Msg.(api.EventStopMsg)
 	jobID := msg.JobID
+	// A job belongs to whoever requested it, the authorization policy decides
+	// who else can stop it.
+	req, err := jm.jsm.GetJobRequest(storage.WithConsistencyModel(ctx, storage.ConsistentReadAfterWrite), jobID)
+	if err != nil {
+		return &api.EventResponse{Err: fmt.Errorf("could not fetch request for job %d: %w", jobID, err)}
+	}
+	if err := ev.Authorize(api.EventRequestor(req.Requestor)); err != nil {
+		return &api.EventResponse{Err: err}
+	}
+	// Jobs that are still waiting in the queue are simply dropped from it.
+	if jm.dequeueJob(jobID) {
+		if err := jm.emitEvent(ctx, jobID, job.EventJobCancelled); err != nil {
+			return &api.EventResponse{Err: fmt.Errorf("could not cancel queued job: %v", err)}
+		}
+		return &api.EventResponse{
+			JobID:     jobID,
+			Requestor: ev.Msg.Requestor(),
+			Status: &job.Status{
+				Name:  req.JobName,
+				State: string(job.EventJobCancelled),
+			},
+		}
+	}
+	// Paused jobs are not running anymore, they are cancelled right away.
+	if resp := jm.stopPausedJob(ev, req); resp != nil {
+		return resp
+	}
 	// CancelJob is asynchronous, it closes the Job's cancellation signal which
 	// is propagated all the way down to the TestRunner. TestRunner  will wait
 	// TestRunnerShutdownTimeout before flagging the test as timed out. JobRunner
 	// will attempt to call Release on TargetManager and will wait up to
 	// TargetManagerReleaseTimeout for Release to return.
-	err := jm.CancelJob(jobID)
-	if err != nil {
+	if err := jm.CancelJob(jobID); err != nil {
 		ctx.Errorf("Cannot stop job: %v", err)
 		return &api.EventResponse{Err: fmt.Errorf("could not stop job: %v", err)}
 	}
@@ -39,3 +66,45 @@ func (jm *JobManager) stop(ev *api.Event) *api.EventResponse {
 		},
 	}
 }
+
+// stopPausedJob cancels a paused job and gives back the targets it kept
+// locked. It returns nil if the job is not paused.
+func (jm *JobManager) stopPausedJob(ev *api.Event, req *job.Request) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentReadAfterWrite)
+	jobID := ev.Msg.(api.EventStopMsg).JobID
+	jm.jobsMu.Lock()
+	if _, running := jm.jobs[jobID]; running {
+		jm.jobsMu.Unlock()
+		return nil
+	}
+	last, err := jm.lastJobStateEvent(ctx, jobID)
+	if err != nil || last.EventName != job.EventJobPaused {
+		jm.jobsMu.Unlock()
+		return nil
+	}
+	// The job is cancelled while holding jobsMu, so that a concurrent resume
+	// finds it is not paused anymore.
+	err = jm.emitEvent(ctx, jobID, job.EventJobCancelled)
+	jm.jobsMu.Unlock()
+	if err != nil {
+		return &api.EventResponse{Err: fmt.Errorf("could not cancel paused job: %v", err)}
+	}
+	ctx.Infof("Cancelled paused job %d", jobID)
+	resp := &api.EventResponse{
+		JobID:     jobID,
+		Requestor: ev.Msg.Requestor(),
+		Status: &job.Status{
+			Name:  req.JobName,
+			State: string(job.EventJobCancelled),
+		},
+	}
+	j, pauseState, err := jm.loadPausedJob(ctx, jobID)
+	if err == nil {
+		err = jm.jobRunner.ReleasePausedJob(ctx.WithField("job_id", jobID), j, pauseState)
+	}
+	if err != nil {
+		ctx.Errorf("Cannot release the targets of paused job %d: %v", jobID, err)
+		resp.Err = fmt.Errorf("job %d was cancelled but its targets could not be released: %w", jobID, err)
+	}
+	return resp
+}
@@ -0,0 +1,31 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/storage"
+)
+
+func (jm *JobManager) subscribe(ev *api.Event) *api.EventResponse {
+	ctx := storage.WithConsistencyModel(ev.Context, storage.ConsistentEventually)
+	msg := ev.Msg.(api.EventSubscribeMsg)
+	evResp := &api.EventResponse{
+		JobID:     msg.JobID,
+		Requestor: ev.Msg.Requestor(),
+	}
+
+	if _, err := jm.jsm.GetJobRequest(ctx, msg.JobID); err != nil {
+		evResp.Err = fmt.Errorf("failed to fetch request for job ID %d: %w", msg.JobID, err)
+		return evResp
+	}
+	// The subscription lives as long as the context of the API call, which
+	// is controlled by the subscriber.
+	evResp.Subscription = jm.eventBroker.Subscribe(ctx, msg.JobID, msg.Filter, msg.Cursor, jm.testEvManager, jm.frameworkEvManager)
+	return evResp
+}
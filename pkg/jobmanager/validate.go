@@ -0,0 +1,232 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/pluginregistry"
+	"github.com/linuxboot/contest/pkg/storage/limits"
+	"github.com/linuxboot/contest/pkg/test"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+func (jm *JobManager) validate(ev *api.Event) *api.EventResponse {
+	msg := ev.Msg.(api.EventValidateMsg)
+	return &api.EventResponse{
+		Requestor: ev.Msg.Requestor(),
+		Problems:  ValidateJobDescriptor(ev.Context, jm.pluginRegistry, msg.JobDescriptor),
+	}
+}
+
+// ValidateJobDescriptor runs on a JSON job descriptor the same checks as a
+// job submission, including the test fetchers and the validation of the
+// parameters of every plugin, without storing or running anything. Unlike
+// NewJobFromJSONDescriptor, it does not stop at the first error and returns
+// all the problems found. No problems means that the job can be submitted.
+func ValidateJobDescriptor(ctx xcontext.Context, registry *pluginregistry.PluginRegistry, jobDescriptorJSON string) []job.DescriptorProblem {
+	v := descriptorValidator{ctx: ctx, registry: registry}
+	var jd job.Descriptor
+	if err := json.Unmarshal([]byte(jobDescriptorJSON), &jd); err != nil {
+		v.add("", fmt.Errorf("invalid job descriptor: %w", err))
+		return v.problems
+	}
+	v.validateJob(&jd)
+	return v.problems
+}
+
+type descriptorValidator struct {
+	ctx      xcontext.Context
+	registry *pluginregistry.PluginRegistry
+	problems []job.DescriptorProblem
+}
+
+func (v *descriptorValidator) add(path string, err error) {
+	v.problems = append(v.problems, job.DescriptorProblem{Path: path, Message: err.Error()})
+}
+
+func (v *descriptorValidator) validateJob(jd *job.Descriptor) {
+	if err := jd.CheckVersion(); err != nil {
+		v.add("Version", err)
+	}
+	seenTags := make(map[string]bool)
+	for idx, tag := range jd.Tags {
+		path := fmt.Sprintf("Tags[%d]", idx)
+		if err := job.IsValidTag(tag, false /* allowInternal */); err != nil {
+			v.add(path, err)
+		} else if seenTags[tag] {
+			v.add(path, fmt.Errorf("duplicate tag %q", tag))
+		}
+		seenTags[tag] = true
+	}
+	if jd.JobName == "" {
+		v.add("JobName", errors.New("job name cannot be empty"))
+	} else if err := limits.NewValidator().ValidateJobName(jd.JobName); err != nil {
+		v.add("JobName", err)
+	}
+	if jd.RunInterval < 0 {
+		v.add("RunInterval", errors.New("run interval must be non-negative"))
+	}
+
+	if len(jd.Reporting.RunReporters) == 0 && len(jd.Reporting.FinalReporters) == 0 {
+		v.add("Reporting", errors.New("at least one run reporter or one final reporter must be specified in a job"))
+	}
+	for idx, reporter := range jd.Reporting.RunReporters {
+		v.validateReporter(fmt.Sprintf("Reporting.RunReporters[%d]", idx), reporter, true)
+	}
+	for idx, reporter := range jd.Reporting.FinalReporters {
+		v.validateReporter(fmt.Sprintf("Reporting.FinalReporters[%d]", idx), reporter, false)
+	}
+
+	if len(jd.TestDescriptors) == 0 {
+		v.add("TestDescriptors", errors.New("need at least one TestDescriptor in the JobDescriptor"))
+		return
+	}
+	stepsDescriptors := make([]test.TestStepsDescriptors, 0, len(jd.TestDescriptors))
+	for idx, td := range jd.TestDescriptors {
+		path := fmt.Sprintf("TestDescriptors[%d]", idx)
+		if td == nil {
+			v.add(path, errors.New("test description is null"))
+			continue
+		}
+		v.validateTargetManager(path, td)
+		if sd, ok := v.validateTest(path, td); ok {
+			stepsDescriptors = append(stepsDescriptors, *sd)
+		}
+	}
+
+	// Let the job creation catch whatever the checks above do not cover, it
+	// reuses the steps already fetched. What is left is about the tests.
+	if len(v.problems) == 0 {
+		resolver := literalStepsResolver{stepsDescriptors: stepsDescriptors}
+		if _, err := newJob(v.ctx, v.registry, jd, resolver); err != nil {
+			v.add("TestDescriptors", err)
+		}
+	}
+}
+
+func (v *descriptorValidator) validateReporter(path string, reporter job.ReporterConfig, run bool) {
+	if strings.TrimSpace(reporter.Name) == "" {
+		v.add(path+".Name", errors.New("reporter name cannot be empty or all-whitespace"))
+		return
+	}
+	if err := limits.NewValidator().ValidateReporterName(reporter.Name); err != nil {
+		v.add(path+".Name", err)
+		return
+	}
+	r, err := v.registry.NewReporter(reporter.Name)
+	if err != nil {
+		v.add(path+".Name", err)
+		return
+	}
+	if run {
+		_, err = r.ValidateRunParameters(reporter.Parameters)
+	} else {
+		_, err = r.ValidateFinalParameters(reporter.Parameters)
+	}
+	if err != nil {
+		v.add(path+".Parameters", err)
+	}
+}
+
+func (v *descriptorValidator) validateTargetManager(path string, td *test.TestDescriptor) {
+	if td.TargetManagerName == "" {
+		v.add(path+".TargetManagerName", errors.New("target manager name cannot be empty"))
+		return
+	}
+	tm, err := v.registry.NewTargetManager(td.TargetManagerName)
+	if err != nil {
+		v.add(path+".TargetManagerName", err)
+		return
+	}
+	if _, err := tm.ValidateAcquireParameters(td.TargetManagerAcquireParameters); err != nil {
+		v.add(path+".TargetManagerAcquireParameters", err)
+	}
+	if _, err := tm.ValidateReleaseParameters(td.TargetManagerReleaseParameters); err != nil {
+		v.add(path+".TargetManagerReleaseParameters", err)
+	}
+}
+
+// validateTest fetches the steps of a test and validates them. It returns
+// false if the steps could not be fetched.
+func (v *descriptorValidator) validateTest(path string, td *test.TestDescriptor) (*test.TestStepsDescriptors, bool) {
+	if td.TestFetcherName == "" {
+		v.add(path+".TestFetcherName", errors.New("test fetcher name cannot be empty"))
+		return nil, false
+	}
+	tf, err := v.registry.NewTestFetcher(td.TestFetcherName)
+	if err != nil {
+		v.add(path+".TestFetcherName", err)
+		return nil, false
+	}
+	fetchParams, err := tf.ValidateFetchParameters(v.ctx, td.TestFetcherFetchParameters)
+	if err != nil {
+		v.add(path+".TestFetcherFetchParameters", err)
+		return nil, false
+	}
+	testName, steps, err := tf.Fetch(v.ctx, fetchParams)
+	if err != nil {
+		v.add(path+".TestFetcherFetchParameters", fmt.Errorf("could not fetch test: %w", err))
+		return nil, false
+	}
+	if err := limits.NewValidator().ValidateTestName(testName); err != nil {
+		v.add(path, err)
+	}
+
+	stepsPath := fetchedStepsPath(path, td)
+	if len(steps) == 0 {
+		v.add(stepsPath, errors.New("at least one test step is required per test"))
+	}
+	var bundles []test.TestStepBundle
+	labels := make(map[string]bool)
+	for idx, step := range steps {
+		stepPath := fmt.Sprintf("%s[%d]", stepsPath, idx)
+		if step == nil {
+			v.add(stepPath, errors.New("test step description is null"))
+			continue
+		}
+		if err := limits.NewValidator().ValidateTestStepLabel(step.Label); err != nil {
+			v.add(stepPath, err)
+			continue
+		}
+		bundle, err := v.registry.NewTestStepBundle(v.ctx, *step)
+		if err != nil {
+			v.add(stepPath, err)
+			continue
+		}
+		if labels[step.Label] {
+			v.add(stepPath, fmt.Errorf("found duplicated labels: %s", step.Label))
+		}
+		labels[step.Label] = true
+		bundles = append(bundles, *bundle)
+	}
+	// Dependencies can only be checked once all the steps are known.
+	if len(bundles) == len(steps) && len(bundles) > 0 {
+		if _, err := test.StepDependencies(bundles); err != nil {
+			v.add(stepsPath, fmt.Errorf("invalid step dependencies: %w", err))
+		}
+	}
+	return &test.TestStepsDescriptors{TestName: testName, TestSteps: steps}, true
+}
+
+// literalFetcherName is the name of the test fetcher which takes the steps
+// inline, in its fetch parameters.
+const literalFetcherName = "literal"
+
+// fetchedStepsPath returns the path of the steps of a test. The steps of the
+// literal fetcher are in the descriptor, the ones of the other fetchers are
+// in the fetched test, which is marked as such in the path.
+func fetchedStepsPath(path string, td *test.TestDescriptor) string {
+	if strings.EqualFold(td.TestFetcherName, literalFetcherName) {
+		return path + ".TestFetcherFetchParameters.Steps"
+	}
+	return path + ".FetchedTest.Steps"
+}
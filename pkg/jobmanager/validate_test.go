@@ -0,0 +1,122 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package jobmanager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/pluginregistry"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/plugins/reporters/targetsuccess"
+	"github.com/linuxboot/contest/plugins/targetmanagers/targetlist"
+	"github.com/linuxboot/contest/plugins/testfetchers/literal"
+	"github.com/linuxboot/contest/plugins/testfetchers/uri"
+	"github.com/linuxboot/contest/tests/plugins/teststeps/slowecho"
+	"github.com/stretchr/testify/require"
+)
+
+func newValidateRegistry(t *testing.T) *pluginregistry.PluginRegistry {
+	pr := pluginregistry.NewPluginRegistry(xcontext.Background())
+	require.NoError(t, pr.RegisterTargetManager(targetlist.Load()))
+	require.NoError(t, pr.RegisterTestFetcher(literal.Load()))
+	require.NoError(t, pr.RegisterTestFetcher(uri.Load()))
+	require.NoError(t, pr.RegisterTestStep(slowecho.Load()))
+	require.NoError(t, pr.RegisterReporter(targetsuccess.Load()))
+	return pr
+}
+
+const validateDescriptor = `{
+    "JobName": "validate",
+    "Version": "1.0",
+    "Tags": ["%s", "lab"],
+    "Runs": 1,
+    "TestDescriptors": [
+        {
+            "TargetManagerName": "TargetList",
+            "TargetManagerAcquireParameters": {"Targets": [{"ID": "id1"}]},
+            "TargetManagerReleaseParameters": {},
+            "TestFetcherName": "literal",
+            "TestFetcherFetchParameters": {
+                "TestName": "test",
+                "Steps": [
+                    {"name": "slowecho", "label": "one", "parameters": {"text": ["hi"], "sleep": ["%s"]}},
+                    {"name": "%s", "label": "two", "parameters": {"text": ["hi"], "sleep": ["0"]}}
+                ]
+            }
+        }
+    ],
+    "Reporting": {
+        "RunReporters": [{"Name": "TargetSuccess", "Parameters": {"SuccessExpression": "%s"}}]
+    }
+}`
+
+func TestValidateJobDescriptor(t *testing.T) {
+	pr := newValidateRegistry(t)
+	ctx := xcontext.Background()
+
+	valid := fmt.Sprintf(validateDescriptor, "nightly", "0", "slowecho", ">10%")
+	require.Empty(t, ValidateJobDescriptor(ctx, pr, valid))
+
+	// All the problems are reported, each with its own path.
+	problems := ValidateJobDescriptor(ctx, pr, fmt.Sprintf(validateDescriptor, "_internal", "soon", "nosuchstep", "many"))
+	var paths []string
+	for _, p := range problems {
+		require.NotEmpty(t, p.Message)
+		paths = append(paths, p.Path)
+	}
+	require.Equal(t, []string{
+		"Tags[0]",
+		"Reporting.RunReporters[0].Parameters",
+		"TestDescriptors[0].TestFetcherFetchParameters.Steps[0]",
+		"TestDescriptors[0].TestFetcherFetchParameters.Steps[1]",
+	}, paths)
+
+	problems = ValidateJobDescriptor(ctx, pr, `{"JobName": 1}`)
+	require.Equal(t, 1, len(problems))
+	require.Equal(t, "", problems[0].Path)
+
+	problems = ValidateJobDescriptor(ctx, pr, `{"Version": "2.0", "TestDescriptors": [{}]}`)
+	require.Equal(t, []job.DescriptorProblem{
+		{Path: "Version", Message: problems[0].Message},
+		{Path: "JobName", Message: "job name cannot be empty"},
+		{Path: "Reporting", Message: "at least one run reporter or one final reporter must be specified in a job"},
+		{Path: "TestDescriptors[0].TargetManagerName", Message: "target manager name cannot be empty"},
+		{Path: "TestDescriptors[0].TestFetcherName", Message: "test fetcher name cannot be empty"},
+	}, problems)
+}
+
+func TestValidateJobDescriptorFetchedSteps(t *testing.T) {
+	pr := newValidateRegistry(t)
+	ctx := xcontext.Background()
+
+	testFile := filepath.Join(t.TempDir(), "test.json")
+	require.NoError(t, os.WriteFile(testFile, []byte(`{"Steps": [{"name": "nosuchstep", "label": "one"}]}`), 0644))
+	descriptor := fmt.Sprintf(`{
+    "JobName": "validate",
+    "Version": "1.0",
+    "Runs": 1,
+    "TestDescriptors": [
+        {
+            "TargetManagerName": "TargetList",
+            "TargetManagerAcquireParameters": {"Targets": [{"ID": "id1"}]},
+            "TargetManagerReleaseParameters": {},
+            "TestFetcherName": "URI",
+            "TestFetcherFetchParameters": {"TestName": "test", "URI": "file://%s"}
+        }
+    ],
+    "Reporting": {
+        "RunReporters": [{"Name": "TargetSuccess", "Parameters": {"SuccessExpression": ">10%%"}}]
+    }
+}`, testFile)
+
+	problems := ValidateJobDescriptor(ctx, pr, descriptor)
+	require.Equal(t, 1, len(problems))
+	require.Equal(t, "TestDescriptors[0].FetchedTest.Steps[0]", problems[0].Path)
+}
@@ -165,6 +165,12 @@ func (e Expression) EvaluateSuccess(success, total uint64) (*Result, error) {
 	} else {
 		return nil, fmt.Errorf("unknown comparison type: %s", e.Type.String())
 	}
+	return e.Evaluate(lhs), nil
+}
+
+// Evaluate compares lhs against the right hand side of the expression. For
+// relative comparisons, lhs is a percentage already.
+func (e Expression) Evaluate(lhs float64) *Result {
 	pass := e.Cmp.Compare(lhs, e.RHS)
 	cmpRes := &Result{Type: e.Type, Op: e.Cmp.Operator()}
 	if e.Type == TypePercentage {
@@ -191,7 +197,7 @@ func (e Expression) EvaluateSuccess(success, total uint64) (*Result, error) {
 		}
 	}
 	cmpRes.Expr = expr
-	return cmpRes, nil
+	return cmpRes
 }
 
 func (e Expression) String() string {
@@ -0,0 +1,135 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package junit models JUnit XML documents, as ingested by most CI systems.
+package junit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// ReporterName is the name of the reporter which renders job results as
+// JUnit XML documents.
+const ReporterName = "JUnit"
+
+// TestSuites is the root element of a JUnit XML document.
+type TestSuites struct {
+	XMLName  xml.Name    `xml:"testsuites"`
+	Name     string      `xml:"name,attr,omitempty"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     float64     `xml:"time,attr"`
+	Suites   []TestSuite `xml:"testsuite"`
+}
+
+// TestSuite groups the test cases of a suite.
+type TestSuite struct {
+	Name       string     `xml:"name,attr"`
+	Tests      int        `xml:"tests,attr"`
+	Failures   int        `xml:"failures,attr"`
+	Skipped    int        `xml:"skipped,attr"`
+	Time       float64    `xml:"time,attr"`
+	Timestamp  string     `xml:"timestamp,attr,omitempty"`
+	Properties []Property `xml:"properties>property,omitempty"`
+	Cases      []TestCase `xml:"testcase"`
+}
+
+// Property is a key/value pair attached to a suite.
+type Property struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// TestCase is the result of a single test case.
+type TestCase struct {
+	Name      string   `xml:"name,attr"`
+	ClassName string   `xml:"classname,attr"`
+	Time      float64  `xml:"time,attr"`
+	Failure   *Failure `xml:"failure,omitempty"`
+	Skipped   *Skipped `xml:"skipped,omitempty"`
+	SystemOut string   `xml:"system-out,omitempty"`
+	SystemErr string   `xml:"system-err,omitempty"`
+}
+
+// Failure describes why a test case failed.
+type Failure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Skipped describes why a test case did not complete.
+type Skipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// Add appends a test case to the suite and updates the counters.
+func (s *TestSuite) Add(tc TestCase) {
+	s.Cases = append(s.Cases, tc)
+	s.Tests++
+	if tc.Failure != nil {
+		s.Failures++
+	}
+	if tc.Skipped != nil {
+		s.Skipped++
+	}
+	s.Time = roundSeconds(s.Time + tc.Time)
+}
+
+// Add appends suites to the document and updates the counters.
+func (ts *TestSuites) Add(suites ...TestSuite) {
+	for _, s := range suites {
+		ts.Suites = append(ts.Suites, s)
+		ts.Tests += s.Tests
+		ts.Failures += s.Failures
+		ts.Skipped += s.Skipped
+		ts.Time = roundSeconds(ts.Time + s.Time)
+	}
+}
+
+// Seconds converts a duration into the time of a test case, in seconds with
+// millisecond precision.
+func Seconds(d time.Duration) float64 {
+	return roundSeconds(d.Seconds())
+}
+
+func roundSeconds(s float64) float64 {
+	return math.Round(s*1000) / 1000
+}
+
+// Marshal encodes the document as indented XML, including the XML header.
+func (ts *TestSuites) Marshal() (string, error) {
+	data, err := xml.MarshalIndent(ts, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("could not encode JUnit XML: %w", err)
+	}
+	return xml.Header + string(data) + "\n", nil
+}
+
+// Parse decodes a JUnit XML document. An error is returned if the data is not
+// a JUnit XML document.
+func Parse(data string) (*TestSuites, error) {
+	if !strings.Contains(data, "<testsuites") {
+		return nil, fmt.Errorf("not a JUnit XML document")
+	}
+	var ts TestSuites
+	if err := xml.Unmarshal([]byte(data), &ts); err != nil {
+		return nil, fmt.Errorf("could not decode JUnit XML: %w", err)
+	}
+	return &ts, nil
+}
+
+// Merge combines the suites of several documents into a single one.
+func Merge(name string, docs ...*TestSuites) *TestSuites {
+	merged := &TestSuites{Name: name}
+	for _, doc := range docs {
+		merged.Add(doc.Suites...)
+	}
+	return merged
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package junit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalParse(t *testing.T) {
+	var suite TestSuite
+	suite.Name = "boot"
+	suite.Add(TestCase{Name: "power on", ClassName: "T1", Time: Seconds(1234567 * time.Microsecond)})
+	suite.Add(TestCase{Name: "power on", ClassName: "T2", Failure: &Failure{Message: "<timeout>", Text: "<timeout>"}})
+	suite.Add(TestCase{Name: "power off", ClassName: "T1", Skipped: &Skipped{}})
+	doc := TestSuites{Name: "job"}
+	doc.Add(suite)
+
+	xml, err := doc.Marshal()
+	require.NoError(t, err)
+	require.Contains(t, xml, `<testsuites name="job" tests="3" failures="1" skipped="1" time="1.235">`)
+	require.Contains(t, xml, `<failure message="&lt;timeout&gt;">&lt;timeout&gt;</failure>`)
+
+	parsed, err := Parse(xml)
+	require.NoError(t, err)
+	require.Equal(t, doc.Suites, parsed.Suites)
+	require.Equal(t, 3, parsed.Tests)
+}
+
+func TestParseNotJUnit(t *testing.T) {
+	_, err := Parse("I did nothing")
+	require.Error(t, err)
+	_, err = Parse("<testsuites><testsuite")
+	require.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	var a, b TestSuites
+	a.Add(TestSuite{Name: "boot (run 1)", Tests: 2, Failures: 1, Time: 1.5})
+	b.Add(TestSuite{Name: "boot (run 2)", Tests: 2, Skipped: 1, Time: 0.25})
+	merged := Merge("job", &a, &b)
+	require.Equal(t, "job", merged.Name)
+	require.Len(t, merged.Suites, 2)
+	require.Equal(t, 4, merged.Tests)
+	require.Equal(t, 1, merged.Failures)
+	require.Equal(t, 1, merged.Skipped)
+	require.Equal(t, 1.75, merged.Time)
+}
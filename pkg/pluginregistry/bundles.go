@@ -31,11 +31,24 @@ func (r *PluginRegistry) NewTestStepBundle(ctx xcontext.Context, testStepDescrip
 	if label == "" {
 		return nil, ErrStepLabelIsMandatory{TestStepDescriptor: testStepDescriptor}
 	}
+	if err := testStepDescriptor.RunIf.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid run_if for test step %s: %w", label, err)
+	}
+	var retry test.StepRetryParameters
+	if testStepDescriptor.Retry != nil {
+		retry = *testStepDescriptor.Retry
+		if err := retry.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid retry for test step %s: %w", label, err)
+		}
+	}
 	testStepBundle := test.TestStepBundle{
 		TestStep:      testStep,
 		TestStepLabel: label,
 		Parameters:    testStepDescriptor.Parameters,
 		AllowedEvents: allowedEvents,
+		DependsOn:     testStepDescriptor.DependsOn,
+		RunIf:         testStepDescriptor.RunIf,
+		Retry:         retry,
 	}
 	return &testStepBundle, nil
 }
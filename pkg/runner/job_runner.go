@@ -7,15 +7,19 @@ package runner
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/benbjohnson/clock"
 
+	"github.com/linuxboot/contest/pkg/artifact"
 	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
 	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/target"
@@ -30,6 +34,8 @@ type jobInfo struct {
 	targets   []*target.Target
 	jobCtx    xcontext.Context
 	jobCancel func()
+	// pausedAt is the time the job was paused at, zero while it is running.
+	pausedAt time.Time
 }
 
 // JobRunner implements logic to run, cancel and stop Jobs
@@ -49,10 +55,17 @@ type JobRunner struct {
 	// testEvManager is used by the JobRunner to emit test events
 	testEvManager testevent.Fetcher
 
+	// eventBroker notifies the subscriptions to a job about its events
+	eventBroker *stream.Broker
+
 	// targetLockDuration is the amount of time target lock is extended by
 	// while the job is running.
 	targetLockDuration time.Duration
 
+	// pausedLockTimeout is the maximum amount of time the target locks of a
+	// paused job are kept, zero means until the server exits.
+	pausedLockTimeout time.Duration
+
 	// clock is the time measurement device, mocked out in tests.
 	clock clock.Clock
 
@@ -123,6 +136,9 @@ func (jr *JobRunner) Run(ctx xcontext.Context, j *job.Job, resumeState *job.Paus
 		// Return without releasing targets and keep the job entry so locks continue to be refreshed
 		// all the way to server exit.
 		keepJobEntry = true
+		jr.jobsMapLock.Lock()
+		jr.jobsMap[j.ID].pausedAt = jr.clock.Now()
+		jr.jobsMapLock.Unlock()
 		return &job.PauseEventPayload{
 			Version:         job.CurrentPauseEventPayloadVersion,
 			JobID:           j.ID,
@@ -301,6 +317,7 @@ func (jr *JobRunner) acquireTargets(
 		return resumeTargets, false, nil
 	}
 
+	start := jr.clock.Now()
 	var targets []*target.Target
 	if len(targets) == 0 {
 		var err error
@@ -310,6 +327,12 @@ func (jr *JobRunner) acquireTargets(
 			return nil, false, err
 		}
 	}
+	if tracker, ok := health.TrackerFromContext(ctx); ok {
+		var err error
+		if targets, err = jr.dropQuarantinedTargets(ctx, j, bundle, targetLocker, tracker, targets); err != nil {
+			return nil, false, err
+		}
+	}
 	// Lock all the targets returned by Acquire.
 	// Targets can also be locked in the `Acquire` method, for
 	// example to allow dynamic acquisition.
@@ -320,6 +343,18 @@ func (jr *JobRunner) acquireTargets(
 	if err := targetLocker.Lock(ctx, j.ID, jr.targetLockDuration, targets); err != nil {
 		return nil, false, fmt.Errorf("target locking failed: %w", err)
 	}
+	if metrics := ctx.Metrics(); metrics != nil {
+		metrics = metrics.WithTags(nil)
+		metrics.Count(perf.TARGET_ACQUIRES).Add(1)
+		metrics.Gauge(perf.TARGET_ACQUIRE_SECONDS).Add(jr.clock.Since(start).Seconds())
+	}
+
+	if len(t.TargetIDs) > 0 {
+		var err error
+		if targets, err = jr.filterTargets(ctx, j, bundle, targetLocker, targets, t.TargetIDs); err != nil {
+			return nil, false, err
+		}
+	}
 
 	// when the targets are acquired, update the counter
 	if metrics := ctx.Metrics(); metrics != nil {
@@ -329,6 +364,96 @@ func (jr *JobRunner) acquireTargets(
 	return targets, true, nil
 }
 
+// filterTargets keeps only the acquired targets whose ID is in targetIDs, and
+// hands the other ones back to the target manager and the locker.
+func (jr *JobRunner) filterTargets(
+	ctx xcontext.Context,
+	j *job.Job,
+	bundle *target.TargetManagerBundle,
+	targetLocker target.Locker,
+	targets []*target.Target,
+	targetIDs []string,
+) ([]*target.Target, error) {
+	wanted := make(map[string]bool, len(targetIDs))
+	for _, id := range targetIDs {
+		wanted[id] = true
+	}
+	var kept, dropped []*target.Target
+	for _, t := range targets {
+		if wanted[t.ID] {
+			kept = append(kept, t)
+		} else {
+			dropped = append(dropped, t)
+		}
+	}
+	if len(dropped) > 0 {
+		ctx.Infof("Releasing %d acquired target(s) not selected for job ID %d", len(dropped), j.ID)
+		if err := bundle.TargetManager.Release(ctx, j.ID, dropped, bundle.ReleaseParameters); err != nil {
+			return nil, fmt.Errorf("failed to release unselected targets: %w", err)
+		}
+		if err := targetLocker.Unlock(ctx, j.ID, dropped); err != nil {
+			return nil, fmt.Errorf("failed to unlock unselected targets: %w", err)
+		}
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("none of the acquired targets matches the selected target IDs %v", targetIDs)
+	}
+	if len(kept) < len(wanted) {
+		for _, t := range kept {
+			delete(wanted, t.ID)
+		}
+		missing := make([]string, 0, len(wanted))
+		for _, id := range targetIDs {
+			if wanted[id] {
+				missing = append(missing, id)
+			}
+		}
+		ctx.Warnf("Only %d of the %d selected targets were acquired for job ID %d, missing %v",
+			len(kept), len(targetIDs), j.ID, missing)
+	}
+	return kept, nil
+}
+
+// dropQuarantinedTargets hands the acquired targets which are in quarantine
+// back to the target manager. The health tracker's locker already keeps the
+// target managers which lock through TryLock from picking them, this covers
+// the other ones.
+func (jr *JobRunner) dropQuarantinedTargets(
+	ctx xcontext.Context,
+	j *job.Job,
+	bundle *target.TargetManagerBundle,
+	targetLocker target.Locker,
+	tracker *health.Tracker,
+	targets []*target.Target,
+) ([]*target.Target, error) {
+	quarantined, err := tracker.Quarantined(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var kept, dropped []*target.Target
+	for _, t := range targets {
+		if quarantined[t.ID] {
+			dropped = append(dropped, t)
+		} else {
+			kept = append(kept, t)
+		}
+	}
+	if len(dropped) == 0 {
+		return targets, nil
+	}
+	ctx.Warnf("Releasing %d acquired target(s) in quarantine for job ID %d", len(dropped), j.ID)
+	if err := bundle.TargetManager.Release(ctx, j.ID, dropped, bundle.ReleaseParameters); err != nil {
+		return nil, fmt.Errorf("failed to release quarantined targets: %w", err)
+	}
+	if err := targetLocker.Unlock(ctx, j.ID, dropped); err != nil {
+		return nil, fmt.Errorf("failed to unlock quarantined targets: %w", err)
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("all the acquired targets are in quarantine: %v", dropped)
+	}
+	return kept, nil
+}
+
 func (jr *JobRunner) runTest(ctx xcontext.Context,
 	j *job.Job, runID types.RunID, testID int, testAttempt uint32,
 	resumeState *job.PauseEventPayload,
@@ -344,7 +469,7 @@ func (jr *JobRunner) runTest(ctx xcontext.Context,
 	)
 
 	header := testevent.Header{JobID: j.ID, RunID: runID, TestName: t.Name, TestAttempt: testAttempt}
-	testEventEmitter := storage.NewTestEventEmitter(jr.storageEngineVault, header)
+	testEventEmitter := jr.eventBroker.TestEventEmitter(j.ID, storage.NewTestEventEmitter(jr.storageEngineVault, header))
 
 	var resumeTargets []*target.Target
 	if resumeState != nil && resumeState.Targets != nil {
@@ -352,9 +477,20 @@ func (jr *JobRunner) runTest(ctx xcontext.Context,
 	}
 
 	tl := target.GetLocker()
+	tracker, trackHealth := health.TrackerFromContext(ctx)
+	if trackHealth {
+		tl = tracker.Locker(tl)
+	}
 
 	acquireCtx, acquireCancel := xcontext.WithTimeout(ctx, j.TargetManagerAcquireTimeout)
 	defer acquireCancel()
+	// Target managers which wait for targets queue the job with its priority.
+	acquireCtx = target.WithPriority(acquireCtx, j.Priority)
+	if len(t.TargetIDs) > 0 {
+		// Target managers which support it only acquire the selected targets,
+		// the other ones are handed back by filterTargets.
+		acquireCtx = target.WithTargetIDs(acquireCtx, t.TargetIDs)
+	}
 
 	// the Acquire semantic is synchronous, so that the implementation
 	// is simpler on the user's side. We run it in a goroutine in
@@ -375,8 +511,8 @@ func (jr *JobRunner) runTest(ctx xcontext.Context,
 
 			// Assume that all errors could be retried except cancellation as both
 			// target manager and target locking problems can disappear if retried
-			if acquireErr == xcontext.ErrCanceled {
-				return nil, nil, false, acquireErr
+			if errors.Is(acquireErr, xcontext.ErrCanceled) {
+				return nil, nil, false, xcontext.ErrCanceled
 			}
 
 			payload, err := target.MarshallErrPayload(acquireErr.Error())
@@ -429,6 +565,13 @@ func (jr *JobRunner) runTest(ctx xcontext.Context,
 		})
 		runCtx = xcontext.WithValue(runCtx, types.KeyJobID, j.ID)
 		runCtx = xcontext.WithValue(runCtx, types.KeyRunID, runID)
+		runCtx = artifact.WithTest(runCtx, j.ID, runID, t.Name, testAttempt)
+
+		emitterFactory := NewTestStepEventsEmitterFactory(jr.storageEngineVault, j.ID, runID, t.Name, testAttempt)
+		emitterFactory.eventBroker = jr.eventBroker
+		if trackHealth {
+			emitterFactory.healthTracker = tracker
+		}
 
 		testRunner := NewTestRunner()
 		runCtx.Debugf("== test runner starting")
@@ -436,7 +579,7 @@ func (jr *JobRunner) runTest(ctx xcontext.Context,
 			runCtx,
 			t,
 			targets,
-			NewTestStepEventsEmitterFactory(jr.storageEngineVault, j.ID, runID, t.Name, testAttempt),
+			emitterFactory,
 			testRunnerState,
 		)
 		runCtx.Debugf("== test runner finished, err: %v", err)
@@ -534,11 +677,27 @@ func (jr *JobRunner) RefreshLocks() {
 	jr.jobsMapLock.Lock()
 	defer jr.jobsMapLock.Unlock()
 	var wg sync.WaitGroup
+	now := jr.clock.Now()
 	for jobID := range jr.jobsMap {
 		ji := jr.jobsMap[jobID]
 		if len(ji.targets) == 0 {
 			continue
 		}
+		lockDuration := jr.targetLockDuration
+		if !ji.pausedAt.IsZero() && jr.pausedLockTimeout > 0 {
+			// Paused jobs only hold on to their targets up to the timeout, after
+			// that the locks are left to expire.
+			remaining := ji.pausedAt.Add(jr.pausedLockTimeout).Sub(now)
+			if remaining <= 0 {
+				ji.jobCtx.Infof("Job ID %d has been paused for more than %s, releasing its %d target locks",
+					ji.jobID, jr.pausedLockTimeout, len(ji.targets))
+				delete(jr.jobsMap, jobID)
+				continue
+			}
+			if remaining < lockDuration {
+				lockDuration = remaining
+			}
+		}
 		wg.Add(1)
 		go func() { // Refresh locks for all the jobs in parallel.
 			tl := target.GetLocker()
@@ -548,7 +707,7 @@ func (jr *JobRunner) RefreshLocks() {
 				break
 			default:
 				ji.jobCtx.Debugf("Refreshing target locks...")
-				if err := tl.RefreshLocks(ji.jobCtx, ji.jobID, jr.targetLockDuration, ji.targets); err != nil {
+				if err := tl.RefreshLocks(ji.jobCtx, ji.jobID, lockDuration, ji.targets); err != nil {
 					ji.jobCtx.Errorf("Failed to refresh %d locks for job ID %d (%v), aborting job", len(ji.targets), ji.jobID, err)
 					// We lost our grip on targets, fold the tent and leave ASAP.
 					ji.jobCancel()
@@ -560,6 +719,44 @@ func (jr *JobRunner) RefreshLocks() {
 	wg.Wait()
 }
 
+// ReleasePausedJob gives the targets of a paused job which will not be resumed
+// back to its target manager and unlocks them. Nothing is released if the
+// job does not hold its targets anymore, e.g. after pausedLockTimeout.
+func (jr *JobRunner) ReleasePausedJob(ctx xcontext.Context, j *job.Job, pauseState *job.PauseEventPayload) error {
+	jr.jobsMapLock.Lock()
+	ji, ok := jr.jobsMap[j.ID]
+	if !ok || ji.pausedAt.IsZero() {
+		jr.jobsMapLock.Unlock()
+		return nil
+	}
+	// Stop refreshing the locks.
+	delete(jr.jobsMap, j.ID)
+	jr.jobsMapLock.Unlock()
+
+	targets := pauseState.Targets
+	if len(targets) == 0 || pauseState.TestID < 1 || pauseState.TestID > len(j.Tests) {
+		return nil
+	}
+	t := j.Tests[pauseState.TestID-1]
+	bundle := t.TargetManagerBundle
+	header := testevent.Header{JobID: j.ID, RunID: pauseState.RunID, TestName: t.Name, TestAttempt: pauseState.TestAttempt}
+	testEventEmitter := jr.eventBroker.TestEventEmitter(j.ID, storage.NewTestEventEmitter(jr.storageEngineVault, header))
+
+	releaseCtx, releaseCancel := xcontext.WithTimeout(ctx, j.TargetManagerReleaseTimeout)
+	defer releaseCancel()
+	err := bundle.TargetManager.Release(releaseCtx, j.ID, targets, bundle.ReleaseParameters)
+	_ = jr.emitTargetEvents(ctx, testEventEmitter, targets, target.EventTargetReleased)
+	if unlockErr := target.GetLocker().Unlock(ctx, j.ID, targets); unlockErr == nil {
+		ctx.Infof("Unlocked %d target(s) for job ID %d", len(targets), j.ID)
+	} else {
+		ctx.Warnf("Failed to unlock %d target(s) (%v): %v", len(targets), targets, unlockErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to release targets: %w", err)
+	}
+	return nil
+}
+
 // emitTargetEvents emits test events to keep track of Target acquisition and release
 func (jr *JobRunner) emitTargetEvents(ctx xcontext.Context, emitter testevent.Emitter, targets []*target.Target, eventName event.Name) error {
 	// The events hold a serialization of the Target in the payload
@@ -590,7 +787,10 @@ func (jr *JobRunner) emitEvent(ctx xcontext.Context, jobID types.JobID, eventNam
 }
 
 type testStepEventsEmitterFactory struct {
-	vault storage.EngineVault
+	vault       storage.EngineVault
+	eventBroker *stream.Broker
+	// healthTracker, if set, records the outcomes of the targets.
+	healthTracker *health.Tracker
 
 	jobID       types.JobID
 	runID       types.RunID
@@ -599,15 +799,23 @@ type testStepEventsEmitterFactory struct {
 }
 
 func (f *testStepEventsEmitterFactory) New(testStepLabel string) testevent.Emitter {
-	return storage.NewTestEventEmitter(f.vault,
-		testevent.Header{
-			JobID:         f.jobID,
-			RunID:         f.runID,
-			TestName:      f.testName,
-			TestAttempt:   f.testAttempt,
-			TestStepLabel: testStepLabel,
-		},
-	)
+	return f.NewAttempt(testStepLabel, 0)
+}
+
+func (f *testStepEventsEmitterFactory) NewAttempt(testStepLabel string, testStepAttempt uint32) testevent.Emitter {
+	header := testevent.Header{
+		JobID:           f.jobID,
+		RunID:           f.runID,
+		TestName:        f.testName,
+		TestAttempt:     f.testAttempt,
+		TestStepLabel:   testStepLabel,
+		TestStepAttempt: testStepAttempt,
+	}
+	emitter := storage.NewTestEventEmitter(f.vault, header)
+	if f.healthTracker != nil {
+		emitter = f.healthTracker.TestEventEmitter(header, emitter)
+	}
+	return f.eventBroker.TestEventEmitter(f.jobID, emitter)
 }
 
 func NewTestStepEventsEmitterFactory(vault storage.EngineVault,
@@ -625,15 +833,20 @@ func NewTestStepEventsEmitterFactory(vault storage.EngineVault,
 	}
 }
 
-// NewJobRunner returns a new JobRunner, which holds an empty registry of jobs
-func NewJobRunner(js storage.JobStorage, storageVault storage.EngineVault, clk clock.Clock, lockDuration time.Duration) *JobRunner {
+// NewJobRunner returns a new JobRunner, which holds an empty registry of jobs.
+// Target locks of paused jobs are kept for at most pausedLockTimeout, zero
+// keeps them until the server exits. eventBroker may be nil if nobody
+// subscribes to the events of the jobs.
+func NewJobRunner(js storage.JobStorage, storageVault storage.EngineVault, clk clock.Clock, lockDuration, pausedLockTimeout time.Duration, eventBroker *stream.Broker) *JobRunner {
 	jr := &JobRunner{
 		jobsMap:               make(map[types.JobID]*jobInfo),
 		jobStorage:            js,
 		storageEngineVault:    storageVault,
-		frameworkEventManager: storage.NewFrameworkEventEmitterFetcher(storageVault),
+		frameworkEventManager: eventBroker.FrameworkEventEmitterFetcher(storage.NewFrameworkEventEmitterFetcher(storageVault)),
 		testEvManager:         storage.NewTestEventFetcher(storageVault),
+		eventBroker:           eventBroker,
 		targetLockDuration:    lockDuration,
+		pausedLockTimeout:     pausedLockTimeout,
 		clock:                 clk,
 		stopLockRefresh:       make(chan struct{}),
 		lockRefreshStopped:    make(chan struct{}),
@@ -25,6 +25,7 @@ import (
 	"github.com/linuxboot/contest/pkg/xcontext/logger"
 	"github.com/linuxboot/contest/plugins/targetmanagers/targetlist"
 	"github.com/linuxboot/contest/plugins/teststeps"
+	"github.com/linuxboot/contest/tests/plugins/teststeps/teststep"
 )
 
 type JobRunnerSuite struct {
@@ -42,7 +43,9 @@ func (s *JobRunnerSuite) SetupTest() {
 		name    string
 		factory test.TestStepFactory
 		events  []event.Name
-	}{} {
+	}{
+		{teststep.Name, teststep.New, teststep.Events},
+	} {
 		require.NoError(s.T(), s.PluginRegistry.RegisterTestStep(e.name, e.factory, e.events))
 	}
 }
@@ -95,7 +98,7 @@ func (s *JobRunnerSuite) TestSimpleJobStartFinish() {
 	}
 
 	jsm := storage.NewJobStorageManager(s.MemoryStorage.StorageEngineVault)
-	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second)
+	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second, 0, nil)
 	require.NotNil(s.T(), jr)
 
 	resumeState, err := jr.Run(ctx, &j, nil)
@@ -177,7 +180,7 @@ func (s *JobRunnerSuite) TestJobWithTestRetry() {
 	}
 
 	jsm := storage.NewJobStorageManager(s.MemoryStorage.StorageEngineVault)
-	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second)
+	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second, 0, nil)
 	require.NotNil(s.T(), jr)
 
 	resumeState, err := jr.Run(ctx, &j, nil)
@@ -274,7 +277,7 @@ func (s *JobRunnerSuite) TestJobRetryOnFailedAcquire() {
 	}
 
 	jsm := storage.NewJobStorageManager(s.MemoryStorage.StorageEngineVault)
-	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second)
+	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second, 0, nil)
 	require.NotNil(s.T(), jr)
 
 	resumeState, err := jr.Run(ctx, &j, nil)
@@ -349,7 +352,7 @@ func (s *JobRunnerSuite) TestAcquireFailed() {
 	}
 
 	jsm := storage.NewJobStorageManager(s.MemoryStorage.StorageEngineVault)
-	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second)
+	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second, 0, nil)
 	require.NotNil(s.T(), jr)
 
 	resumeState, err := jr.Run(ctx, &j, nil)
@@ -376,6 +379,151 @@ func (s *JobRunnerSuite) TestAcquireFailed() {
 	}
 }
 
+func (s *JobRunnerSuite) TestFailedTargetStatusAfterCleanup() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	acquireParameters := targetlist.AcquireParameters{
+		Targets: []*target.Target{
+			{
+				ID: "T1",
+			},
+			{
+				ID: "T2",
+			},
+		},
+	}
+
+	cleanup := s.NewStep(ctx, "cleanup", teststep.Name, nil)
+	cleanup.RunIf = test.RunAlways
+	reporter := &collectingReporter{}
+	j := job.Job{
+		ID:                          1,
+		Runs:                        1,
+		TargetManagerAcquireTimeout: 10 * time.Second,
+		TargetManagerReleaseTimeout: 10 * time.Second,
+		RunReporterBundles: []*job.ReporterBundle{
+			{
+				Reporter: reporter,
+			},
+		},
+		Tests: []*test.Test{
+			{
+				Name: testName,
+				TargetManagerBundle: &target.TargetManagerBundle{
+					AcquireParameters: acquireParameters,
+					TargetManager:     targetlist.New(),
+				},
+				TestStepsBundles: []test.TestStepBundle{
+					s.NewStep(ctx, "main", teststep.Name, test.TestStepParameters{
+						teststep.FailTargetsParam: []test.Param{*test.NewParam("T1")},
+					}),
+					cleanup,
+				},
+			},
+		},
+	}
+
+	jsm := storage.NewJobStorageManager(s.MemoryStorage.StorageEngineVault)
+	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second, 0, nil)
+	require.NotNil(s.T(), jr)
+
+	resumeState, err := jr.Run(ctx, &j, nil)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), resumeState)
+
+	require.Len(s.T(), reporter.runStatuses, 1)
+	require.Len(s.T(), reporter.runStatuses[0].TestStatuses, 1)
+	ts := reporter.runStatuses[0].TestStatuses[0]
+
+	// Both targets went through the cleanup step.
+	require.Len(s.T(), ts.TestStepStatuses, 2)
+	require.Len(s.T(), ts.TestStepStatuses[1].TargetStatuses, 2)
+	for _, tgs := range ts.TestStepStatuses[1].TargetStatuses {
+		require.Empty(s.T(), tgs.Error)
+	}
+
+	// T1 is still reported as failed in the main step.
+	require.Len(s.T(), ts.TargetStatuses, 2)
+	for _, tgs := range ts.TargetStatuses {
+		switch tgs.Target.ID {
+		case "T1":
+			require.Equal(s.T(), "main", tgs.TestStepLabel)
+			require.NotEmpty(s.T(), tgs.Error)
+		case "T2":
+			require.Equal(s.T(), "cleanup", tgs.TestStepLabel)
+			require.Empty(s.T(), tgs.Error)
+		}
+	}
+}
+
+// A target which passes a step after being retried is not reported as failed.
+func (s *JobRunnerSuite) TestRetriedTargetStatus() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	var mu sync.Mutex
+	failed := make(map[string]bool)
+	require.NoError(s.T(), s.RegisterStateFullStep(
+		func(ctx xcontext.Context, ch test.TestStepChannels, params test.TestStepParameters, ev testevent.Emitter, resumeState json.RawMessage) (json.RawMessage, error) {
+			return teststeps.ForEachTarget(stateFullStepName, ctx, ch, func(ctx xcontext.Context, target *target.Target) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if !failed[target.ID] {
+					failed[target.ID] = true
+					return fmt.Errorf("flaky")
+				}
+				return nil
+			})
+		},
+		nil,
+	))
+	flaky := s.NewStep(ctx, "flaky", stateFullStepName, nil)
+	flaky.Retry = test.StepRetryParameters{NumRetries: 1}
+
+	reporter := &collectingReporter{}
+	j := job.Job{
+		ID:                          1,
+		Runs:                        1,
+		TargetManagerAcquireTimeout: 10 * time.Second,
+		TargetManagerReleaseTimeout: 10 * time.Second,
+		RunReporterBundles: []*job.ReporterBundle{
+			{
+				Reporter: reporter,
+			},
+		},
+		Tests: []*test.Test{
+			{
+				Name: testName,
+				TargetManagerBundle: &target.TargetManagerBundle{
+					AcquireParameters: targetlist.AcquireParameters{
+						Targets: []*target.Target{{ID: "T1"}},
+					},
+					TargetManager: targetlist.New(),
+				},
+				TestStepsBundles: []test.TestStepBundle{flaky},
+			},
+		},
+	}
+
+	jsm := storage.NewJobStorageManager(s.MemoryStorage.StorageEngineVault)
+	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second, 0, nil)
+	require.NotNil(s.T(), jr)
+
+	resumeState, err := jr.Run(ctx, &j, nil)
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), resumeState)
+
+	require.Len(s.T(), reporter.runStatuses, 1)
+	require.Len(s.T(), reporter.runStatuses[0].TestStatuses, 1)
+	ts := reporter.runStatuses[0].TestStatuses[0]
+	require.Len(s.T(), ts.TargetStatuses, 1)
+	require.Equal(s.T(), "flaky", ts.TargetStatuses[0].TestStepLabel)
+	require.Empty(s.T(), ts.TargetStatuses[0].Error)
+	require.Equal(s.T(), uint32(1), ts.TargetStatuses[0].TestStepAttempt)
+	require.False(s.T(), ts.TargetStatuses[0].OutTime.IsZero())
+}
+
 func (s *JobRunnerSuite) TestResumeStateBadJobId() {
 	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
 	defer cancel()
@@ -415,7 +563,7 @@ func (s *JobRunnerSuite) TestResumeStateBadJobId() {
 	}
 
 	jsm := storage.NewJobStorageManager(s.MemoryStorage.StorageEngineVault)
-	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second)
+	jr := NewJobRunner(jsm, s.MemoryStorage.StorageEngineVault, clock.New(), time.Second, 0, nil)
 	require.NotNil(s.T(), jr)
 
 	inputResumeState := job.PauseEventPayload{
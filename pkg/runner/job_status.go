@@ -8,6 +8,7 @@ package runner
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
@@ -55,7 +56,14 @@ func (jr *JobRunner) buildTargetStatuses(coordinates job.TestStepCoordinates, ta
 
 		evName := testEvent.Data.EventName
 		if evName == target.EventTargetIn {
-			targetStatus.InTime = testEvent.EmitTime
+			// A target retried by the step enters it again, only the last attempt
+			// determines the outcome.
+			if targetStatus.InTime.IsZero() {
+				targetStatus.InTime = testEvent.EmitTime
+			}
+			targetStatus.OutTime = time.Time{}
+			targetStatus.Error = ""
+			targetStatus.TestStepAttempt = testEvent.Header.TestStepAttempt
 		} else if evName == target.EventTargetOut {
 			targetStatus.OutTime = testEvent.EmitTime
 		} else if evName == target.EventTargetErr {
@@ -161,7 +169,8 @@ func (jr *JobRunner) buildTestStatus(ctx xcontext.Context, coordinates job.TestC
 	}
 
 	// Calculate the overall status of the Targets which corresponds to the last TargetStatus
-	// object recorded for each Target.
+	// object recorded for each Target, unless the Target failed a TestStep. Targets may go
+	// through cleanup steps after failing, in which case the failed TargetStatus is kept.
 
 	// Fetch all events signaling that a Target has been acquired. This is the source of truth
 	// indicating which Targets belong to a Test.
@@ -183,10 +192,13 @@ func (jr *JobRunner) buildTestStatus(ctx xcontext.Context, coordinates job.TestC
 	}
 
 	var targetStatuses []job.TargetStatus
-	// Keep track of the last TargetStatus seen for each Target
+	// Keep track of the last TargetStatus seen for each Target, or the first failed one
 	targetMap := make(map[string]job.TargetStatus)
 	for _, testStepStatus := range testStatus.TestStepStatuses {
 		for _, targetStatus := range testStepStatus.TargetStatuses {
+			if prev, ok := targetMap[targetStatus.Target.ID]; ok && prev.Error != "" {
+				continue
+			}
 			targetMap[targetStatus.Target.ID] = targetStatus
 		}
 	}
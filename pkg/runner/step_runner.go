@@ -7,6 +7,7 @@ import (
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/linuxboot/contest/pkg/cerrors"
 	"github.com/linuxboot/contest/pkg/event"
@@ -14,6 +15,7 @@ import (
 	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/test"
 	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/metrics/perf"
 )
 
 type AddTargetToStep func(ctx xcontext.Context, tgt *target.Target) error
@@ -129,7 +131,7 @@ func (sr *StepRunner) Run(
 
 	go func() {
 		defer finish()
-		sr.outputLoop(ctx, stepOut, ev, bundle.TestStepLabel)
+		sr.outputLoop(ctx, stepOut, ev, bundle.TestStepLabel, bundle.TestStep.Name())
 		ctx.Debugf("Reading loop finished")
 	}()
 
@@ -283,6 +285,7 @@ func (sr *StepRunner) outputLoop(
 	stepOut chan test.TestStepResult,
 	ev testevent.Emitter,
 	testStepLabel string,
+	testStepName string,
 ) {
 	for {
 		select {
@@ -347,10 +350,21 @@ func (sr *StepRunner) outputLoop(
 				}
 			}
 
+			result := perf.TARGET_RESULT_PASS
 			if res.Err == nil {
 				err = emitEvent(ctx, ev, target.EventTargetOut, res.Target, nil)
 			} else {
-				err = emitEvent(ctx, ev, target.EventTargetErr, res.Target, target.ErrPayload{Error: res.Err.Error()})
+				result = perf.TARGET_RESULT_FAIL
+				err = emitEvent(ctx, ev, target.EventTargetErr, res.Target, target.ErrPayload{
+					Error:          res.Err.Error(),
+					Infrastructure: target.IsInfrastructureError(res.Err),
+				})
+			}
+			if metrics := ctx.Metrics(); metrics != nil {
+				metrics.WithTags(nil).
+					WithTag(perf.TAG_STEP_PLUGIN, testStepName).
+					WithTag(perf.TAG_TARGET_RESULT, result).
+					Count(perf.TARGET_RESULTS).Add(1)
 			}
 			if err != nil {
 				ctx.Errorf("failed to emit event: %s", err)
@@ -397,6 +411,7 @@ func (sr *StepRunner) runningLoop(
 	sr.runningLoopActive = true
 	sr.mu.Unlock()
 
+	start := time.Now()
 	resultResumeState, err := func() (json.RawMessage, error) {
 		defer func() {
 			if r := recover(); r != nil {
@@ -410,6 +425,11 @@ func (sr *StepRunner) runningLoop(
 		inChannels := test.TestStepChannels{In: stepIn, Out: stepOut}
 		return bundle.TestStep.Run(ctx, inChannels, bundle.Parameters, ev, resumeState)
 	}()
+	if metrics := ctx.Metrics(); metrics != nil {
+		metrics = metrics.WithTags(nil).WithTag(perf.TAG_STEP_PLUGIN, bundle.TestStep.Name())
+		metrics.Count(perf.STEP_RUNS).Add(1)
+		metrics.Gauge(perf.STEP_RUN_SECONDS).Add(time.Since(start).Seconds())
+	}
 	ctx.Debugf("TestStep finished '%v', rs %s", err, string(resultResumeState))
 
 	sr.mu.Lock()
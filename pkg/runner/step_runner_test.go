@@ -14,6 +14,7 @@ import (
 	"github.com/linuxboot/contest/pkg/xcontext"
 	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
 	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/pkg/xcontext/metrics/perf"
 	"github.com/linuxboot/contest/plugins/teststeps"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -111,6 +112,11 @@ func (s *StepRunnerSuite) TestRunningStep() {
 	require.NoError(s.T(), res.Err)
 
 	require.Equal(s.T(), inputResumeState, obtainedResumeState)
+
+	metrics := ctx.Metrics().WithTags(nil).WithTag(perf.TAG_STEP_PLUGIN, stateFullStepName)
+	require.Equal(s.T(), uint64(1), metrics.Count(perf.STEP_RUNS).Add(0))
+	require.Equal(s.T(), uint64(1), metrics.WithTag(perf.TAG_TARGET_RESULT, perf.TARGET_RESULT_PASS).Count(perf.TARGET_RESULTS).Add(0))
+	require.Equal(s.T(), uint64(1), metrics.WithTag(perf.TAG_TARGET_RESULT, perf.TARGET_RESULT_FAIL).Count(perf.TARGET_RESULTS).Add(0))
 }
 
 func (s *StepRunnerSuite) TestAddSameTargetSequentiallyTimes() {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/insomniacslk/xjson"
 
+	"github.com/linuxboot/contest/pkg/artifact"
 	"github.com/linuxboot/contest/pkg/cerrors"
 	"github.com/linuxboot/contest/pkg/config"
 	"github.com/linuxboot/contest/pkg/event/testevent"
@@ -25,17 +26,19 @@ import (
 
 // TestRunner is the state associated with a test run.
 // Here's how a test run works:
+//  * Steps form a graph, as determined by test.StepDependencies. Without explicit dependencies
+//    it's a linear pipeline where each step depends on the previous one.
 //  * Each target gets a targetState and a "target handler" - a goroutine that takes that particular
-//    target through each step of the pipeline in sequence. It injects the target, waits for the result,
-//    then moves on to the next step.
+//    target through the steps of the pipeline. It injects the target into every step whose dependencies
+//    the target has completed, waits for the results, then moves on to the steps that became ready.
 //  * Each step of the pipeline gets a stepState and:
 //    - A "step runner" - a goroutine that is responsible for running the step's Run() method
 //    - A "step reader" - a goroutine that processes results and sends them on to target handlers that await them.
 //  * After starting all of the above, the main goroutine goes into "monitor" mode
 //    that checks on the pipeline's progress and is responsible for closing step input channels
 //    when all the targets have been injected.
-//  * Monitor loop finishes when all the targets have been injected into the last step
-//    or if a step has encountered an error.
+//  * Monitor loop finishes when input channels of all the steps are closed and all the targets
+//    are done, or if a step has encountered an error.
 //  * We then wait for all the step runners and readers to shut down.
 //  * Once all the activity has died down, resulting state is examined and an error is returned, if any.
 type TestRunner struct {
@@ -58,8 +61,9 @@ type stepState struct {
 
 	stepIndex int                 // Index of this step in the pipeline.
 	sb        test.TestStepBundle // The test bundle.
+	deps      []int               // Indices of the steps this step depends on.
 
-	ev                 testevent.Emitter
+	ev                 *stepEventsEmitter
 	stepRunner         *StepRunner
 	addTarget          AddTargetToStep
 	readingLoopRunning bool
@@ -79,6 +83,7 @@ const (
 	targetStepPhaseRun                           // (3) Injected into step.
 	targetStepPhaseResultPending                 // (4) Result posted to the handler.
 	targetStepPhaseEnd                           // (5) Finished running a step.
+	targetStepPhaseSkipped                       // (6) Not entered because of the step's run condition.
 )
 
 // targetState contains state associated with one target progressing through the pipeline.
@@ -86,12 +91,13 @@ type targetState struct {
 	tgt *target.Target
 
 	// This part of state gets serialized into JSON for resumption.
-	CurStep  int             `json:"S,omitempty"` // Current step number.
-	CurPhase targetStepPhase `json:"P,omitempty"` // Current phase of step execution.
-	Res      *xjson.Error    `json:"R,omitempty"` // Final result, if reached the end state.
+	Steps []targetStepPhase `json:"S"`           // Phase of execution of each step, the target may be in several at once.
+	Res   *xjson.Error      `json:"R,omitempty"` // Result of the first failed step, if any.
+	// Current attempt of each step, see test.StepRetryParameters.
+	Attempts []uint32 `json:"A,omitempty"`
 
 	handlerRunning bool
-	resCh          chan error // Channel used to communicate result by the step runner.
+	resCh          []chan error // Channels used to communicate results of each step by the step runners.
 }
 
 // resumeStateStruct is used to serialize runner state to be resumed in the future.
@@ -104,10 +110,55 @@ type resumeStateStruct struct {
 // Resume state version we are compatible with.
 // When imcompatible changes are made to the state format, bump this.
 // Restoring incompatible state will abort the job.
-const resumeStateStructVersion = 2
+const resumeStateStructVersion = 3
 
 type TestStepEventsEmitterFactory interface {
 	New(testStepLabel string) testevent.Emitter
+	// NewAttempt creates an emitter for the events of a retry of the step.
+	NewAttempt(testStepLabel string, testStepAttempt uint32) testevent.Emitter
+}
+
+// stepEventsEmitter emits the events of a step. Events which refer to a target
+// are recorded with the attempt of the step the target is currently in.
+type stepEventsEmitter struct {
+	factory TestStepEventsEmitterFactory
+	label   string
+
+	mu       sync.Mutex
+	attempts map[string]uint32
+	emitters map[uint32]testevent.Emitter
+}
+
+func newStepEventsEmitter(factory TestStepEventsEmitterFactory, testStepLabel string) *stepEventsEmitter {
+	return &stepEventsEmitter{
+		factory:  factory,
+		label:    testStepLabel,
+		attempts: make(map[string]uint32),
+		emitters: make(map[uint32]testevent.Emitter),
+	}
+}
+
+// Emit emits an event with the attempt of the target it refers to.
+func (e *stepEventsEmitter) Emit(ctx xcontext.Context, data testevent.Data) error {
+	e.mu.Lock()
+	var attempt uint32
+	if data.Target != nil {
+		attempt = e.attempts[data.Target.ID]
+	}
+	emitter := e.emitters[attempt]
+	if emitter == nil {
+		emitter = e.factory.NewAttempt(e.label, attempt)
+		e.emitters[attempt] = emitter
+	}
+	e.mu.Unlock()
+	return emitter.Emit(ctx, data)
+}
+
+// setAttempt sets the attempt recorded in the events of a target.
+func (e *stepEventsEmitter) setAttempt(targetID string, attempt uint32) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.attempts[targetID] = attempt
 }
 
 // Run is the main enty point of the runner.
@@ -124,6 +175,11 @@ func (tr *TestRunner) Run(
 	targetsCtx, targetsCancel := xcontext.WithCancel(ctx)
 	defer targetsCancel()
 
+	deps, err := test.StepDependencies(t.TestStepsBundles)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// If we have state to resume, parse it.
 	var rs resumeStateStruct
 	if len(resumeState) > 0 {
@@ -135,6 +191,12 @@ func (tr *TestRunner) Run(
 			return nil, nil, fmt.Errorf("incompatible resume state version %d (want %d)",
 				rs.Version, resumeStateStructVersion)
 		}
+		for id, tgs := range rs.Targets {
+			if len(tgs.Steps) != len(t.TestStepsBundles) {
+				return nil, nil, fmt.Errorf("incompatible resume state: target %s has %d steps (want %d)",
+					id, len(tgs.Steps), len(t.TestStepsBundles))
+			}
+		}
 		tr.targets = rs.Targets
 	}
 
@@ -145,24 +207,28 @@ func (tr *TestRunner) Run(
 
 	// Initialize remaining fields of the target structures,
 	// build the map and kick off target processing.
-	minStep := len(tr.steps)
 	for _, tgt := range targets {
 		tgs := tr.targets[tgt.ID]
 		if tgs == nil {
 			tgs = &targetState{
-				CurPhase: targetStepPhaseInit,
+				Steps: make([]targetStepPhase, len(t.TestStepsBundles)),
 			}
+			for i := range tgs.Steps {
+				tgs.Steps[i] = targetStepPhaseInit
+			}
+		}
+		if len(tgs.Attempts) != len(t.TestStepsBundles) {
+			tgs.Attempts = make([]uint32, len(t.TestStepsBundles))
 		}
 		tgs.tgt = tgt
 		// Buffer of 1 is needed so that the step reader does not block when submitting result back
 		// to the target handler. Target handler may not yet be ready to receive the result,
 		// i.e. reporting TargetIn event which may involve network I/O.
-		tgs.resCh = make(chan error, 1)
-		tr.targets[tgt.ID] = tgs
-
-		if tgs.CurStep < minStep {
-			minStep = tgs.CurStep
+		tgs.resCh = make([]chan error, len(t.TestStepsBundles))
+		for i := range tgs.resCh {
+			tgs.resCh[i] = make(chan error, 1)
 		}
+		tr.targets[tgt.ID] = tgs
 	}
 
 	// Set up the pipeline
@@ -170,6 +236,7 @@ func (tr *TestRunner) Run(
 		stepCtx, stepCancel := xcontext.WithCancel(stepsCtx)
 		stepCtx = stepCtx.WithField("step_index", strconv.Itoa(i))
 		stepCtx = stepCtx.WithField("step_label", sb.TestStepLabel)
+		stepCtx = artifact.WithTestStep(stepCtx, sb.TestStepLabel)
 
 		var srs json.RawMessage
 		if i < len(rs.StepResumeState) && string(rs.StepResumeState[i]) != "null" {
@@ -179,7 +246,7 @@ func (tr *TestRunner) Run(
 		// Collect "processed" targets in resume state for a StepRunner
 		var resumeStateTargets []target.Target
 		for _, tgt := range tr.targets {
-			if tgt.CurStep == i && tgt.CurPhase == targetStepPhaseRun {
+			if tgt.Steps[i] == targetStepPhaseRun {
 				resumeStateTargets = append(resumeStateTargets, *tgt.tgt)
 			}
 		}
@@ -189,11 +256,17 @@ func (tr *TestRunner) Run(
 			cancel:             stepCancel,
 			stepIndex:          i,
 			sb:                 sb,
-			ev:                 emitterFactory.New(sb.TestStepLabel),
+			deps:               deps[i],
+			ev:                 newStepEventsEmitter(emitterFactory, sb.TestStepLabel),
 			stepRunner:         NewStepRunner(),
 			resumeState:        srs,
 			resumeStateTargets: resumeStateTargets,
 		}
+		for id, tgs := range tr.targets {
+			if tgs.Attempts[i] > 0 {
+				ss.ev.setAttempt(id, tgs.Attempts[i])
+			}
+		}
 		// Step handlers will be started from target handlers as targets reach them.
 		tr.steps = append(tr.steps, ss)
 	}
@@ -209,7 +282,7 @@ func (tr *TestRunner) Run(
 	}
 
 	// Run until no more progress can be made.
-	runErr := tr.runMonitor(ctx, minStep)
+	runErr := tr.runMonitor(ctx)
 	if runErr != nil {
 		ctx.Errorf("monitor returned error: %q, canceling", runErr)
 		stepsCancel()
@@ -241,17 +314,23 @@ func (tr *TestRunner) Run(
 	numInFlightTargets := 0
 	for i, tgt := range targets {
 		tgs := tr.targets[tgt.ID]
-		stepErr := tr.steps[tgs.CurStep].runErr
-		if tgs.CurPhase == targetStepPhaseRun {
-			numInFlightTargets++
-			if stepErr != xcontext.ErrPaused {
+		inFlight := false
+		for j, phase := range tgs.Steps {
+			stepErr := tr.steps[j].runErr
+			if phase == targetStepPhaseRun {
+				inFlight = true
+				if stepErr != xcontext.ErrPaused {
+					resumeOk = false
+				}
+			}
+			if phase != targetStepPhaseInit && phase != targetStepPhaseSkipped && stepErr != nil && stepErr != xcontext.ErrPaused {
 				resumeOk = false
 			}
 		}
-		if stepErr != nil && stepErr != xcontext.ErrPaused {
-			resumeOk = false
+		if inFlight {
+			numInFlightTargets++
 		}
-		ctx.Debugf("  %d %s %v %t", i, tgs, stepErr, resumeOk)
+		ctx.Debugf("  %d %s %t", i, tgs, resumeOk)
 	}
 	ctx.Debugf("- %d in flight, ok to resume? %t", numInFlightTargets, resumeOk)
 	ctx.Debugf("step states:")
@@ -292,7 +371,7 @@ func (tr *TestRunner) Run(
 	for id, state := range tr.targets {
 		if state.Res != nil {
 			targetsResults[id] = state.Res.Unwrap()
-		} else if state.CurStep == len(tr.steps)-1 && state.CurPhase == targetStepPhaseEnd {
+		} else if state.finishedLocked() {
 			targetsResults[id] = nil
 		}
 	}
@@ -353,15 +432,15 @@ func (tr *TestRunner) waitStepRunners(ctx xcontext.Context) error {
 }
 
 func (tr *TestRunner) injectTarget(ctx xcontext.Context, tgs *targetState, ss *stepState) error {
-	ctx.Debugf("%s: injecting into %s", tgs, ss)
+	ctx.Debugf("%s: injecting into %s", tgs.tgt, ss)
 
 	tgt := tgs.tgt
 	err := ss.addTarget(ctx, tgt)
 	if err == nil {
 		tr.mu.Lock()
 		// By the time we get here the target could have been processed and result posted already, hence the check.
-		if tgs.CurPhase == targetStepPhaseBegin {
-			tgs.CurPhase = targetStepPhaseRun
+		if tgs.Steps[ss.stepIndex] == targetStepPhaseBegin {
+			tgs.Steps[ss.stepIndex] = targetStepPhaseRun
 		}
 		tr.mu.Unlock()
 	}
@@ -369,52 +448,60 @@ func (tr *TestRunner) injectTarget(ctx xcontext.Context, tgs *targetState, ss *s
 	return err
 }
 
-func (tr *TestRunner) awaitTargetResult(ctx xcontext.Context, tgs *targetState, ss *stepState) error {
+// awaitTargetResult waits for the result of the target in the step and returns
+// whether the target is to be retried.
+func (tr *TestRunner) awaitTargetResult(ctx xcontext.Context, tgs *targetState, ss *stepState) (bool, error) {
 	tr.mu.Lock()
-	resCh := tgs.resCh
+	resCh := tgs.resCh[ss.stepIndex]
 	tr.mu.Unlock()
 
 	if resCh == nil {
 		// Channel is closed when job is paused to make sure all results are processed.
-		ctx.Debugf("%s: result channel closed", tgs)
-		return xcontext.ErrPaused
+		ctx.Debugf("%s: result channel closed", tgs.tgt)
+		return false, xcontext.ErrPaused
 	}
 
-	processTargetResult := func(res error) {
-		ctx.Debugf("%s: result recd for %s", tgs, ss)
+	processTargetResult := func(res error) bool {
+		ctx.Debugf("%s: result recd for %s", tgs.tgt, ss)
 		tr.mu.Lock()
-		if res != nil {
-			tgs.Res = xjson.NewError(res)
+		retry := ss.sb.Retry.ShouldRetry(tgs.Attempts[ss.stepIndex], res)
+		if retry {
+			// The target goes back to the injection phase, so the step stays open for it.
+			tgs.Attempts[ss.stepIndex]++
+			tgs.Steps[ss.stepIndex] = targetStepPhaseBegin
+		} else {
+			if res != nil && tgs.Res == nil {
+				tgs.Res = xjson.NewError(res)
+			}
+			tgs.Steps[ss.stepIndex] = targetStepPhaseEnd
 		}
-		tgs.CurPhase = targetStepPhaseEnd
 		tr.mu.Unlock()
 		tr.monitorCond.Signal()
+		return retry
 	}
 
-	onCancelled := func() error {
+	onCancelled := func() (bool, error) {
 		// we might have a race-condition here when both events happen
 		// in this case we should prioritise result processing
 		select {
 		case res := <-resCh:
-			processTargetResult(res)
-			return nil
+			return processTargetResult(res), nil
 		default:
 		}
 		tr.mu.Lock()
 		ctx.Debugf("%s: canceled 2", tgs)
 		tr.mu.Unlock()
-		return xcontext.ErrCanceled
+		return false, xcontext.ErrCanceled
 	}
 
 	select {
 	case res, ok := <-resCh:
 		if !ok {
 			// Channel is closed when job is paused to make sure all results are processed.
-			ctx.Debugf("%s: result channel closed", tgs)
-			return xcontext.ErrPaused
+			ctx.Debugf("%s: result channel closed", tgs.tgt)
+			return false, xcontext.ErrPaused
 		}
-		processTargetResult(res)
-		return nil
+		return processTargetResult(res), nil
 	// Check for cancellation.
 	// Notably we are not checking for the pause condition here:
 	// when paused, we want to let all the injected targets to finish
@@ -427,88 +514,154 @@ func (tr *TestRunner) awaitTargetResult(ctx xcontext.Context, tgs *targetState,
 	}
 }
 
-// targetHandler takes a single target through each step of the pipeline in sequence.
-// It injects the target, waits for the result, then moves on to the next step.
+// targetHandler takes a single target through the steps of the pipeline.
+// The target is injected into every step whose dependencies it has completed, so it may
+// be in several steps at once. Once the target fails a step, it only enters the steps
+// which run on failure, see test.StepRunCondition. Steps it does not enter are skipped.
 func (tr *TestRunner) targetHandler(ctx xcontext.Context, tgs *targetState) {
 	ctx = ctx.WithField("target", tgs.tgt.ID)
-	ctx.Debugf("%s: target handler active", tgs)
-	// NB: CurStep may be non-zero on entry if resumed
-loop:
-	for i := tgs.CurStep; i < len(tr.steps); {
+	ctx.Debugf("%s: target handler active", tgs.tgt)
+	started := make([]bool, len(tr.steps))
+	doneCh := make(chan error)
+	numInFlight := 0
+	stop := false
+	// NB: some steps may be beyond the init phase on entry if resumed
+	for {
 		// Early check for pause or cancellation.
 		select {
 		case <-ctx.Until(xcontext.ErrPaused):
-			ctx.Debugf("%s: paused 0", tgs)
-			break loop
+			ctx.Debugf("%s: paused 0", tgs.tgt)
+			stop = true
 		case <-ctx.Done():
-			ctx.Debugf("%s: canceled 0", tgs)
-			break loop
+			ctx.Debugf("%s: canceled 0", tgs.tgt)
+			stop = true
 		default:
 		}
 		tr.mu.Lock()
-		ss := tr.steps[i]
-		var inject bool
-		switch tgs.CurPhase {
-		case targetStepPhaseInit:
-			// Normal case, inject and wait for result.
-			inject = true
-			tgs.CurPhase = targetStepPhaseBegin
-		case targetStepPhaseBegin:
-			// Paused before injection.
-			inject = true
-		case targetStepPhaseRun:
-			// Resumed in running state, skip injection.
-			inject = false
-		case targetStepPhaseEnd:
-			// Resumed in terminal state, we are done.
-			tr.mu.Unlock()
-			break loop
-		default:
-			ctx.Errorf("%s: invalid phase %s", tgs, tgs.CurPhase)
-			break loop
+		for scan := true; scan; {
+			scan = false
+			for i, ss := range tr.steps {
+				if started[i] {
+					continue
+				}
+				var inject bool
+				switch tgs.Steps[i] {
+				case targetStepPhaseInit:
+					// Normal case, inject and wait for result once the dependencies are done.
+					if stop || !tgs.depsDoneLocked(ss) {
+						continue
+					}
+					if !ss.sb.RunIf.ShouldRun(tgs.Res != nil) {
+						ctx.Debugf("%s: skipping %s", tgs, ss)
+						tgs.Steps[i] = targetStepPhaseSkipped
+						// Steps depending on this one may be ready now.
+						scan = true
+						continue
+					}
+					inject = true
+					tgs.Steps[i] = targetStepPhaseBegin
+				case targetStepPhaseBegin:
+					// Paused before injection.
+					if stop {
+						continue
+					}
+					inject = true
+				case targetStepPhaseRun:
+					// Resumed in running state, skip injection.
+					inject = false
+				case targetStepPhaseEnd, targetStepPhaseSkipped:
+					// Resumed in terminal state, nothing to do.
+					continue
+				default:
+					ctx.Errorf("%s: invalid phase %s", tgs, tgs.Steps[i])
+					stop = true
+					continue
+				}
+				started[i] = true
+				numInFlight++
+				go func(ss *stepState, inject bool) {
+					doneCh <- tr.runTargetStep(ctx, tgs, ss, inject)
+				}(ss, inject)
+			}
 		}
 		tr.mu.Unlock()
+		if numInFlight == 0 {
+			break
+		}
+		if err := <-doneCh; err != nil {
+			stop = true
+		}
+		numInFlight--
+	}
+	tr.mu.Lock()
+	ctx.Debugf("%s: target handler finished", tgs)
+	tgs.handlerRunning = false
+	tr.monitorCond.Signal()
+	tr.mu.Unlock()
+}
+
+// runTargetStep takes a target through a single step: it injects the target and waits for the result.
+// If the step has a retry policy, a target that fails is injected again until it passes or runs out of attempts.
+func (tr *TestRunner) runTargetStep(ctx xcontext.Context, tgs *targetState, ss *stepState, inject bool) error {
+	var err error
+	for {
 		// Make sure we have a step runner active. If not, start one.
-		err := tr.runStepIfNeeded(ss)
+		err = tr.runStepIfNeeded(ss)
 		// Inject the target.
 		if err == nil && inject {
 			err = tr.injectTarget(ctx, tgs, ss)
 		}
 		// Await result. It will be communicated to us by the step runner
 		// and returned in tgs.res.
+		var retry bool
 		if err == nil {
-			err = tr.awaitTargetResult(ctx, tgs, ss)
+			retry, err = tr.awaitTargetResult(ctx, tgs, ss)
 		}
-		tr.mu.Lock()
-		if err != nil {
-			ctx.Errorf("%s", err)
-			switch err {
-			case xcontext.ErrPaused:
-				ctx.Debugf("%s: paused 1", tgs)
-			case xcontext.ErrCanceled:
-				ctx.Debugf("%s: canceled 1", tgs)
-			default:
-				ss.setErrLocked(err)
-			}
-			tr.mu.Unlock()
+		if err != nil || !retry {
 			break
 		}
-		if tgs.Res != nil {
-			tr.mu.Unlock()
+		if err = tr.awaitRetry(ctx, tgs, ss); err != nil {
 			break
 		}
-		i++
-		if i < len(tr.steps) {
-			tgs.CurStep = i
-			tgs.CurPhase = targetStepPhaseInit
+		inject = true
+	}
+	if err != nil {
+		tr.mu.Lock()
+		ctx.Errorf("%s", err)
+		switch err {
+		case xcontext.ErrPaused:
+			ctx.Debugf("%s: paused 1", tgs)
+		case xcontext.ErrCanceled:
+			ctx.Debugf("%s: canceled 1", tgs)
+		default:
+			ss.setErrLocked(err)
 		}
 		tr.mu.Unlock()
 	}
+	return err
+}
+
+// awaitRetry waits for the retry interval of the step before the target is injected again.
+// If paused in the meantime, the target is injected right away when the job is resumed.
+func (tr *TestRunner) awaitRetry(ctx xcontext.Context, tgs *targetState, ss *stepState) error {
 	tr.mu.Lock()
-	ctx.Debugf("%s: target handler finished", tgs)
-	tgs.handlerRunning = false
-	tr.monitorCond.Signal()
+	attempt := tgs.Attempts[ss.stepIndex]
 	tr.mu.Unlock()
+	ctx.Infof("%s: retrying %s, attempt %d of %d", tgs.tgt, ss, attempt, ss.sb.Retry.NumRetries)
+	ss.ev.setAttempt(tgs.tgt.ID, attempt)
+
+	timer := time.NewTimer(time.Duration(ss.sb.Retry.RetryInterval))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Until(xcontext.ErrPaused):
+		ctx.Debugf("%s: paused 2", tgs.tgt)
+		return xcontext.ErrPaused
+	case <-ctx.Done():
+		ctx.Debugf("%s: canceled 3", tgs.tgt)
+		return xcontext.ErrCanceled
+	}
+	return nil
 }
 
 // runStepIfNeeded starts the step runner goroutine if not already running.
@@ -584,7 +737,8 @@ func (tr *TestRunner) reportTargetResult(ctx xcontext.Context, ss *stepState, tg
 		defer tr.mu.Unlock()
 
 		tgs := tr.targets[tgt.ID]
-		if tgs.resCh == nil {
+		resCh := tgs.resCh[ss.stepIndex]
+		if resCh == nil {
 			// If canceled or paused, target handler may have left early. We don't care though.
 			select {
 			case <-ctx.Done():
@@ -594,9 +748,9 @@ func (tr *TestRunner) reportTargetResult(ctx xcontext.Context, ss *stepState, tg
 				return nil, fmt.Errorf("%s: target handler %s is not there, dropping result on the floor", ss, tgs)
 			}
 		}
-		tgs.CurPhase = targetStepPhaseResultPending
+		tgs.Steps[ss.stepIndex] = targetStepPhaseResultPending
 		ctx.Debugf("%s: result for %s: %v", ss, tgs, res)
-		return tgs.resCh, nil
+		return resCh, nil
 	}()
 	if err != nil {
 		return err
@@ -621,9 +775,9 @@ func (tr *TestRunner) checkStepRunnersFailed() error {
 		case xcontext.ErrPaused:
 			// This is fine, just need to unblock target handlers waiting on result from this step.
 			for _, tgs := range tr.targets {
-				if tgs.resCh != nil && tgs.CurStep == i {
-					close(tgs.resCh)
-					tgs.resCh = nil
+				if tgs.resCh[i] != nil {
+					close(tgs.resCh[i])
+					tgs.resCh[i] = nil
 				}
 			}
 		default:
@@ -638,82 +792,55 @@ func (tr *TestRunner) checkStepRunnersFailed() error {
 // It also monitors steps for critical errors and cancels the whole run.
 // Note: input channels remain open when cancellation is requested,
 // plugins are expected to handle it explicitly.
-func (tr *TestRunner) runMonitor(ctx xcontext.Context, minStep int) error {
+func (tr *TestRunner) runMonitor(ctx xcontext.Context) error {
 	ctx.Debugf("monitor: active")
-	if minStep < len(tr.steps) {
-		ctx.Debugf("monitor: starting at step %s", tr.steps[minStep])
-	}
 
-	// Run the main loop.
-	runErr := func() error {
-		tr.mu.Lock()
-		defer tr.mu.Unlock()
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
 
-		pass := 1
-		var runErr error
-	stepLoop:
-		for step := minStep; step < len(tr.steps); pass++ {
-			ss := tr.steps[step]
-			ctx.Debugf("monitor pass %d: current step %s", pass, ss)
-			// Check if all the targets have either made it past the injection phase or terminated.
-			ok := true
-			for _, tgs := range tr.targets {
-				ctx.Debugf("monitor pass %d: %s: %s", pass, ss, tgs)
-				if !tgs.handlerRunning { // Not running anymore
-					continue
+	stopped := make([]bool, len(tr.steps))
+	var runErr error
+	for pass := 1; ; pass++ {
+		if runErr = tr.checkStepRunnersFailed(); runErr != nil {
+			break
+		}
+		numStopped := 0
+		for i, ss := range tr.steps {
+			if !stopped[i] {
+				// Check if all the targets have either made it past the injection phase,
+				// will never get to this step or terminated.
+				ok := true
+				for _, tgs := range tr.targets {
+					ctx.Debugf("monitor pass %d: %s: %s", pass, ss, tgs)
+					if tgs.mayEnterLocked(ss) {
+						ctx.Debugf("monitor pass %d: %s: not all targets injected yet (%s)", pass, ss, tgs)
+						ok = false
+						break
+					}
 				}
-				if tgs.CurStep < step || tgs.CurPhase < targetStepPhaseRun {
-					ctx.Debugf("monitor pass %d: %s: not all targets injected yet (%s)", pass, ss, tgs)
-					ok = false
-					break
+				if ok {
+					ctx.Debugf("monitor pass %d: %s: no more targets, closing input channel", pass, ss)
+					ss.stepRunner.Stop()
+					stopped[i] = true
 				}
 			}
-			if runErr = tr.checkStepRunnersFailed(); runErr != nil {
-				break stepLoop
+			if stopped[i] {
+				numStopped++
 			}
-			if !ok {
-				// Wait for notification: as progress is being made, we get notified.
-				tr.monitorCond.Wait()
-				continue
-			}
-			// All targets ok, close the step's input channel.
-			ctx.Debugf("monitor pass %d: %s: no more targets, closing input channel", pass, ss)
-			ss.stepRunner.Stop()
-			step++
 		}
-		return runErr
-	}()
-	if runErr != nil {
-		return runErr
-	}
-
-	//
-	// After all targets were sent to the steps we should monitor steps for incoming errors
-	//
-	tr.mu.Lock()
-	defer tr.mu.Unlock()
-
-	var pass int
-tgtLoop:
-	for ; runErr == nil; pass++ {
-		if runErr = tr.checkStepRunnersFailed(); runErr != nil {
-			break tgtLoop
-		}
-		done := true
-		for _, tgs := range tr.targets {
-			ctx.Debugf("monitor pass %d: %s", pass, tgs)
-			if tgs.handlerRunning && (tgs.CurStep < len(tr.steps) || tgs.CurPhase != targetStepPhaseEnd) {
-				if tgs.CurPhase == targetStepPhaseRun && tr.steps[tgs.CurStep].runErr == xcontext.ErrPaused {
-					// It's been paused, this is fine.
-					continue
+		if numStopped == len(tr.steps) {
+			done := true
+			for _, tgs := range tr.targets {
+				ctx.Debugf("monitor pass %d: %s", pass, tgs)
+				if !tr.targetDoneLocked(tgs) {
+					done = false
+					break
 				}
-				done = false
+			}
+			if done {
 				break
 			}
 		}
-		if done {
-			break
-		}
 		// Wait for notification: as progress is being made, we get notified.
 		tr.monitorCond.Wait()
 	}
@@ -721,6 +848,63 @@ tgtLoop:
 	return runErr
 }
 
+// targetDoneLocked checks if the target handler has nothing left to do.
+func (tr *TestRunner) targetDoneLocked(tgs *targetState) bool {
+	if !tgs.handlerRunning {
+		return true
+	}
+	// Targets only waiting for results from paused steps are fine.
+	numPaused := 0
+	for i, phase := range tgs.Steps {
+		switch phase {
+		case targetStepPhaseBegin, targetStepPhaseResultPending:
+			return false
+		case targetStepPhaseRun:
+			if tr.steps[i].runErr != xcontext.ErrPaused {
+				return false
+			}
+			numPaused++
+		}
+	}
+	return numPaused > 0
+}
+
+// depsDoneLocked checks if the target has completed or skipped all the dependencies of a step.
+func (tgs *targetState) depsDoneLocked(ss *stepState) bool {
+	for _, dep := range ss.deps {
+		if !tgs.Steps[dep].terminal() {
+			return false
+		}
+	}
+	return true
+}
+
+// mayEnterLocked checks if the target may still be injected into the step.
+func (tgs *targetState) mayEnterLocked(ss *stepState) bool {
+	if !tgs.handlerRunning {
+		return false
+	}
+	switch tgs.Steps[ss.stepIndex] {
+	case targetStepPhaseInit, targetStepPhaseBegin:
+		// Targets in the init phase enter the step or skip it once its dependencies are done.
+		return true
+	case targetStepPhaseRun, targetStepPhaseResultPending:
+		// Targets may fail and be injected again if they have attempts left.
+		return tgs.Attempts[ss.stepIndex] < ss.sb.Retry.NumRetries
+	}
+	return false
+}
+
+// finishedLocked checks if the target has completed or skipped all the steps.
+func (tgs *targetState) finishedLocked() bool {
+	for _, phase := range tgs.Steps {
+		if !phase.terminal() {
+			return false
+		}
+	}
+	return true
+}
+
 func NewTestRunnerWithTimeouts(shutdownTimeout time.Duration) *TestRunner {
 	tr := &TestRunner{
 		shutdownTimeout: shutdownTimeout,
@@ -747,10 +931,17 @@ func (tph targetStepPhase) String() string {
 		return "result_pending"
 	case targetStepPhaseEnd:
 		return "end"
+	case targetStepPhaseSkipped:
+		return "skipped"
 	}
 	return fmt.Sprintf("???(%d)", tph)
 }
 
+// terminal returns whether the target is done with a step.
+func (tph targetStepPhase) terminal() bool {
+	return tph == targetStepPhaseEnd || tph == targetStepPhaseSkipped
+}
+
 func (ss *stepState) String() string {
 	return fmt.Sprintf("[#%d %s]", ss.stepIndex, ss.sb.TestStepLabel)
 }
@@ -767,6 +958,6 @@ func (tgs *targetState) String() string {
 		resText = "<nil>"
 	}
 	finished := !tgs.handlerRunning
-	return fmt.Sprintf("[%s %d %s %t %s]",
-		tgs.tgt, tgs.CurStep, tgs.CurPhase, finished, resText)
+	return fmt.Sprintf("[%s %v %t %s]",
+		tgs.tgt, tgs.Steps, finished, resText)
 }
@@ -6,24 +6,29 @@
 package runner
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/insomniacslk/xjson"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/linuxboot/contest/pkg/cerrors"
 	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/testevent"
 	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/test"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
 	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/plugins/teststeps"
 	"github.com/linuxboot/contest/tests/common"
 	"github.com/linuxboot/contest/tests/common/goroutine_leak_check"
 	"github.com/linuxboot/contest/tests/plugins/teststeps/badtargets"
@@ -575,3 +580,337 @@ func (s *TestRunnerSuite) TestPauseResumeSimple() {
 {[1 5 SimpleTest 0 Step 3][Target{ID: "T2"} TargetOut]}
 `, s.MemoryStorage.GetTargetEvents(ctx, testName, "T2"))
 }
+
+func dependsOn(sb test.TestStepBundle, labels ...string) test.TestStepBundle {
+	sb.DependsOn = labels
+	return sb
+}
+
+// Steps forming a graph: targets fan out to two parallel branches and fan in again.
+func (s *TestRunnerSuite) TestGraphFanOutFanIn() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	tr := newTestRunner()
+	_, targetsResults, err := s.runWithTimeout(ctx, tr, nil, 1, 2*time.Second,
+		[]*target.Target{tgt("T1"), tgt("T2")},
+		[]test.TestStepBundle{
+			// Listed out of order on purpose.
+			dependsOn(s.newTestStep(ctx, "Power off", 0, "", ""), "Chipsec", "FWTS"),
+			s.newTestStep(ctx, "Flash", 0, "", ""),
+			// Both targets are slow here, they are expected to go through FWTS in the meantime.
+			dependsOn(s.newTestStep(ctx, "Chipsec", 0, "", "*=200"), "Flash"),
+			dependsOn(s.newTestStep(ctx, "FWTS", 0, "", ""), "Flash"),
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), map[string]error{
+		"T1": nil,
+		"T2": nil,
+	}, targetsResults)
+
+	for _, id := range []string{"T1", "T2"} {
+		events := s.MemoryStorage.GetTargetEvents(ctx, testName, id)
+		for _, step := range []string{"Flash", "Chipsec", "FWTS", "Power off"} {
+			require.Contains(s.T(), events, fmt.Sprintf(`{[1 1 SimpleTest 0 %s][Target{ID: "%s"} TestFinishedEvent]}`, step, id))
+		}
+		flashOut := strings.Index(events, "0 Flash][Target{ID: \""+id+"\"} TargetOut]")
+		chipsecIn := strings.Index(events, "0 Chipsec][Target{ID: \""+id+"\"} TargetIn]")
+		chipsecOut := strings.Index(events, "0 Chipsec][Target{ID: \""+id+"\"} TargetOut]")
+		fwtsIn := strings.Index(events, "0 FWTS][Target{ID: \""+id+"\"} TargetIn]")
+		fwtsOut := strings.Index(events, "0 FWTS][Target{ID: \""+id+"\"} TargetOut]")
+		powerOffIn := strings.Index(events, "0 Power off][Target{ID: \""+id+"\"} TargetIn]")
+		require.Less(s.T(), flashOut, chipsecIn)
+		require.Less(s.T(), flashOut, fwtsIn)
+		require.Less(s.T(), fwtsOut, chipsecOut)
+		require.Less(s.T(), chipsecOut, powerOffIn)
+	}
+}
+
+// A target failing one branch of the graph still completes the other branch
+// but does not enter the steps that depend on the failed one.
+func (s *TestRunnerSuite) TestGraphFailedBranch() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	tr := newTestRunner()
+	_, targetsResults, err := s.runWithTimeout(ctx, tr, nil, 1, 2*time.Second,
+		[]*target.Target{tgt("T1"), tgt("T2")},
+		[]test.TestStepBundle{
+			s.newTestStep(ctx, "Flash", 0, "", ""),
+			dependsOn(s.newTestStep(ctx, "Chipsec", 0, "T1", ""), "Flash"),
+			dependsOn(s.newTestStep(ctx, "FWTS", 0, "", "T1=100"), "Flash"),
+			dependsOn(s.newTestStep(ctx, "Power off", 0, "", ""), "Chipsec", "FWTS"),
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), targetsResults, 2)
+	require.Error(s.T(), targetsResults["T1"])
+	require.NoError(s.T(), targetsResults["T2"])
+
+	events := s.MemoryStorage.GetTargetEvents(ctx, testName, "T1")
+	require.Contains(s.T(), events, `{[1 1 SimpleTest 0 Chipsec][Target{ID: "T1"} TestFailedEvent]}`)
+	require.Contains(s.T(), events, `{[1 1 SimpleTest 0 FWTS][Target{ID: "T1"} TestFinishedEvent]}`)
+	require.NotContains(s.T(), events, "Power off")
+	require.Contains(s.T(), s.MemoryStorage.GetTargetEvents(ctx, testName, "T2"),
+		`{[1 1 SimpleTest 0 Power off][Target{ID: "T2"} TestFinishedEvent]}`)
+}
+
+// Pausing and resuming a graph with targets in flight in parallel branches.
+func (s *TestRunnerSuite) TestGraphPauseResume() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	targets := []*target.Target{tgt("T1"), tgt("T2")}
+	steps := []test.TestStepBundle{
+		s.newTestStep(ctx, "Flash", 0, "", ""),
+		// T1 and T2 will be paused here, the step will be given time to finish.
+		dependsOn(s.newTestStep(ctx, "Chipsec", 0, "", "*=300"), "Flash"),
+		// T2 fails here before the pause.
+		dependsOn(s.newTestStep(ctx, "FWTS", 0, "T2", ""), "Flash"),
+		dependsOn(s.newTestStep(ctx, "Power off", 0, "", ""), "Chipsec", "FWTS"),
+	}
+	var resumeState []byte
+	{
+		tr1 := newTestRunner()
+		ctx1, pause := xcontext.WithNotify(ctx, xcontext.ErrPaused)
+		ctx1, cancel := xcontext.WithCancel(ctx1)
+		defer cancel()
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			pause()
+		}()
+		var err error
+		resumeState, _, err = s.runWithTimeout(ctx1, tr1, nil, 1, 2*time.Second, targets, steps)
+		require.Error(s.T(), err)
+		require.IsType(s.T(), xcontext.ErrPaused, err)
+		require.NotNil(s.T(), resumeState)
+	}
+	{
+		tr2 := newTestRunner()
+		ctx2, cancel := xcontext.WithCancel(ctx)
+		defer cancel()
+		_, targetsResults, err := s.runWithTimeout(ctx2, tr2, resumeState, 5, 2*time.Second,
+			[]*target.Target{tgt("T1"), tgt("T2")}, steps)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), targetsResults, 2)
+		require.NoError(s.T(), targetsResults["T1"])
+		require.Error(s.T(), targetsResults["T2"])
+	}
+	// The branches were completed before pausing, only the last step ran after resuming.
+	require.Equal(s.T(), `
+{[1 5 SimpleTest 0 Power off][(*Target)(nil) TestStepRunningEvent]}
+{[1 5 SimpleTest 0 Power off][(*Target)(nil) TestStepFinishedEvent]}
+`, s.MemoryStorage.GetStepEvents(ctx, testName, "Power off"))
+	events := s.MemoryStorage.GetTargetEvents(ctx, testName, "T1")
+	require.True(s.T(), strings.HasSuffix(events, `
+{[1 5 SimpleTest 0 Power off][Target{ID: "T1"} TargetIn]}
+{[1 5 SimpleTest 0 Power off][Target{ID: "T1"} TestStartedEvent]}
+{[1 5 SimpleTest 0 Power off][Target{ID: "T1"} TestFinishedEvent]}
+{[1 5 SimpleTest 0 Power off][Target{ID: "T1"} TargetOut]}
+`), events)
+	require.Equal(s.T(), 4, strings.Count(events, "[1 5 "))
+	require.NotContains(s.T(), s.MemoryStorage.GetTargetEvents(ctx, testName, "T2"), "Power off")
+}
+
+func runIf(sb test.TestStepBundle, cond test.StepRunCondition) test.TestStepBundle {
+	sb.RunIf = cond
+	return sb
+}
+
+// Targets which failed a step skip the following steps, except the ones
+// which run on failure or always, and remain failed.
+func (s *TestRunnerSuite) TestRunIf() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	tr := newTestRunner()
+	_, targetsResults, err := s.runWithTimeout(ctx, tr, nil, 1, 2*time.Second,
+		[]*target.Target{tgt("T1"), tgt("T2")},
+		[]test.TestStepBundle{
+			s.newTestStep(ctx, "Step 1", 0, "T1", ""),
+			s.newTestStep(ctx, "Step 2", 0, "", ""),
+			runIf(s.newTestStep(ctx, "Collect logs", 0, "", ""), test.RunOnFailure),
+			runIf(s.newTestStep(ctx, "Power off", 0, "", ""), test.RunAlways),
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), targetsResults, 2)
+	require.Error(s.T(), targetsResults["T1"])
+	require.NoError(s.T(), targetsResults["T2"])
+
+	t1Events := s.MemoryStorage.GetTargetEvents(ctx, testName, "T1")
+	require.NotContains(s.T(), t1Events, "Step 2")
+	require.Contains(s.T(), t1Events, `{[1 1 SimpleTest 0 Collect logs][Target{ID: "T1"} TestFinishedEvent]}`)
+	require.Contains(s.T(), t1Events, `{[1 1 SimpleTest 0 Power off][Target{ID: "T1"} TestFinishedEvent]}`)
+
+	t2Events := s.MemoryStorage.GetTargetEvents(ctx, testName, "T2")
+	require.Contains(s.T(), t2Events, `{[1 1 SimpleTest 0 Step 2][Target{ID: "T2"} TestFinishedEvent]}`)
+	require.NotContains(s.T(), t2Events, "Collect logs")
+	require.Contains(s.T(), t2Events, `{[1 1 SimpleTest 0 Power off][Target{ID: "T2"} TestFinishedEvent]}`)
+}
+
+// A target failing a cleanup step is failed too.
+func (s *TestRunnerSuite) TestRunIfAlwaysFails() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	tr := newTestRunner()
+	_, targetsResults, err := s.runWithTimeout(ctx, tr, nil, 1, 2*time.Second,
+		[]*target.Target{tgt("T1")},
+		[]test.TestStepBundle{
+			s.newTestStep(ctx, "Step 1", 0, "", ""),
+			runIf(s.newTestStep(ctx, "Power off", 0, "T1", ""), test.RunAlways),
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), targetsResults, 1)
+	require.Error(s.T(), targetsResults["T1"])
+}
+
+func retry(sb test.TestStepBundle, numRetries uint32, retryOn string) test.TestStepBundle {
+	sb.Retry = test.StepRetryParameters{NumRetries: numRetries, RetryOn: retryOn}
+	if err := sb.Retry.Validate(); err != nil {
+		panic(err)
+	}
+	return sb
+}
+
+// newFlakyStep creates a step which fails each target with err the given number of times.
+func (s *TestRunnerSuite) newFlakyStep(ctx xcontext.Context, label string, numFailures int, err error) test.TestStepBundle {
+	var mu sync.Mutex
+	failures := make(map[string]int)
+	require.NoError(s.T(), s.RegisterStateFullStep(
+		func(ctx xcontext.Context, ch test.TestStepChannels, params test.TestStepParameters, ev testevent.Emitter, resumeState json.RawMessage) (json.RawMessage, error) {
+			return teststeps.ForEachTarget(stateFullStepName, ctx, ch, func(ctx xcontext.Context, target *target.Target) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if failures[target.ID] < numFailures {
+					failures[target.ID]++
+					return err
+				}
+				return nil
+			})
+		},
+		nil,
+	))
+	return s.NewStep(ctx, label, stateFullStepName, nil)
+}
+
+// stepAttempts returns the attempts of the routing events of a target in a step.
+func (s *TestRunnerSuite) stepAttempts(ctx xcontext.Context, stepLabel, targetID string) []string {
+	q, err := testevent.BuildQuery(testevent.QueryTestName(testName), testevent.QueryTestStepLabel(stepLabel))
+	require.NoError(s.T(), err)
+	events, err := s.MemoryStorage.Storage.GetTestEvents(ctx, q)
+	require.NoError(s.T(), err)
+	var res []string
+	for _, ev := range events {
+		if ev.Data.Target == nil || ev.Data.Target.ID != targetID {
+			continue
+		}
+		switch ev.Data.EventName {
+		case target.EventTargetIn, target.EventTargetOut, target.EventTargetErr:
+			res = append(res, fmt.Sprintf("%s %d", ev.Data.EventName, ev.Header.TestStepAttempt))
+		}
+	}
+	return res
+}
+
+// A target failing a step with a retry policy is injected into the same step again.
+func (s *TestRunnerSuite) TestStepRetry() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	tr := newTestRunner()
+	_, targetsResults, err := s.runWithTimeout(ctx, tr, nil, 1, 2*time.Second,
+		[]*target.Target{tgt("T1")},
+		[]test.TestStepBundle{
+			retry(s.newFlakyStep(ctx, "Step 1", 2, fmt.Errorf("timed out")), 2, "timed out"),
+			s.newTestStep(ctx, "Step 2", 0, "", ""),
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), targetsResults, 1)
+	require.NoError(s.T(), targetsResults["T1"])
+	require.Equal(s.T(), []string{
+		"TargetIn 0", "TargetErr 0",
+		"TargetIn 1", "TargetErr 1",
+		"TargetIn 2", "TargetOut 2",
+	}, s.stepAttempts(ctx, "Step 1", "T1"))
+	require.Equal(s.T(), []string{"TargetIn 0", "TargetOut 0"}, s.stepAttempts(ctx, "Step 2", "T1"))
+}
+
+// A target failing every attempt of a step is failed.
+func (s *TestRunnerSuite) TestStepRetryExhausted() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	tr := newTestRunner()
+	_, targetsResults, err := s.runWithTimeout(ctx, tr, nil, 1, 2*time.Second,
+		[]*target.Target{tgt("T1")},
+		[]test.TestStepBundle{
+			retry(s.newFlakyStep(ctx, "Step 1", 3, fmt.Errorf("timed out")), 1, ""),
+			s.newTestStep(ctx, "Step 2", 0, "", ""),
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), targetsResults, 1)
+	require.Error(s.T(), targetsResults["T1"])
+	require.Equal(s.T(), []string{"TargetIn 0", "TargetErr 0", "TargetIn 1", "TargetErr 1"}, s.stepAttempts(ctx, "Step 1", "T1"))
+	require.Empty(s.T(), s.stepAttempts(ctx, "Step 2", "T1"))
+}
+
+// Errors not matching the retry policy of a step are not retried.
+func (s *TestRunnerSuite) TestStepRetryNotMatching() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	tr := newTestRunner()
+	_, targetsResults, err := s.runWithTimeout(ctx, tr, nil, 1, 2*time.Second,
+		[]*target.Target{tgt("T1")},
+		[]test.TestStepBundle{
+			retry(s.newFlakyStep(ctx, "Step 1", 1, fmt.Errorf("wrong firmware version")), 3, "timed out"),
+		},
+	)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), targetsResults, 1)
+	require.Error(s.T(), targetsResults["T1"])
+	require.Equal(s.T(), []string{"TargetIn 0", "TargetErr 0"}, s.stepAttempts(ctx, "Step 1", "T1"))
+}
+
+// A target waiting to be retried when paused is injected again after resuming.
+func (s *TestRunnerSuite) TestStepRetryPauseResume() {
+	ctx, cancel := xcontext.WithCancel(logrusctx.NewContext(logger.LevelDebug))
+	defer cancel()
+
+	targets := []*target.Target{tgt("T1")}
+	sb := retry(s.newFlakyStep(ctx, "Step 1", 1, fmt.Errorf("timed out")), 1, "")
+	sb.Retry.RetryInterval = xjson.Duration(time.Minute)
+	steps := []test.TestStepBundle{sb}
+	var resumeState []byte
+	{
+		tr1 := newTestRunner()
+		ctx1, pause := xcontext.WithNotify(ctx, xcontext.ErrPaused)
+		ctx1, cancel := xcontext.WithCancel(ctx1)
+		defer cancel()
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			pause()
+		}()
+		var err error
+		resumeState, _, err = s.runWithTimeout(ctx1, tr1, nil, 1, 2*time.Second, targets, steps)
+		require.Error(s.T(), err)
+		require.IsType(s.T(), xcontext.ErrPaused, err)
+		require.NotNil(s.T(), resumeState)
+	}
+	{
+		tr2 := newTestRunner()
+		ctx2, cancel := xcontext.WithCancel(ctx)
+		defer cancel()
+		_, targetsResults, err := s.runWithTimeout(ctx2, tr2, resumeState, 5, 2*time.Second, targets, steps)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), targetsResults, 1)
+		require.NoError(s.T(), targetsResults["T1"])
+	}
+	require.Equal(s.T(), []string{"TargetIn 0", "TargetErr 0", "TargetIn 1", "TargetOut 1"}, s.stepAttempts(ctx, "Step 1", "T1"))
+}
@@ -0,0 +1,119 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package storage
+
+import (
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// HealthStorage defines the interface that implements persistence for the
+// outcomes and the quarantines of the targets
+type HealthStorage interface {
+	// StoreTargetOutcome records the outcome of a test step on a target.
+	StoreTargetOutcome(ctx xcontext.Context, outcome *health.Outcome) error
+
+	// GetTargetOutcomes returns up to limit of the latest outcomes of a
+	// target, newest first. Zero means no limit.
+	GetTargetOutcomes(ctx xcontext.Context, targetID string, limit uint) ([]health.Outcome, error)
+
+	// QuarantineTarget quarantines a target, replacing its previous
+	// quarantine if it was released. It returns an error wrapping
+	// health.ErrQuarantined if the target is already in quarantine.
+	QuarantineTarget(ctx xcontext.Context, quarantine *health.Quarantine) error
+
+	// ReleaseTarget releases a target from quarantine. It returns an error
+	// wrapping health.ErrNotQuarantined if the target is not in quarantine.
+	ReleaseTarget(ctx xcontext.Context, targetID string, release *health.Release) error
+
+	// GetTargetQuarantine returns the latest quarantine of a target, which
+	// may have been released. It returns an error wrapping
+	// health.ErrNotFound if the target was never quarantined.
+	GetTargetQuarantine(ctx xcontext.Context, targetID string) (*health.Quarantine, error)
+
+	// ListQuarantinedTargets returns the targets in quarantine sorted by ID.
+	ListQuarantinedTargets(ctx xcontext.Context) ([]health.Quarantine, error)
+}
+
+// HealthStorageManager implements HealthStorage interface
+type HealthStorageManager struct {
+	vault EngineVault
+}
+
+var _ health.Store = HealthStorageManager{}
+
+// StoreTargetOutcome submits the outcome of a target to the storage layer
+func (hsm HealthStorageManager) StoreTargetOutcome(ctx xcontext.Context, outcome *health.Outcome) error {
+	storage, err := hsm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.StoreTargetOutcome(ctx, outcome)
+}
+
+// GetTargetOutcomes fetches the latest outcomes of a target from the storage
+// layer. Tracking the health of the targets needs the outcomes which were
+// just stored, so they are always read from the sync engine.
+func (hsm HealthStorageManager) GetTargetOutcomes(ctx xcontext.Context, targetID string, limit uint) ([]health.Outcome, error) {
+	storage, err := hsm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.GetTargetOutcomes(ctx, targetID, limit)
+}
+
+// QuarantineTarget submits a quarantine to the storage layer
+func (hsm HealthStorageManager) QuarantineTarget(ctx xcontext.Context, quarantine *health.Quarantine) error {
+	storage, err := hsm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.QuarantineTarget(ctx, quarantine)
+}
+
+// ReleaseTarget ends the quarantine of a target in the storage layer
+func (hsm HealthStorageManager) ReleaseTarget(ctx xcontext.Context, targetID string, release *health.Release) error {
+	storage, err := hsm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.ReleaseTarget(ctx, targetID, release)
+}
+
+// GetTargetQuarantine fetches the latest quarantine of a target from the
+// storage layer
+func (hsm HealthStorageManager) GetTargetQuarantine(ctx xcontext.Context, targetID string) (*health.Quarantine, error) {
+	storage, err := hsm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.GetTargetQuarantine(ctx, targetID)
+}
+
+// ListQuarantinedTargets fetches the targets in quarantine from the storage
+// layer
+func (hsm HealthStorageManager) ListQuarantinedTargets(ctx xcontext.Context) ([]health.Quarantine, error) {
+	engineType := SyncEngine
+	if !isStronglyConsistent(ctx) {
+		engineType = AsyncEngine
+	}
+	storage, err := hsm.vault.GetEngine(engineType)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.ListQuarantinedTargets(ctx)
+}
+
+// NewHealthStorageManager creates a new HealthStorageManager object
+func NewHealthStorageManager(vault EngineVault) HealthStorageManager {
+	return HealthStorageManager{vault: vault}
+}
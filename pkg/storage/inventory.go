@@ -0,0 +1,89 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package storage
+
+import (
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// InventoryStorage defines the interface that implements persistence for the
+// target inventory
+type InventoryStorage interface {
+	// AddInventoryTarget adds a target to the inventory. It returns an error
+	// wrapping inventory.ErrAlreadyExists if its ID is already taken.
+	AddInventoryTarget(ctx xcontext.Context, target *inventory.Target) error
+
+	// UpdateInventoryTarget replaces a target of the inventory, except for its
+	// creation time. It returns an error wrapping inventory.ErrNotFound if the
+	// target is not in the inventory.
+	UpdateInventoryTarget(ctx xcontext.Context, target *inventory.Target) error
+
+	// DeleteInventoryTarget removes a target from the inventory. It returns
+	// an error wrapping inventory.ErrNotFound if the target is not in the
+	// inventory.
+	DeleteInventoryTarget(ctx xcontext.Context, targetID string) error
+
+	// ListInventoryTargets returns the targets selected by query sorted by
+	// ID.
+	ListInventoryTargets(ctx xcontext.Context, query *inventory.Query) ([]inventory.Target, error)
+}
+
+// InventoryStorageManager implements InventoryStorage interface
+type InventoryStorageManager struct {
+	vault EngineVault
+}
+
+var _ inventory.Store = InventoryStorageManager{}
+
+// AddInventoryTarget submits a new target to the storage layer
+func (ism InventoryStorageManager) AddInventoryTarget(ctx xcontext.Context, target *inventory.Target) error {
+	storage, err := ism.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.AddInventoryTarget(ctx, target)
+}
+
+// UpdateInventoryTarget replaces a target in the storage layer
+func (ism InventoryStorageManager) UpdateInventoryTarget(ctx xcontext.Context, target *inventory.Target) error {
+	storage, err := ism.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.UpdateInventoryTarget(ctx, target)
+}
+
+// DeleteInventoryTarget removes a target from the storage layer
+func (ism InventoryStorageManager) DeleteInventoryTarget(ctx xcontext.Context, targetID string) error {
+	storage, err := ism.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.DeleteInventoryTarget(ctx, targetID)
+}
+
+// ListInventoryTargets fetches targets of the inventory from the storage layer
+func (ism InventoryStorageManager) ListInventoryTargets(ctx xcontext.Context, query *inventory.Query) ([]inventory.Target, error) {
+	engineType := SyncEngine
+	if !isStronglyConsistent(ctx) {
+		engineType = AsyncEngine
+	}
+	storage, err := ism.vault.GetEngine(engineType)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.ListInventoryTargets(ctx, query)
+}
+
+// NewInventoryStorageManager creates a new InventoryStorageManager object
+func NewInventoryStorageManager(vault EngineVault) InventoryStorageManager {
+	return InventoryStorageManager{vault: vault}
+}
@@ -6,16 +6,24 @@
 package storage
 
 import (
+	"errors"
+
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
 
+// ErrJobNotFound is returned when fetching the request of a job which does
+// not exist.
+var ErrJobNotFound = errors.New("job not found")
+
 // JobStorage defines the interface that implements persistence for job
 // related information
 type JobStorage interface {
 	// Job request interface
 	StoreJobRequest(ctx xcontext.Context, request *job.Request) (types.JobID, error)
+	// GetJobRequest returns an error wrapping ErrJobNotFound if the job does
+	// not exist.
 	GetJobRequest(ctx xcontext.Context, jobID types.JobID) (*job.Request, error)
 
 	// Job report interface
@@ -23,7 +31,7 @@ type JobStorage interface {
 	GetJobReport(ctx xcontext.Context, jobID types.JobID) (*job.JobReport, error)
 
 	// Job enumeration interface
-	ListJobs(ctx xcontext.Context, query *JobQuery) ([]types.JobID, error)
+	ListJobs(ctx xcontext.Context, query *JobQuery) ([]job.Summary, error)
 }
 
 // JobStorageManager implements JobStorage interface
@@ -79,8 +87,8 @@ func (jsm JobStorageManager) GetJobReport(ctx xcontext.Context, jobID types.JobI
 	return storage.GetJobReport(ctx, jobID)
 }
 
-// ListJobs returns list of job IDs matching the query
-func (jsm JobStorageManager) ListJobs(ctx xcontext.Context, query *JobQuery) ([]types.JobID, error) {
+// ListJobs returns the summaries of the jobs matching the query
+func (jsm JobStorageManager) ListJobs(ctx xcontext.Context, query *JobQuery) ([]job.Summary, error) {
 	engineType := SyncEngine
 	if !isStronglyConsistent(ctx) {
 		engineType = AsyncEngine
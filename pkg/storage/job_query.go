@@ -8,8 +8,10 @@ package storage
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
 )
 
 type JobQueryField interface {
@@ -19,14 +21,49 @@ type JobQueryField interface {
 type JobQueryFields []JobQueryField
 
 type JobQuery struct {
-	States   []job.State
-	Tags     []string
-	ServerID string
+	States    []job.State
+	Tags      []string
+	ServerID  string
+	Requestor string
+
+	// NamePattern matches job names. '*' matches any sequence of characters
+	// and '?' matches any single character.
+	NamePattern string
+
+	// RequestedAfter and RequestedBefore bound the request time of the jobs,
+	// inclusively.
+	RequestedAfter  time.Time
+	RequestedBefore time.Time
+
+	// EndedAfter and EndedBefore bound the end time of the jobs, inclusively.
+	// Jobs which have not ended do not match either of them.
+	EndedAfter  time.Time
+	EndedBefore time.Time
+
+	// NewestFirst lists jobs by descending ID rather than ascending.
+	NewestFirst bool
+
+	// AfterJobID is a pagination cursor: only the jobs which come after it in
+	// the listing order are returned. Pass the ID of the last job of the
+	// previous page.
+	AfterJobID types.JobID
+
+	// Limit is the maximum number of jobs returned, 0 means no limit.
+	Limit uint
 }
 
 type jobQueryFieldStates []job.State
 type jobQueryFieldTags []string
 type jobQueryFieldServerID string
+type jobQueryFieldRequestor string
+type jobQueryFieldNamePattern string
+type jobQueryFieldRequestedAfter time.Time
+type jobQueryFieldRequestedBefore time.Time
+type jobQueryFieldEndedAfter time.Time
+type jobQueryFieldEndedBefore time.Time
+type jobQueryFieldNewestFirst bool
+type jobQueryFieldAfterJobID types.JobID
+type jobQueryFieldLimit uint
 
 func QueryJobStates(states ...job.State) JobQueryField { return jobQueryFieldStates(states) }
 func (value jobQueryFieldStates) queryFieldPointer(query *JobQuery) interface{} {
@@ -43,6 +80,51 @@ func (value jobQueryFieldServerID) queryFieldPointer(query *JobQuery) interface{
 	return &query.ServerID
 }
 
+func QueryJobRequestor(requestor string) JobQueryField { return jobQueryFieldRequestor(requestor) }
+func (value jobQueryFieldRequestor) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.Requestor
+}
+
+func QueryJobNamePattern(pattern string) JobQueryField { return jobQueryFieldNamePattern(pattern) }
+func (value jobQueryFieldNamePattern) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.NamePattern
+}
+
+func QueryJobRequestedAfter(t time.Time) JobQueryField { return jobQueryFieldRequestedAfter(t) }
+func (value jobQueryFieldRequestedAfter) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.RequestedAfter
+}
+
+func QueryJobRequestedBefore(t time.Time) JobQueryField { return jobQueryFieldRequestedBefore(t) }
+func (value jobQueryFieldRequestedBefore) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.RequestedBefore
+}
+
+func QueryJobEndedAfter(t time.Time) JobQueryField { return jobQueryFieldEndedAfter(t) }
+func (value jobQueryFieldEndedAfter) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.EndedAfter
+}
+
+func QueryJobEndedBefore(t time.Time) JobQueryField { return jobQueryFieldEndedBefore(t) }
+func (value jobQueryFieldEndedBefore) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.EndedBefore
+}
+
+func QueryJobsNewestFirst() JobQueryField { return jobQueryFieldNewestFirst(true) }
+func (value jobQueryFieldNewestFirst) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.NewestFirst
+}
+
+func QueryJobAfterJobID(jobID types.JobID) JobQueryField { return jobQueryFieldAfterJobID(jobID) }
+func (value jobQueryFieldAfterJobID) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.AfterJobID
+}
+
+func QueryJobLimit(limit uint) JobQueryField { return jobQueryFieldLimit(limit) }
+func (value jobQueryFieldLimit) queryFieldPointer(query *JobQuery) interface{} {
+	return &query.Limit
+}
+
 func BuildJobQuery(queryFields ...JobQueryField) (*JobQuery, error) {
 	return JobQueryFields(queryFields).BuildQuery()
 }
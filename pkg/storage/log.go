@@ -0,0 +1,67 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package storage
+
+import (
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// LogQuery selects the log entries of a job
+type LogQuery struct {
+	JobID types.JobID
+	// AfterSequenceID only selects the entries stored after the one with the
+	// given sequence ID, which allows following the log of a running job.
+	AfterSequenceID uint64
+	// Limit is the maximum number of entries returned, zero means no limit.
+	Limit uint
+}
+
+// LogStorage defines the interface that implements persistence for the log
+// entries captured while running jobs
+type LogStorage interface {
+	// StoreJobLogs stores log entries, their sequence IDs are assigned by
+	// the storage layer.
+	StoreJobLogs(ctx xcontext.Context, entries []job.LogEntry) error
+
+	// GetJobLogs returns the log entries of a job sorted by sequence ID.
+	GetJobLogs(ctx xcontext.Context, query *LogQuery) ([]job.LogEntry, error)
+}
+
+// LogStorageManager implements LogStorage interface
+type LogStorageManager struct {
+	vault EngineVault
+}
+
+// StoreJobLogs submits log entries to the storage layer
+func (lsm LogStorageManager) StoreJobLogs(ctx xcontext.Context, entries []job.LogEntry) error {
+	storage, err := lsm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.StoreJobLogs(ctx, entries)
+}
+
+// GetJobLogs fetches the log entries of a job from the storage layer
+func (lsm LogStorageManager) GetJobLogs(ctx xcontext.Context, query *LogQuery) ([]job.LogEntry, error) {
+	engineType := SyncEngine
+	if !isStronglyConsistent(ctx) {
+		engineType = AsyncEngine
+	}
+	storage, err := lsm.vault.GetEngine(engineType)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.GetJobLogs(ctx, query)
+}
+
+// NewLogStorageManager creates a new LogStorageManager object
+func NewLogStorageManager(vault EngineVault) LogStorageManager {
+	return LogStorageManager{vault: vault}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// ScheduleStorage defines the interface that implements persistence for job
+// schedules
+type ScheduleStorage interface {
+	StoreSchedule(ctx xcontext.Context, schedule *job.Schedule) (types.ScheduleID, error)
+	GetSchedule(ctx xcontext.Context, scheduleID types.ScheduleID) (*job.Schedule, error)
+	DeleteSchedule(ctx xcontext.Context, scheduleID types.ScheduleID) error
+
+	// ListSchedules returns the schedules owned by the given server, or all
+	// of them if serverID is empty.
+	ListSchedules(ctx xcontext.Context, serverID string) ([]*job.Schedule, error)
+
+	// UpdateScheduleLastRun records the job submitted by the last firing of a
+	// schedule.
+	UpdateScheduleLastRun(ctx xcontext.Context, scheduleID types.ScheduleID, jobID types.JobID, runTime time.Time) error
+}
+
+// ScheduleStorageManager implements ScheduleStorage interface
+type ScheduleStorageManager struct {
+	vault EngineVault
+}
+
+// StoreSchedule submits a new schedule to the storage layer
+func (ssm ScheduleStorageManager) StoreSchedule(ctx xcontext.Context, schedule *job.Schedule) (types.ScheduleID, error) {
+	storage, err := ssm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return 0, err
+	}
+
+	return storage.StoreSchedule(ctx, schedule)
+}
+
+// GetSchedule fetches a schedule from the storage layer
+func (ssm ScheduleStorageManager) GetSchedule(ctx xcontext.Context, scheduleID types.ScheduleID) (*job.Schedule, error) {
+	engineType := SyncEngine
+	if !isStronglyConsistent(ctx) {
+		engineType = AsyncEngine
+	}
+	storage, err := ssm.vault.GetEngine(engineType)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.GetSchedule(ctx, scheduleID)
+}
+
+// DeleteSchedule removes a schedule from the storage layer
+func (ssm ScheduleStorageManager) DeleteSchedule(ctx xcontext.Context, scheduleID types.ScheduleID) error {
+	storage, err := ssm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.DeleteSchedule(ctx, scheduleID)
+}
+
+// ListSchedules returns the schedules owned by a server
+func (ssm ScheduleStorageManager) ListSchedules(ctx xcontext.Context, serverID string) ([]*job.Schedule, error) {
+	engineType := SyncEngine
+	if !isStronglyConsistent(ctx) {
+		engineType = AsyncEngine
+	}
+	storage, err := ssm.vault.GetEngine(engineType)
+	if err != nil {
+		return nil, err
+	}
+
+	return storage.ListSchedules(ctx, serverID)
+}
+
+// UpdateScheduleLastRun records the last job submitted by a schedule
+func (ssm ScheduleStorageManager) UpdateScheduleLastRun(ctx xcontext.Context, scheduleID types.ScheduleID, jobID types.JobID, runTime time.Time) error {
+	storage, err := ssm.vault.GetEngine(SyncEngine)
+	if err != nil {
+		return err
+	}
+
+	return storage.UpdateScheduleLastRun(ctx, scheduleID, jobID, runTime)
+}
+
+// NewScheduleStorageManager creates a new ScheduleStorageManager object
+func NewScheduleStorageManager(vault EngineVault) ScheduleStorageManager {
+	return ScheduleStorageManager{vault: vault}
+}
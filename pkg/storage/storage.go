@@ -24,6 +24,10 @@ const consistencyModelKey = "storage_consistency_model"
 type Storage interface {
 	JobStorage
 	EventStorage
+	ScheduleStorage
+	LogStorage
+	InventoryStorage
+	HealthStorage
 
 	// Close flushes and releases resources associated with the storage engine.
 	Close() error
@@ -7,9 +7,12 @@ package storage
 
 import (
 	"testing"
+	"time"
 
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
 	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
@@ -46,7 +49,7 @@ func (n *nullStorage) GetJobReport(ctx xcontext.Context, jobID types.JobID) (*jo
 	n.jobRequestCount++
 	return nil, nil
 }
-func (n *nullStorage) ListJobs(ctx xcontext.Context, query *JobQuery) ([]types.JobID, error) {
+func (n *nullStorage) ListJobs(ctx xcontext.Context, query *JobQuery) ([]job.Summary, error) {
 	n.jobRequestCount++
 	return nil, nil
 }
@@ -76,6 +79,82 @@ func (n *nullStorage) GetFrameworkEvent(ctx xcontext.Context, eventQuery *framew
 	return nil, nil
 }
 
+// schedules interface
+func (n *nullStorage) StoreSchedule(ctx xcontext.Context, schedule *job.Schedule) (types.ScheduleID, error) {
+	n.jobRequestCount++
+	return types.ScheduleID(0), nil
+}
+func (n *nullStorage) GetSchedule(ctx xcontext.Context, scheduleID types.ScheduleID) (*job.Schedule, error) {
+	n.jobRequestCount++
+	return nil, nil
+}
+func (n *nullStorage) DeleteSchedule(ctx xcontext.Context, scheduleID types.ScheduleID) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) ListSchedules(ctx xcontext.Context, serverID string) ([]*job.Schedule, error) {
+	n.jobRequestCount++
+	return nil, nil
+}
+func (n *nullStorage) UpdateScheduleLastRun(ctx xcontext.Context, scheduleID types.ScheduleID, jobID types.JobID, runTime time.Time) error {
+	n.jobRequestCount++
+	return nil
+}
+
+// logs interface
+func (n *nullStorage) StoreJobLogs(ctx xcontext.Context, entries []job.LogEntry) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) GetJobLogs(ctx xcontext.Context, query *LogQuery) ([]job.LogEntry, error) {
+	n.jobRequestCount++
+	return nil, nil
+}
+
+// inventory interface
+func (n *nullStorage) AddInventoryTarget(ctx xcontext.Context, target *inventory.Target) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) UpdateInventoryTarget(ctx xcontext.Context, target *inventory.Target) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) DeleteInventoryTarget(ctx xcontext.Context, targetID string) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) ListInventoryTargets(ctx xcontext.Context, query *inventory.Query) ([]inventory.Target, error) {
+	n.jobRequestCount++
+	return nil, nil
+}
+
+// health interface
+func (n *nullStorage) StoreTargetOutcome(ctx xcontext.Context, outcome *health.Outcome) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) GetTargetOutcomes(ctx xcontext.Context, targetID string, limit uint) ([]health.Outcome, error) {
+	n.jobRequestCount++
+	return nil, nil
+}
+func (n *nullStorage) QuarantineTarget(ctx xcontext.Context, quarantine *health.Quarantine) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) ReleaseTarget(ctx xcontext.Context, targetID string, release *health.Release) error {
+	n.jobRequestCount++
+	return nil
+}
+func (n *nullStorage) GetTargetQuarantine(ctx xcontext.Context, targetID string) (*health.Quarantine, error) {
+	n.jobRequestCount++
+	return nil, nil
+}
+func (n *nullStorage) ListQuarantinedTargets(ctx xcontext.Context) ([]health.Quarantine, error) {
+	n.jobRequestCount++
+	return nil, nil
+}
+
 func (n *nullStorage) Close() error {
 	return nil
 }
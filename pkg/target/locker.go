@@ -6,8 +6,10 @@
 package target
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
@@ -62,6 +64,139 @@ type Locker interface {
 	Close() error
 }
 
+// WaitLocker is implemented by the Lockers which can make the jobs wait for
+// targets to become available instead of failing to acquire them, see
+// WaitLock.
+type WaitLocker interface {
+	Locker
+
+	// WaitLock locks up to limit of the given targets like TryLock, but
+	// waits until at least min of them can be locked.
+	// The waiting jobs are served in order of priority, higher first, then
+	// of request time: the targets a job waits for are not handed out to
+	// the jobs queued after it.
+	// It returns an error if ctx is done before enough targets are available.
+	WaitLock(ctx xcontext.Context, jobID types.JobID, priority int, duration time.Duration, targets []*Target, min, limit uint) ([]string, error)
+
+	// Waiters returns the jobs waiting for targets, in the order they are
+	// served.
+	Waiters() []Waiter
+}
+
+// Waiter is a job waiting in the queue of a WaitLocker.
+type Waiter struct {
+	JobID    types.JobID
+	Priority int
+	// Since is when the job started waiting.
+	Since time.Time
+	// MinTargets is the number of targets the job waits for.
+	MinTargets uint
+	// Position is the 1-based position of the job in the queue.
+	Position int
+}
+
+// LockLister is implemented by the Lockers which can list the locks they
+// hold, e.g. to find out who holds a target or to unlock the targets of a
+// crashed server.
+type LockLister interface {
+	Locker
+
+	// ListLocks returns the locks on the given target IDs, or all the locks
+	// if none is given, sorted by target ID. Expired locks are included until
+	// they are taken over or unlocked.
+	ListLocks(ctx xcontext.Context, targetIDs []string) ([]Lock, error)
+}
+
+// Lock is a lock held by a job on a target.
+type Lock struct {
+	TargetID  string
+	JobID     types.JobID
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// EventTargetForceUnlocked is emitted, as a framework event of the job which
+// held the lock, when an admin forcibly unlocks a target.
+var EventTargetForceUnlocked = event.Name("TargetForceUnlocked")
+
+// ForceUnlockPayload is the payload of EventTargetForceUnlocked.
+type ForceUnlockPayload struct {
+	Lock      Lock
+	Requestor string
+	Reason    string
+}
+
+// WaitLock locks between min and limit of the given targets. If tl is a
+// WaitLocker, it waits for them in its queue with the priority carried by
+// ctx, see WithPriority. Other lockers only try once to lock them.
+func WaitLock(ctx xcontext.Context, tl Locker, jobID types.JobID, duration time.Duration, targets []*Target, min, limit uint) ([]string, error) {
+	if wl, ok := tl.(WaitLocker); ok {
+		return wl.WaitLock(ctx, jobID, PriorityFromContext(ctx), duration, targets, min, limit)
+	}
+	locked, err := tl.TryLock(ctx, jobID, duration, targets, limit)
+	if err != nil {
+		return nil, err
+	}
+	if uint(len(locked)) >= min {
+		return locked, nil
+	}
+	lockedTargets, err := FilterTargets(locked, targets)
+	if err != nil {
+		return nil, err
+	}
+	if err := tl.Unlock(ctx, jobID, lockedTargets); err != nil {
+		return nil, fmt.Errorf("cannot unlock targets: %w", err)
+	}
+	return nil, fmt.Errorf("can't lock enough targets, want %d, got %d", min, len(locked))
+}
+
+type key string
+
+const keyPriority = key("acquire_priority")
+
+// WithPriority returns a context carrying the priority of the job which
+// acquires targets, WaitLock queues the job with it.
+func WithPriority(ctx xcontext.Context, priority int) xcontext.Context {
+	return xcontext.WithValue(ctx, keyPriority, priority)
+}
+
+// PriorityFromContext returns the priority of the job which acquires
+// targets, zero if ctx does not carry one.
+func PriorityFromContext(ctx xcontext.Context) int {
+	priority, _ := ctx.Value(keyPriority).(int)
+	return priority
+}
+
+const keyTargetIDs = key("acquire_target_ids")
+
+// WithTargetIDs returns a context restricting the targets to acquire to the
+// given IDs, e.g. when only the targets which failed are retried. Target
+// managers which pick targets from a pool only consider these ones, see
+// SelectTargets, the JobRunner hands back any other target they return.
+func WithTargetIDs(ctx xcontext.Context, targetIDs []string) xcontext.Context {
+	return xcontext.WithValue(ctx, keyTargetIDs, targetIDs)
+}
+
+// SelectTargets returns, in order, the targets whose ID is carried by ctx,
+// see WithTargetIDs. All the targets are returned if ctx does not carry any
+// ID, and ok is false.
+func SelectTargets(ctx xcontext.Context, targets []*Target) (selected []*Target, ok bool) {
+	targetIDs, _ := ctx.Value(keyTargetIDs).([]string)
+	if len(targetIDs) == 0 {
+		return targets, false
+	}
+	wanted := make(map[string]bool, len(targetIDs))
+	for _, id := range targetIDs {
+		wanted[id] = true
+	}
+	for _, t := range targets {
+		if wanted[t.ID] {
+			selected = append(selected, t)
+		}
+	}
+	return selected, true
+}
+
 // SetLocker sets the desired lock engine for targets.
 func SetLocker(newLocker Locker) {
 	if locker != nil {
@@ -7,6 +7,7 @@ package target
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -38,6 +39,33 @@ var EventTargetReleased = event.Name("TargetReleased")
 // ErrPayload represents the payload associated with a TargetErr or AcquireErr events
 type ErrPayload struct {
 	Error string
+	// Infrastructure is set when the target failed because of an
+	// ErrInfrastructure rather than because of the test itself.
+	Infrastructure bool `json:",omitempty"`
+}
+
+// ErrInfrastructure indicates that a target failed a test step because the
+// infrastructure around it failed, e.g. the target could not be reached,
+// rather than because the test itself failed. Test steps wrap such errors
+// so that targets which keep failing this way can be quarantined.
+type ErrInfrastructure struct {
+	Err error
+}
+
+// Error returns the error string associated with the error
+func (e *ErrInfrastructure) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *ErrInfrastructure) Unwrap() error {
+	return e.Err
+}
+
+// IsInfrastructureError returns whether err wraps an ErrInfrastructure.
+func IsInfrastructureError(err error) bool {
+	var infraErr *ErrInfrastructure
+	return errors.As(err, &infraErr)
 }
 
 // MarshallErrPayload prepares error message as ErrPayload structure for event data payload
@@ -73,6 +101,13 @@ type Target struct {
 	FQDN        string `json:"FQDN,omitempty"`
 	PrimaryIPv4 net.IP `json:"PrimaryIPv4,omitempty"`
 	PrimaryIPv6 net.IP `json:"PrimaryIPv6,omitempty"`
+	// Labels describe the target, e.g. its board or its pool, and Attributes
+	// hold what the test steps need to reach it, e.g. the host of its BMC or
+	// its UART device. They are set by the target managers which know about
+	// them, and are available to the templates of the test step parameters
+	// through the Label and Attr methods.
+	Labels     map[string]string `json:"Labels,omitempty"`
+	Attributes map[string]string `json:"Attributes,omitempty"`
 	// This field is reserved for TargetManager to associate any state needed to keep track of the target between Acquire and Release.
 	// It will be serialized between server restarts. Please keep it small.
 	TargetManagerState json.RawMessage `json:"TMS,omitempty"`
@@ -102,6 +137,28 @@ func (t *Target) String() string {
 	return res.String()
 }
 
+// Label returns the value of a label of the target, it fails if the target
+// does not have the label. It is meant for templates, e.g.
+// {{ .Label "board" }}.
+func (t *Target) Label(key string) (string, error) {
+	value, ok := t.Labels[key]
+	if !ok {
+		return "", fmt.Errorf("target %q has no label %q", t.ID, key)
+	}
+	return value, nil
+}
+
+// Attr returns the value of an attribute of the target, it fails if the
+// target does not have the attribute. It is meant for templates, e.g.
+// {{ .Attr "bmc_host" }}.
+func (t *Target) Attr(key string) (string, error) {
+	value, ok := t.Attributes[key]
+	if !ok {
+		return "", fmt.Errorf("target %q has no attribute %q", t.ID, key)
+	}
+	return value, nil
+}
+
 // FilterTargets - Filter targets from targets based on targetIDs
 func FilterTargets(targetIDs []string, targets []*Target) ([]*Target, error) {
 
@@ -7,10 +7,14 @@ package target
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/xcontext"
 )
 
 func TestNilTarget(t *testing.T) {
@@ -54,6 +58,43 @@ func TestTargetStringification(t *testing.T) {
 	require.Equal(t, `{"ID":"123","TMS":{"hello":"world"}}`, string(tj5))
 }
 
+func TestTargetLabelsAndAttributes(t *testing.T) {
+	t1 := &Target{
+		ID:         "123",
+		Labels:     map[string]string{"board": "tioga", "bmc": ""},
+		Attributes: map[string]string{"bmc_host": "123-bmc.example.com"},
+	}
+	tj1, err := json.Marshal(t1)
+	require.NoError(t, err)
+	require.Equal(t, `{"ID":"123","Labels":{"bmc":"","board":"tioga"},"Attributes":{"bmc_host":"123-bmc.example.com"}}`, string(tj1))
+	var t2 Target
+	require.NoError(t, json.Unmarshal(tj1, &t2))
+	require.Equal(t, t1, &t2)
+
+	v, err := t2.Label("board")
+	require.NoError(t, err)
+	require.Equal(t, "tioga", v)
+	v, err = t2.Label("bmc")
+	require.NoError(t, err)
+	require.Equal(t, "", v)
+	_, err = t2.Label("pool")
+	require.Error(t, err)
+
+	v, err = t2.Attr("bmc_host")
+	require.NoError(t, err)
+	require.Equal(t, "123-bmc.example.com", v)
+	_, err = t2.Attr("uart")
+	require.Error(t, err)
+}
+
+func TestInfrastructureError(t *testing.T) {
+	err := fmt.Errorf("failed to create proc: %w", &ErrInfrastructure{Err: errors.New("cannot connect to SSH server")})
+	require.True(t, IsInfrastructureError(err))
+	require.Equal(t, "failed to create proc: cannot connect to SSH server", err.Error())
+	require.False(t, IsInfrastructureError(errors.New("exit status 1")))
+	require.False(t, IsInfrastructureError(nil))
+}
+
 func TestErrPayloadMarshalling(t *testing.T) {
 	t.Run("Nil", func(t *testing.T) {
 		res, err := UnmarshalErrPayload(nil)
@@ -75,3 +116,16 @@ func TestErrPayloadMarshalling(t *testing.T) {
 		require.Equal(t, ErrPayload{Error: "dummy"}, *res)
 	})
 }
+
+func TestSelectTargets(t *testing.T) {
+	ctx := xcontext.Background()
+	targets := []*Target{{ID: "t1"}, {ID: "t2"}, {ID: "t3"}}
+
+	selected, ok := SelectTargets(ctx, targets)
+	require.False(t, ok)
+	require.Equal(t, targets, selected)
+
+	selected, ok = SelectTargets(WithTargetIDs(ctx, []string{"t3", "t1", "t4"}), targets)
+	require.True(t, ok)
+	require.Equal(t, []*Target{targets[0], targets[2]}, selected)
+}
@@ -0,0 +1,237 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package target
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// DefaultWaitInterval is how often a WaitQueue checks again the targets the
+// jobs wait for, in case their locks expired or were released by another
+// ConTest server.
+const DefaultWaitInterval = 5 * time.Second
+
+// AvailableFunc returns the targets, out of the given ones, that jobID could
+// lock right now.
+type AvailableFunc func(ctx xcontext.Context, jobID types.JobID, targets []*Target) ([]*Target, error)
+
+// WaitQueue implements the queue of WaitLocker on top of the TryLock of a
+// Locker. Lockers wake the queue up with Notify when they unlock targets.
+type WaitQueue struct {
+	tl        Locker
+	available AvailableFunc
+	clock     clock.Clock
+	interval  time.Duration
+
+	mu      sync.Mutex
+	waiters []*waiter
+	seq     uint64
+
+	notifyMu sync.Mutex
+	notifyCh chan struct{}
+}
+
+type waiter struct {
+	Waiter
+	ctx      xcontext.Context
+	seq      uint64
+	duration time.Duration
+	targets  []*Target
+	limit    uint
+	// locked and err are the result of WaitLock, set before done is closed.
+	locked []string
+	err    error
+	done   chan struct{}
+}
+
+// before tells whether w has to be served before other.
+func (w *waiter) before(other *waiter) bool {
+	if w.Priority != other.Priority {
+		return w.Priority > other.Priority
+	}
+	return w.seq < other.seq
+}
+
+// NewWaitQueue returns a WaitQueue locking the targets through tl, and
+// checking their availability through available every interval.
+func NewWaitQueue(tl Locker, available AvailableFunc, clk clock.Clock, interval time.Duration) *WaitQueue {
+	return &WaitQueue{
+		tl:        tl,
+		available: available,
+		clock:     clk,
+		interval:  interval,
+		notifyCh:  make(chan struct{}),
+	}
+}
+
+// WaitLock implements WaitLocker.WaitLock.
+func (q *WaitQueue) WaitLock(ctx xcontext.Context, jobID types.JobID, priority int, duration time.Duration, targets []*Target, min, limit uint) ([]string, error) {
+	if jobID == 0 {
+		return nil, fmt.Errorf("invalid waitLock request, jobID cannot be zero (targets: %v)", targets)
+	}
+	if min > limit {
+		return nil, fmt.Errorf("invalid waitLock request, cannot wait for %d targets with a limit of %d", min, limit)
+	}
+	if min > uint(len(targets)) {
+		return nil, fmt.Errorf("invalid waitLock request, cannot wait for %d targets out of %d", min, len(targets))
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	q.mu.Lock()
+	q.seq++
+	w := &waiter{
+		Waiter: Waiter{
+			JobID:      jobID,
+			Priority:   priority,
+			Since:      q.clock.Now(),
+			MinTargets: min,
+		},
+		ctx:      ctx,
+		seq:      q.seq,
+		duration: duration,
+		targets:  targets,
+		limit:    limit,
+		done:     make(chan struct{}),
+	}
+	q.waiters = append(q.waiters, w)
+	sort.SliceStable(q.waiters, func(i, j int) bool {
+		return q.waiters[i].before(q.waiters[j])
+	})
+	q.mu.Unlock()
+	ctx.Infof("Waiting for %d targets with priority %d", min, priority)
+
+	for {
+		// Take the notification channel before serving, so that the
+		// targets unlocked while serving are not missed.
+		notifyCh := q.notifyChan()
+		q.serve()
+		select {
+		case <-w.done:
+			ctx.Debugf("WaitLock %d targets for %s: %d %v", len(targets), duration, len(w.locked), w.err)
+			return w.locked, w.err
+		case <-notifyCh:
+		case <-q.clock.After(q.interval):
+		case <-ctx.Done():
+			if q.remove(w) {
+				return nil, fmt.Errorf("gave up waiting for %d targets: %w", min, ctx.Err())
+			}
+			// The job was served in the meantime.
+			<-w.done
+			return w.locked, w.err
+		}
+	}
+}
+
+// Waiters implements WaitLocker.Waiters.
+func (q *WaitQueue) Waiters() []Waiter {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	res := make([]Waiter, 0, len(q.waiters))
+	for idx, w := range q.waiters {
+		waiter := w.Waiter
+		waiter.Position = idx + 1
+		res = append(res, waiter)
+	}
+	return res
+}
+
+// Notify wakes the waiting jobs up, so that they check again the targets
+// they wait for.
+func (q *WaitQueue) Notify() {
+	q.notifyMu.Lock()
+	defer q.notifyMu.Unlock()
+	close(q.notifyCh)
+	q.notifyCh = make(chan struct{})
+}
+
+func (q *WaitQueue) notifyChan() <-chan struct{} {
+	q.notifyMu.Lock()
+	defer q.notifyMu.Unlock()
+	return q.notifyCh
+}
+
+// remove removes w from the queue, it returns false if w was served already.
+func (q *WaitQueue) remove(w *waiter) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for idx, other := range q.waiters {
+		if other == w {
+			q.waiters = append(q.waiters[:idx], q.waiters[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// serve goes through the queue in order and locks the targets of the jobs
+// which can have enough of them.
+func (q *WaitQueue) serve() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	// reserved holds the available targets of the jobs which are still
+	// waiting, the jobs queued after them cannot take them.
+	reserved := make(map[string]bool)
+	waiting := make([]*waiter, 0, len(q.waiters))
+	for _, w := range q.waiters {
+		if q.serveWaiter(w, reserved) {
+			close(w.done)
+			continue
+		}
+		waiting = append(waiting, w)
+	}
+	q.waiters = waiting
+}
+
+// serveWaiter locks the targets of w if enough of them are available,
+// otherwise it reserves the available ones. It returns whether w is done.
+func (q *WaitQueue) serveWaiter(w *waiter, reserved map[string]bool) bool {
+	candidates := make([]*Target, 0, len(w.targets))
+	for _, t := range w.targets {
+		if !reserved[t.ID] {
+			candidates = append(candidates, t)
+		}
+	}
+	available, err := q.available(w.ctx, w.JobID, candidates)
+	if err != nil {
+		w.err = fmt.Errorf("cannot check available targets: %w", err)
+		return true
+	}
+	if uint(len(available)) >= w.MinTargets {
+		locked, err := q.tl.TryLock(w.ctx, w.JobID, w.duration, available, w.limit)
+		if err != nil {
+			w.err = fmt.Errorf("failed to lock targets: %w", err)
+			return true
+		}
+		if uint(len(locked)) >= w.MinTargets {
+			w.locked = locked
+			return true
+		}
+		// Some targets were locked in the meantime by a job which does not
+		// wait, give the other ones back and keep waiting.
+		lockedTargets, err := FilterTargets(locked, available)
+		if err == nil {
+			err = q.tl.Unlock(w.ctx, w.JobID, lockedTargets)
+		}
+		if err != nil {
+			w.err = fmt.Errorf("cannot unlock targets: %w", err)
+			return true
+		}
+	}
+	for _, t := range available {
+		reserved[t.ID] = true
+	}
+	return false
+}
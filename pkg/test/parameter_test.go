@@ -30,6 +30,30 @@ func TestParameterExpand(t *testing.T) {
 	}
 }
 
+func TestParameterExpandAttributes(t *testing.T) {
+	tgt := &target.Target{
+		ID:         "1234",
+		Labels:     map[string]string{"board": "tioga"},
+		Attributes: map[string]string{"bmc_host": "1234-bmc.example.com"},
+	}
+	res, err := NewParam(`{{ .Label "board" }} {{ .Attr "bmc_host" }}`).Expand(tgt)
+	require.NoError(t, err)
+	require.Equal(t, "tioga 1234-bmc.example.com", res)
+
+	_, err = NewParam(`{{ .Attr "uart" }}`).Expand(tgt)
+	require.Error(t, err)
+
+	type config struct {
+		Host string
+		Port int
+		Args []string
+	}
+	var out config
+	pe := NewParamExpander(tgt)
+	require.NoError(t, pe.ExpandObject(config{Host: `{{ .Attr "bmc_host" }}`, Port: 22, Args: []string{`{{ .ID }}`}}, &out))
+	require.Equal(t, config{Host: "1234-bmc.example.com", Port: 22, Args: []string{"1234"}}, out)
+}
+
 //nolint:staticcheck
 func TestParameterExpandUserFunctions(t *testing.T) {
 	require.Error(t, UnregisterFunction("NoSuchFunction"))
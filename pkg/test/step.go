@@ -9,8 +9,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 
+	"github.com/insomniacslk/xjson"
+
 	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/event/testevent"
 	"github.com/linuxboot/contest/pkg/target"
@@ -81,6 +84,93 @@ type TestStepDescriptor struct {
 	Name       string
 	Label      string
 	Parameters TestStepParameters
+	// DependsOn lists the labels of the steps that a target has to complete
+	// before entering this one, see StepDependencies.
+	DependsOn []string `json:"depends_on,omitempty"`
+	// RunIf determines which targets enter the step, see StepRunCondition.
+	RunIf StepRunCondition `json:"run_if,omitempty"`
+	// Retry optionally lets the targets which fail the step try it again,
+	// see StepRetryParameters.
+	Retry *StepRetryParameters `json:"retry,omitempty"`
+}
+
+// StepRetryParameters describes optional parameters for retrying a step.
+// Unlike RetryParameters, which run the whole test again, only the targets
+// which failed are injected into the same step again. Each attempt is
+// recorded in the TestStepAttempt field of the event headers.
+type StepRetryParameters struct {
+	NumRetries    uint32
+	RetryInterval xjson.Duration
+	// RetryOn, if set, is a regular expression which the error of a target
+	// has to match for the target to be retried. All errors are retried
+	// otherwise.
+	RetryOn string `json:",omitempty"`
+
+	// retryOn is RetryOn compiled by Validate.
+	retryOn *regexp.Regexp
+}
+
+// Validate checks that the retry parameters are well-formed, and compiles
+// RetryOn for ShouldRetry.
+func (p *StepRetryParameters) Validate() error {
+	if p.RetryOn == "" {
+		p.retryOn = nil
+		return nil
+	}
+	re, err := regexp.Compile(p.RetryOn)
+	if err != nil {
+		return fmt.Errorf("invalid RetryOn expression: %w", err)
+	}
+	p.retryOn = re
+	return nil
+}
+
+// ShouldRetry returns whether a target which failed the given attempt of a
+// step with err is tried again. Attempts are numbered from 0. Validate must
+// have been called if RetryOn is set, errors are never retried otherwise.
+func (p StepRetryParameters) ShouldRetry(attempt uint32, err error) bool {
+	if err == nil || attempt >= p.NumRetries {
+		return false
+	}
+	if p.RetryOn == "" {
+		return true
+	}
+	return p.retryOn != nil && p.retryOn.MatchString(err.Error())
+}
+
+// StepRunCondition determines which targets enter a step, depending on whether
+// they have failed a step by the time the dependencies of the step complete.
+type StepRunCondition string
+
+// Supported step run conditions
+const (
+	// RunOnSuccess only lets in the targets which have not failed any step.
+	// This is the default.
+	RunOnSuccess StepRunCondition = "on_success"
+	// RunOnFailure only lets in the targets which have failed a step.
+	RunOnFailure StepRunCondition = "on_failure"
+	// RunAlways lets in all the targets, e.g. for cleanup steps.
+	RunAlways StepRunCondition = "always"
+)
+
+// Validate checks that the run condition is one of the supported ones.
+func (c StepRunCondition) Validate() error {
+	switch c {
+	case "", RunOnSuccess, RunOnFailure, RunAlways:
+		return nil
+	}
+	return fmt.Errorf("unknown run condition '%s', must be one of %s, %s or %s", c, RunOnSuccess, RunOnFailure, RunAlways)
+}
+
+// ShouldRun returns whether a target enters a step with this run condition.
+func (c StepRunCondition) ShouldRun(failed bool) bool {
+	switch c {
+	case RunAlways:
+		return true
+	case RunOnFailure:
+		return failed
+	}
+	return !failed
 }
 
 // TestStepBundle bundles the selected TestStep together with its parameters as
@@ -90,11 +180,15 @@ type TestStepBundle struct {
 	TestStepLabel string
 	Parameters    TestStepParameters
 	AllowedEvents map[event.Name]bool
+	DependsOn     []string
+	RunIf         StepRunCondition
+	Retry         StepRetryParameters
 }
 
 // TestStepResult is used by TestSteps to report result for a particular target.
 // Empty Err means success, non-empty indicates failure.
-// Failed targets do not proceed to further steps in this run.
+// Failed targets only proceed to the steps which run on failure in this run,
+// see StepRunCondition.
 type TestStepResult struct {
 	Target *target.Target
 	Err    error
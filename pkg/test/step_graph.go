@@ -0,0 +1,104 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package test
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StepDependencies resolves the dependencies between the steps of a test into
+// indices of bundles: the i-th element of the result lists the steps that a
+// target has to complete before entering step i.
+//
+// If none of the steps declares DependsOn, the steps form a linear pipeline in
+// the order they are listed in. Otherwise the steps form a graph: the steps
+// without dependencies are entered first, and targets fan out to and fan in
+// from the other steps as their dependencies are completed.
+//
+// An error is returned if a dependency refers to an unknown label or if the
+// dependencies form a cycle.
+func StepDependencies(bundles []TestStepBundle) ([][]int, error) {
+	deps := make([][]int, len(bundles))
+
+	isGraph := false
+	for _, sb := range bundles {
+		if len(sb.DependsOn) > 0 {
+			isGraph = true
+			break
+		}
+	}
+	if !isGraph {
+		for i := 1; i < len(bundles); i++ {
+			deps[i] = []int{i - 1}
+		}
+		return deps, nil
+	}
+
+	indices := make(map[string]int, len(bundles))
+	for i, sb := range bundles {
+		indices[sb.TestStepLabel] = i
+	}
+	for i, sb := range bundles {
+		seen := make(map[int]bool)
+		for _, label := range sb.DependsOn {
+			dep, ok := indices[label]
+			if !ok {
+				return nil, fmt.Errorf("step '%s' depends on unknown step '%s'", sb.TestStepLabel, label)
+			}
+			if dep == i {
+				return nil, fmt.Errorf("step '%s' depends on itself", sb.TestStepLabel)
+			}
+			if !seen[dep] {
+				seen[dep] = true
+				deps[i] = append(deps[i], dep)
+			}
+		}
+	}
+
+	// Depth-first search, a step found on the current path closes a cycle.
+	const (
+		unvisited = iota
+		onPath
+		visited
+	)
+	state := make([]int, len(bundles))
+	var path []int
+	var visit func(i int) error
+	visit = func(i int) error {
+		state[i] = onPath
+		path = append(path, i)
+		for _, dep := range deps[i] {
+			switch state[dep] {
+			case unvisited:
+				if err := visit(dep); err != nil {
+					return err
+				}
+			case onPath:
+				start := len(path) - 1
+				for path[start] != dep {
+					start--
+				}
+				var labels []string
+				for _, j := range append(path[start:], dep) {
+					labels = append(labels, bundles[j].TestStepLabel)
+				}
+				return fmt.Errorf("step dependencies form a cycle: %s", strings.Join(labels, " -> "))
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = visited
+		return nil
+	}
+	for i := range bundles {
+		if state[i] == unvisited {
+			if err := visit(i); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return deps, nil
+}
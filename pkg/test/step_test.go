@@ -7,6 +7,7 @@ package test
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,85 @@ func TestTestStepParametersUnmarshalNested(t *testing.T) {
 	require.Equal(t, "bar", substruct.Val2)
 	require.Equal(t, "baz", substruct.More_nesting["foobar"])
 }
+
+func stepGraph(deps map[string][]string, labels ...string) []TestStepBundle {
+	var bundles []TestStepBundle
+	for _, label := range labels {
+		bundles = append(bundles, TestStepBundle{TestStepLabel: label, DependsOn: deps[label]})
+	}
+	return bundles
+}
+
+func TestStepDependenciesLinear(t *testing.T) {
+	deps, err := StepDependencies(stepGraph(nil, "a", "b", "c"))
+	require.NoError(t, err)
+	require.Equal(t, [][]int{nil, {0}, {1}}, deps)
+}
+
+func TestStepDependenciesGraph(t *testing.T) {
+	// flash, then chipsec and fwts in parallel, then power off.
+	deps, err := StepDependencies(stepGraph(map[string][]string{
+		"chipsec":  {"flash"},
+		"fwts":     {"flash", "flash"},
+		"poweroff": {"chipsec", "fwts"},
+	}, "poweroff", "flash", "chipsec", "fwts"))
+	require.NoError(t, err)
+	require.Equal(t, [][]int{{2, 3}, nil, {1}, {1}}, deps)
+}
+
+func TestStepDependenciesErrors(t *testing.T) {
+	_, err := StepDependencies(stepGraph(map[string][]string{"b": {"x"}}, "a", "b"))
+	require.EqualError(t, err, "step 'b' depends on unknown step 'x'")
+
+	_, err = StepDependencies(stepGraph(map[string][]string{"b": {"b"}}, "a", "b"))
+	require.EqualError(t, err, "step 'b' depends on itself")
+
+	_, err = StepDependencies(stepGraph(map[string][]string{
+		"a": {"c"},
+		"b": {"a"},
+		"c": {"b"},
+		"d": {"a"},
+	}, "d", "a", "b", "c"))
+	require.EqualError(t, err, "step dependencies form a cycle: a -> c -> b -> a")
+}
+
+func TestStepRunCondition(t *testing.T) {
+	for _, tc := range []struct {
+		cond              StepRunCondition
+		onSuccess, onFail bool
+	}{
+		{"", true, false},
+		{RunOnSuccess, true, false},
+		{RunOnFailure, false, true},
+		{RunAlways, true, true},
+	} {
+		require.NoError(t, tc.cond.Validate())
+		require.Equal(t, tc.onSuccess, tc.cond.ShouldRun(false), tc.cond)
+		require.Equal(t, tc.onFail, tc.cond.ShouldRun(true), tc.cond)
+	}
+	require.Error(t, StepRunCondition("sometimes").Validate())
+}
+
+func TestStepRetryParameters(t *testing.T) {
+	timeout := errors.New("ping timed out")
+	other := errors.New("wrong firmware version")
+
+	all := StepRetryParameters{NumRetries: 2}
+	require.NoError(t, all.Validate())
+	require.True(t, all.ShouldRetry(0, timeout))
+	require.True(t, all.ShouldRetry(1, other))
+	require.False(t, all.ShouldRetry(2, timeout))
+	require.False(t, all.ShouldRetry(0, nil))
+
+	matching := StepRetryParameters{NumRetries: 1, RetryOn: "timed? out"}
+	require.NoError(t, matching.Validate())
+	require.True(t, matching.ShouldRetry(0, timeout))
+	require.False(t, matching.ShouldRetry(0, other))
+
+	require.False(t, StepRetryParameters{}.ShouldRetry(0, timeout))
+	require.Error(t, (&StepRetryParameters{RetryOn: "("}).Validate())
+
+	// RetryOn is only compiled by Validate.
+	unvalidated := StepRetryParameters{NumRetries: 1, RetryOn: "timed? out"}
+	require.False(t, unvalidated.ShouldRetry(0, timeout))
+}
@@ -26,6 +26,9 @@ type Test struct {
 	TargetManagerBundle *target.TargetManagerBundle
 	TestFetcherBundle   *TestFetcherBundle
 	RetryParameters     RetryParameters
+	// TargetIDs, if not empty, restricts the test to the acquired targets
+	// with these IDs.
+	TargetIDs []string
 }
 
 // TestDescriptor models the JSON encoded blob which is given as input to the
@@ -45,6 +48,11 @@ type TestDescriptor struct {
 	// TestFetcher-related parameters
 	TestFetcherName            string
 	TestFetcherFetchParameters json.RawMessage
+
+	// TargetIDs optionally restricts the test to a subset of the targets
+	// returned by the target manager. Any other acquired target is released
+	// right away. This is used when retrying a job on its failed targets only.
+	TargetIDs []string `json:",omitempty"`
 }
 
 // Validate performs sanity checks on the Descriptor
@@ -14,9 +14,15 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/linuxboot/contest/pkg/api"
-	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 	"github.com/linuxboot/contest/plugins/listeners/httplistener"
@@ -36,6 +42,9 @@ type HTTPPartiallyDecodedResponse struct {
 // HTTP implements the Transport interface
 type HTTP struct {
 	Addr string
+	// Token, if set, is sent as bearer token for the server to authenticate
+	// the requestor.
+	Token string
 }
 
 func (h *HTTP) Version(ctx xcontext.Context, requestor string) (*api.VersionResponse, error) {
@@ -68,6 +77,22 @@ func (h *HTTP) Start(ctx xcontext.Context, requestor string, jobDescriptor strin
 	return &api.StartResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
 }
 
+func (h *HTTP) Validate(ctx xcontext.Context, requestor string, jobDescriptor string) (*api.ValidateResponse, error) {
+	params := url.Values{}
+	params.Add("jobDesc", jobDescriptor)
+	resp, err := h.request(ctx, requestor, "validate", params)
+	if err != nil {
+		return nil, err
+	}
+	data := api.ResponseDataValidate{}
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ValidateResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
 func (h *HTTP) Stop(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.StopResponse, error) {
 	params := url.Values{}
 	params.Add("jobID", strconv.Itoa(int(jobID)))
@@ -100,9 +125,47 @@ func (h *HTTP) Status(ctx xcontext.Context, requestor string, jobID types.JobID)
 	return &api.StatusResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
 }
 
-func (h *HTTP) Retry(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.RetryResponse, error) {
+func (h *HTTP) Pause(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.PauseResponse, error) {
+	params := url.Values{}
+	params.Add("jobID", strconv.Itoa(int(jobID)))
+	resp, err := h.request(ctx, requestor, "pause", params)
+	if err != nil {
+		return nil, err
+	}
+	data := api.ResponseDataPause{}
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.PauseResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) Resume(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.ResumeResponse, error) {
 	params := url.Values{}
 	params.Add("jobID", strconv.Itoa(int(jobID)))
+	resp, err := h.request(ctx, requestor, "resume", params)
+	if err != nil {
+		return nil, err
+	}
+	data := api.ResponseDataResume{}
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ResumeResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) Retry(ctx xcontext.Context, requestor string, jobID types.JobID, opts api.RetryOptions) (*api.RetryResponse, error) {
+	params := url.Values{}
+	params.Add("jobID", strconv.Itoa(int(jobID)))
+	if opts.OnlyFailedTests {
+		params.Add("onlyFailedTests", "true")
+	}
+	if opts.OnlyFailedTargets {
+		params.Add("onlyFailedTargets", "true")
+	}
 	resp, err := h.request(ctx, requestor, "retry", params)
 	if err != nil {
 		return nil, err
@@ -116,17 +179,45 @@ func (h *HTTP) Retry(ctx xcontext.Context, requestor string, jobID types.JobID)
 	return &api.RetryResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
 }
 
-func (h *HTTP) List(ctx xcontext.Context, requestor string, states []job.State, tags []string) (*api.ListResponse, error) {
+func (h *HTTP) List(ctx xcontext.Context, requestor string, query *storage.JobQuery) (*api.ListResponse, error) {
 	params := url.Values{}
-	if len(states) > 0 {
-		sts := make([]string, len(states))
-		for i, st := range states {
+	if len(query.States) > 0 {
+		sts := make([]string, len(query.States))
+		for i, st := range query.States {
 			sts[i] = st.String()
 		}
 		params.Set("states", strings.Join(sts, ","))
 	}
-	if len(tags) > 0 {
-		params.Set("tags", strings.Join(tags, ","))
+	if len(query.Tags) > 0 {
+		params.Set("tags", strings.Join(query.Tags, ","))
+	}
+	for param, value := range map[string]string{
+		"serverID":     query.ServerID,
+		"jobRequestor": query.Requestor,
+		"namePattern":  query.NamePattern,
+	} {
+		if value != "" {
+			params.Set(param, value)
+		}
+	}
+	for param, t := range map[string]time.Time{
+		"requestedAfter":  query.RequestedAfter,
+		"requestedBefore": query.RequestedBefore,
+		"endedAfter":      query.EndedAfter,
+		"endedBefore":     query.EndedBefore,
+	} {
+		if !t.IsZero() {
+			params.Set(param, t.Format(time.RFC3339Nano))
+		}
+	}
+	if query.NewestFirst {
+		params.Set("newestFirst", "true")
+	}
+	if query.AfterJobID != 0 {
+		params.Set("afterJobID", query.AfterJobID.String())
+	}
+	if query.Limit != 0 {
+		params.Set("limit", strconv.FormatUint(uint64(query.Limit), 10))
 	}
 	resp, err := h.request(ctx, requestor, "list", params)
 	if err != nil {
@@ -141,6 +232,391 @@ func (h *HTTP) List(ctx xcontext.Context, requestor string, states []job.State,
 	return &api.ListResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
 }
 
+func (h *HTTP) Schedule(ctx xcontext.Context, requestor string, jobDescriptor string, cronExpression string, skipIfRunning bool) (*api.ScheduleResponse, error) {
+	params := url.Values{}
+	params.Add("jobDesc", jobDescriptor)
+	params.Add("cron", cronExpression)
+	if skipIfRunning {
+		params.Add("skipIfRunning", "true")
+	}
+	resp, err := h.request(ctx, requestor, "schedule", params)
+	if err != nil {
+		return nil, err
+	}
+	data := api.ResponseDataSchedule{}
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ScheduleResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) Unschedule(ctx xcontext.Context, requestor string, scheduleID types.ScheduleID) (*api.UnscheduleResponse, error) {
+	params := url.Values{}
+	params.Add("scheduleID", scheduleID.String())
+	resp, err := h.request(ctx, requestor, "unschedule", params)
+	if err != nil {
+		return nil, err
+	}
+	data := api.ResponseDataUnschedule{}
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.UnscheduleResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) ListSchedules(ctx xcontext.Context, requestor string) (*api.ListSchedulesResponse, error) {
+	resp, err := h.request(ctx, requestor, "listschedules", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataListSchedules
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ListSchedulesResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) JUnit(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.JUnitResponse, error) {
+	params := url.Values{}
+	params.Add("jobID", jobID.String())
+	resp, err := h.request(ctx, requestor, "junit", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataJUnit
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.JUnitResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) Artifact(ctx xcontext.Context, requestor string, jobID types.JobID, artifactID uint64) (*api.ArtifactResponse, error) {
+	params := url.Values{}
+	params.Add("jobID", jobID.String())
+	params.Add("artifactID", strconv.FormatUint(artifactID, 10))
+	resp, err := h.request(ctx, requestor, "artifact", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataArtifact
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ArtifactResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) Logs(ctx xcontext.Context, requestor string, jobID types.JobID, afterSequenceID uint64, limit uint) (*api.LogsResponse, error) {
+	params := url.Values{}
+	params.Add("jobID", jobID.String())
+	if afterSequenceID != 0 {
+		params.Add("afterSequenceID", strconv.FormatUint(afterSequenceID, 10))
+	}
+	if limit != 0 {
+		params.Add("limit", strconv.FormatUint(uint64(limit), 10))
+	}
+	resp, err := h.request(ctx, requestor, "logs", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataLogs
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.LogsResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) AddTarget(ctx xcontext.Context, requestor string, target inventory.Target) (*api.AddTargetResponse, error) {
+	targetJSON, err := json.Marshal(target)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode target: %w", err)
+	}
+	params := url.Values{}
+	params.Add("target", string(targetJSON))
+	resp, err := h.request(ctx, requestor, "addtarget", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataAddTarget
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.AddTargetResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) UpdateTarget(ctx xcontext.Context, requestor string, target inventory.Target) (*api.UpdateTargetResponse, error) {
+	targetJSON, err := json.Marshal(target)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode target: %w", err)
+	}
+	params := url.Values{}
+	params.Add("target", string(targetJSON))
+	resp, err := h.request(ctx, requestor, "updatetarget", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataUpdateTarget
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.UpdateTargetResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) RemoveTarget(ctx xcontext.Context, requestor string, targetID string) (*api.RemoveTargetResponse, error) {
+	params := url.Values{}
+	params.Add("targetID", targetID)
+	resp, err := h.request(ctx, requestor, "removetarget", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataRemoveTarget
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.RemoveTargetResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) ListTargets(ctx xcontext.Context, requestor string, selector string) (*api.ListTargetsResponse, error) {
+	params := url.Values{}
+	if selector != "" {
+		params.Add("selector", selector)
+	}
+	resp, err := h.request(ctx, requestor, "listtargets", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataListTargets
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ListTargetsResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) QuarantineTarget(ctx xcontext.Context, requestor string, targetID, reason string) (*api.QuarantineTargetResponse, error) {
+	params := url.Values{}
+	params.Add("targetID", targetID)
+	params.Add("reason", reason)
+	resp, err := h.request(ctx, requestor, "quarantine", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataQuarantineTarget
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.QuarantineTargetResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) ReleaseTarget(ctx xcontext.Context, requestor string, targetID, reason string) (*api.ReleaseTargetResponse, error) {
+	params := url.Values{}
+	params.Add("targetID", targetID)
+	params.Add("reason", reason)
+	resp, err := h.request(ctx, requestor, "release", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataReleaseTarget
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ReleaseTargetResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) ListQuarantinedTargets(ctx xcontext.Context, requestor string) (*api.ListQuarantinedTargetsResponse, error) {
+	resp, err := h.request(ctx, requestor, "quarantined", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataListQuarantinedTargets
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ListQuarantinedTargetsResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) ListLocks(ctx xcontext.Context, requestor string, targetIDs []string) (*api.ListLocksResponse, error) {
+	params := url.Values{}
+	if len(targetIDs) > 0 {
+		params.Set("targetIDs", strings.Join(targetIDs, ","))
+	}
+	resp, err := h.request(ctx, requestor, "locks", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataListLocks
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ListLocksResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) ForceUnlock(ctx xcontext.Context, requestor string, targetIDs []string, reason string) (*api.ForceUnlockResponse, error) {
+	params := url.Values{}
+	params.Set("targetIDs", strings.Join(targetIDs, ","))
+	params.Add("reason", reason)
+	resp, err := h.request(ctx, requestor, "forceunlock", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataForceUnlock
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.ForceUnlockResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+func (h *HTTP) Tail(ctx xcontext.Context, requestor string, jobID types.JobID, filter stream.Filter, cursor stream.Cursor, wait time.Duration) (*api.TailResponse, error) {
+	params := url.Values{}
+	params.Add("jobID", jobID.String())
+	if filter.RunID != 0 {
+		params.Add("runID", filter.RunID.String())
+	}
+	if filter.TestName != "" {
+		params.Add("testName", filter.TestName)
+	}
+	if filter.TestStepLabel != "" {
+		params.Add("stepLabel", filter.TestStepLabel)
+	}
+	if filter.TargetID != "" {
+		params.Add("targetID", filter.TargetID)
+	}
+	if len(filter.EventNames) != 0 {
+		names := make([]string, 0, len(filter.EventNames))
+		for _, name := range filter.EventNames {
+			names = append(names, string(name))
+		}
+		params.Add("eventNames", strings.Join(names, ","))
+	}
+	params.Add("testEventSequenceID", strconv.FormatUint(cursor.TestEventSequenceID, 10))
+	params.Add("frameworkEventSequenceID", strconv.FormatUint(cursor.FrameworkEventSequenceID, 10))
+	params.Add("wait", wait.String())
+	resp, err := h.request(ctx, requestor, "tail", params)
+	if err != nil {
+		return nil, err
+	}
+	var data api.ResponseDataTail
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &api.TailResponse{ServerID: resp.ServerID, Data: data, Err: resp.Error}, nil
+}
+
+// Events queries the test and the framework events with one request each,
+// a nil query skips that kind of events.
+func (h *HTTP) Events(ctx xcontext.Context, requestor string, testQuery *testevent.Query, frameworkQuery *frameworkevent.Query) (*api.EventsResponse, error) {
+	result := &api.EventsResponse{}
+	if testQuery != nil {
+		params := eventQueryParams(&testQuery.Query)
+		params.Add("kinds", "test")
+		if testQuery.AfterSequenceID != 0 {
+			params.Add("testEventSequenceID", strconv.FormatUint(testQuery.AfterSequenceID, 10))
+		}
+		if testQuery.RunID != 0 {
+			params.Add("runID", testQuery.RunID.String())
+		}
+		for param, value := range map[string]string{
+			"testName":        testQuery.TestName,
+			"stepLabel":       testQuery.TestStepLabel,
+			"targetID":        testQuery.TargetID,
+			"payloadContains": testQuery.PayloadContains,
+		} {
+			if value != "" {
+				params.Add(param, value)
+			}
+		}
+		data, err := h.events(ctx, requestor, params, result)
+		if err != nil || result.Err != nil {
+			return result, err
+		}
+		result.Data.TestEvents = data.TestEvents
+	}
+	if frameworkQuery != nil {
+		params := eventQueryParams(&frameworkQuery.Query)
+		params.Add("kinds", "framework")
+		if frameworkQuery.AfterSequenceID != 0 {
+			params.Add("frameworkEventSequenceID", strconv.FormatUint(frameworkQuery.AfterSequenceID, 10))
+		}
+		data, err := h.events(ctx, requestor, params, result)
+		if err != nil || result.Err != nil {
+			return result, err
+		}
+		result.Data.FrameworkEvents = data.FrameworkEvents
+	}
+	return result, nil
+}
+
+// eventQueryParams returns the parameters of the fields common to test and
+// framework event queries, except for AfterSequenceID.
+func eventQueryParams(query *event.Query) url.Values {
+	params := url.Values{}
+	params.Add("jobID", query.JobID.String())
+	if len(query.EventNames) != 0 {
+		names := make([]string, 0, len(query.EventNames))
+		for _, name := range query.EventNames {
+			names = append(names, string(name))
+		}
+		params.Add("eventNames", strings.Join(names, ","))
+	}
+	if !query.EmittedStartTime.IsZero() {
+		params.Add("emittedStartTime", query.EmittedStartTime.Format(time.RFC3339Nano))
+	}
+	if !query.EmittedEndTime.IsZero() {
+		params.Add("emittedEndTime", query.EmittedEndTime.Format(time.RFC3339Nano))
+	}
+	if query.Limit != 0 {
+		params.Add("limit", strconv.FormatUint(uint64(query.Limit), 10))
+	}
+	return params
+}
+
+// events sends an events request, and records the server ID and the error
+// of the response in result.
+func (h *HTTP) events(ctx xcontext.Context, requestor string, params url.Values, result *api.EventsResponse) (*api.ResponseDataEvents, error) {
+	resp, err := h.request(ctx, requestor, "events", params)
+	if err != nil {
+		return nil, err
+	}
+	result.ServerID, result.Err = resp.ServerID, resp.Error
+	var data api.ResponseDataEvents
+	if string(resp.Data) != "" {
+		if err := json.Unmarshal([]byte(resp.Data), &data); err != nil {
+			return nil, fmt.Errorf("cannot decode json response: %v", err)
+		}
+	}
+	return &data, nil
+}
+
 func (h *HTTP) request(ctx xcontext.Context, requestor string, verb string, params url.Values) (*HTTPPartiallyDecodedResponse, error) {
 	logger := xcontext.LoggerFrom(ctx)
 
@@ -160,7 +636,15 @@ func (h *HTTP) request(ctx xcontext.Context, requestor string, verb string, para
 		logger = logger.WithField(k, v)
 	}
 	logger.Debugf("Requesting URL %s with requestor ID '%s'\n", u.String(), requestor)
-	resp, err := http.PostForm(u.String(), params)
+	req, err := http.NewRequest(http.MethodPost, u.String(), strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("cannot create HTTP request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if h.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.Token)
+	}
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("HTTP POST failed: %v", err)
 	}
@@ -6,8 +6,14 @@
 package transport
 
 import (
+	"time"
+
 	"github.com/linuxboot/contest/pkg/api"
-	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
@@ -17,8 +23,28 @@ import (
 type Transport interface {
 	Version(ctx xcontext.Context, requestor string) (*api.VersionResponse, error)
 	Start(ctx xcontext.Context, requestor string, jobDescriptor string) (*api.StartResponse, error)
+	Validate(ctx xcontext.Context, requestor string, jobDescriptor string) (*api.ValidateResponse, error)
 	Stop(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.StopResponse, error)
 	Status(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.StatusResponse, error)
-	Retry(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.RetryResponse, error)
-	List(ctx xcontext.Context, requestor string, states []job.State, tags []string) (*api.ListResponse, error)
+	Pause(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.PauseResponse, error)
+	Resume(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.ResumeResponse, error)
+	Retry(ctx xcontext.Context, requestor string, jobID types.JobID, opts api.RetryOptions) (*api.RetryResponse, error)
+	List(ctx xcontext.Context, requestor string, query *storage.JobQuery) (*api.ListResponse, error)
+	Schedule(ctx xcontext.Context, requestor string, jobDescriptor string, cronExpression string, skipIfRunning bool) (*api.ScheduleResponse, error)
+	Unschedule(ctx xcontext.Context, requestor string, scheduleID types.ScheduleID) (*api.UnscheduleResponse, error)
+	ListSchedules(ctx xcontext.Context, requestor string) (*api.ListSchedulesResponse, error)
+	JUnit(ctx xcontext.Context, requestor string, jobID types.JobID) (*api.JUnitResponse, error)
+	Artifact(ctx xcontext.Context, requestor string, jobID types.JobID, artifactID uint64) (*api.ArtifactResponse, error)
+	Logs(ctx xcontext.Context, requestor string, jobID types.JobID, afterSequenceID uint64, limit uint) (*api.LogsResponse, error)
+	Tail(ctx xcontext.Context, requestor string, jobID types.JobID, filter stream.Filter, cursor stream.Cursor, wait time.Duration) (*api.TailResponse, error)
+	Events(ctx xcontext.Context, requestor string, testQuery *testevent.Query, frameworkQuery *frameworkevent.Query) (*api.EventsResponse, error)
+	AddTarget(ctx xcontext.Context, requestor string, target inventory.Target) (*api.AddTargetResponse, error)
+	UpdateTarget(ctx xcontext.Context, requestor string, target inventory.Target) (*api.UpdateTargetResponse, error)
+	RemoveTarget(ctx xcontext.Context, requestor string, targetID string) (*api.RemoveTargetResponse, error)
+	ListTargets(ctx xcontext.Context, requestor string, selector string) (*api.ListTargetsResponse, error)
+	QuarantineTarget(ctx xcontext.Context, requestor string, targetID, reason string) (*api.QuarantineTargetResponse, error)
+	ReleaseTarget(ctx xcontext.Context, requestor string, targetID, reason string) (*api.ReleaseTargetResponse, error)
+	ListQuarantinedTargets(ctx xcontext.Context, requestor string) (*api.ListQuarantinedTargetsResponse, error)
+	ListLocks(ctx xcontext.Context, requestor string, targetIDs []string) (*api.ListLocksResponse, error)
+	ForceUnlock(ctx xcontext.Context, requestor string, targetIDs []string, reason string) (*api.ForceUnlockResponse, error)
 }
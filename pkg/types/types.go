@@ -17,6 +17,9 @@ type JobID uint64
 // RunID represents the id of a run within the Job
 type RunID uint64
 
+// ScheduleID represents a unique job schedule identifier
+type ScheduleID uint64
+
 func (v JobID) String() string {
 	return strconv.FormatUint(uint64(v), 10)
 }
@@ -25,6 +28,10 @@ func (v RunID) String() string {
 	return strconv.FormatUint(uint64(v), 10)
 }
 
+func (v ScheduleID) String() string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
 type key string
 
 const (
@@ -26,6 +26,9 @@ type contestParameter struct {
 	Parameter fqdnParameter
 }
 
+// userFunctions look up the parameters of a target by its ID in
+// parameterFile. Targets acquired with their attributes, e.g. from the
+// inventory, are better served by {{ .Attr "bmc_host" }} and the like.
 var userFunctions = map[string]interface{}{
 	"getPrivateKeyFile": func(a string) (string, error) {
 		fb, err := ioutil.ReadFile(parameterFile)
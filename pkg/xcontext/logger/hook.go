@@ -0,0 +1,93 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package logger
+
+import (
+	"fmt"
+
+	"github.com/linuxboot/contest/pkg/xcontext/fields"
+)
+
+// Hook is called with the messages logged through a logger returned by
+// WithHook.
+type Hook func(level Level, message string)
+
+// WithHook returns a Logger which logs like l, and which also passes the
+// messages of level hookLevel or more severe to hook. The hook is called
+// regardless of the level of l, and is preserved by WithField, WithFields and
+// WithLevel.
+func WithHook(l Logger, hookLevel Level, hook Hook) Logger {
+	return hookLogger{Logger: l, hookLevel: hookLevel, hook: hook}
+}
+
+type hookLogger struct {
+	Logger
+	hookLevel Level
+	hook      Hook
+}
+
+var _ Logger = hookLogger{}
+
+func (l hookLogger) call(level Level, format string, args []interface{}) {
+	if level > l.hookLevel {
+		return
+	}
+	l.hook(level, fmt.Sprintf(format, args...))
+}
+
+// Debugf implements MinimalLogger.
+func (l hookLogger) Debugf(format string, args ...interface{}) {
+	l.call(LevelDebug, format, args)
+	l.Logger.Debugf(format, args...)
+}
+
+// Infof implements MinimalLogger.
+func (l hookLogger) Infof(format string, args ...interface{}) {
+	l.call(LevelInfo, format, args)
+	l.Logger.Infof(format, args...)
+}
+
+// Warnf implements MinimalLogger.
+func (l hookLogger) Warnf(format string, args ...interface{}) {
+	l.call(LevelWarning, format, args)
+	l.Logger.Warnf(format, args...)
+}
+
+// Errorf implements MinimalLogger.
+func (l hookLogger) Errorf(format string, args ...interface{}) {
+	l.call(LevelError, format, args)
+	l.Logger.Errorf(format, args...)
+}
+
+// Panicf implements MinimalLogger. The hook is called before panicking.
+func (l hookLogger) Panicf(format string, args ...interface{}) {
+	l.call(LevelPanic, format, args)
+	l.Logger.Panicf(format, args...)
+}
+
+// Fatalf implements MinimalLogger. The hook is called before exiting.
+func (l hookLogger) Fatalf(format string, args ...interface{}) {
+	l.call(LevelFatal, format, args)
+	l.Logger.Fatalf(format, args...)
+}
+
+// WithField implements LoggerExtensions.
+func (l hookLogger) WithField(key string, value interface{}) Logger {
+	l.Logger = l.Logger.WithField(key, value)
+	return l
+}
+
+// WithFields implements LoggerExtensions.
+func (l hookLogger) WithFields(f fields.Fields) Logger {
+	l.Logger = l.Logger.WithFields(f)
+	return l
+}
+
+// WithLevel implements LoggerExtensions.
+func (l hookLogger) WithLevel(level Level) Logger {
+	l.Logger = l.Logger.WithLevel(level)
+	return l
+}
@@ -0,0 +1,33 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package logger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithHook(t *testing.T) {
+	var logged, hooked []string
+	l := ConvertLogger(func(format string, args ...interface{}) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	}).WithLevel(LevelError)
+	l = WithHook(l, LevelInfo, func(level Level, message string) {
+		hooked = append(hooked, level.String()+" "+message)
+	})
+
+	l.Debugf("debug %d", 1)
+	l.Infof("info %d", 2)
+	// The hook is kept by the derived loggers.
+	l.WithField("key", "value").Warnf("warning %d", 3)
+	l.WithLevel(LevelDebug).Errorf("error %d", 4)
+
+	require.Equal(t, []string{"info info 2", "warning warning 3", "error error 4"}, hooked)
+	require.Len(t, logged, 1)
+	require.Contains(t, logged[0], "error 4")
+}
@@ -1,7 +1,50 @@
 package perf
 
 // perf counter keys constants
+//
+// Durations are reported as a Gauge with the sum of the observed seconds,
+// next to a Count of the observations, so that the average duration over a
+// period is the ratio of their increases.
 const (
 	ACQUIRED_TARGETS string = "acquired_targets"
 	RUNNING_JOBS     string = "running_jobs"
+
+	// JOB_STATES counts the state transitions of the jobs, tagged with TAG_JOB_STATE.
+	JOB_STATES string = "job_states"
+	// STEP_RUNS and STEP_RUN_SECONDS measure the runs of the test steps,
+	// tagged with TAG_STEP_PLUGIN.
+	STEP_RUNS        string = "step_runs"
+	STEP_RUN_SECONDS string = "step_run_seconds"
+	// TARGET_RESULTS counts the results of the targets going through the test
+	// steps, tagged with TAG_STEP_PLUGIN and TAG_TARGET_RESULT.
+	TARGET_RESULTS string = "target_results"
+	// TARGET_ACQUIRES and TARGET_ACQUIRE_SECONDS measure the acquisition and
+	// the locking of the targets of the tests.
+	TARGET_ACQUIRES        string = "target_acquires"
+	TARGET_ACQUIRE_SECONDS string = "target_acquire_seconds"
+	// STORAGE_FLUSHES and STORAGE_FLUSH_SECONDS measure the flushes of the
+	// event buffers of the storage, tagged with TAG_STORAGE_BUFFER.
+	STORAGE_FLUSHES       string = "storage_flushes"
+	STORAGE_FLUSH_SECONDS string = "storage_flush_seconds"
+	// API_REQUESTS counts the requests received by the API, tagged with
+	// TAG_API_METHOD.
+	API_REQUESTS string = "api_requests"
+)
+
+// perf tag keys constants
+const (
+	TAG_JOB_STATE      string = "job_state"
+	TAG_STEP_PLUGIN    string = "step_plugin"
+	TAG_TARGET_RESULT  string = "result"
+	TAG_STORAGE_BUFFER string = "buffer"
+	TAG_API_METHOD     string = "api_method"
+)
+
+// perf tag values constants
+const (
+	TARGET_RESULT_PASS string = "pass"
+	TARGET_RESULT_FAIL string = "fail"
+
+	STORAGE_BUFFER_TEST_EVENTS      string = "test_events"
+	STORAGE_BUFFER_FRAMEWORK_EVENTS string = "framework_events"
 )
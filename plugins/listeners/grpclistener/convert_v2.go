@@ -0,0 +1,451 @@
+package grpclistener
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	contestlistenerv2 "github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v2"
+)
+
+// formatTime formats times of the v2 messages, the zero time is empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+func rawPayload(payload *json.RawMessage) []byte {
+	if payload == nil {
+		return nil
+	}
+	return *payload
+}
+
+func statusToV2(jobID types.JobID, status *job.Status) (*contestlistenerv2.Status, error) {
+	state, err := job.EventNameToJobState(event.Name(status.State))
+	if err != nil {
+		state = job.JobStateUnknown
+	}
+	res := &contestlistenerv2.Status{
+		JobId:         int64(jobID),
+		Name:          status.Name,
+		State:         contestlistenerv2.JobState(state),
+		StateError:    status.StateErrMsg,
+		StartTime:     formatTime(status.StartTime),
+		QueuePosition: int32(status.QueuePosition),
+		RetryOf:       int64(status.RetryOf),
+	}
+	if status.EndTime != nil {
+		res.EndTime = formatTime(*status.EndTime)
+	}
+	if status.TargetWait != nil {
+		res.TargetWait = &contestlistenerv2.TargetWait{
+			Position:   int32(status.TargetWait.Position),
+			Priority:   int32(status.TargetWait.Priority),
+			Since:      formatTime(status.TargetWait.Since),
+			MinTargets: uint32(status.TargetWait.MinTargets),
+		}
+	}
+	for i := range status.RunStatuses {
+		res.RunStatuses = append(res.RunStatuses, runStatusToV2(&status.RunStatuses[i]))
+	}
+	for i := range status.Artifacts {
+		res.Artifacts = append(res.Artifacts, artifactToV2(&status.Artifacts[i]))
+	}
+	if status.JobReport != nil {
+		res.JobReport = &contestlistenerv2.JobReport{}
+		for _, runReports := range status.JobReport.RunReports {
+			pbRunReports := &contestlistenerv2.RunReports{}
+			for _, report := range runReports {
+				pbReport, err := reportToV2(report)
+				if err != nil {
+					return nil, err
+				}
+				pbRunReports.Reports = append(pbRunReports.Reports, pbReport)
+			}
+			res.JobReport.RunReports = append(res.JobReport.RunReports, pbRunReports)
+		}
+		for _, report := range status.JobReport.FinalReports {
+			pbReport, err := reportToV2(report)
+			if err != nil {
+				return nil, err
+			}
+			res.JobReport.FinalReports = append(res.JobReport.FinalReports, pbReport)
+		}
+	}
+	return res, nil
+}
+
+func artifactToV2(a *artifact.Artifact) *contestlistenerv2.Artifact {
+	return &contestlistenerv2.Artifact{
+		Id:            a.ID,
+		JobId:         int64(a.JobID),
+		RunId:         uint64(a.RunID),
+		TestName:      a.TestName,
+		TestAttempt:   a.TestAttempt,
+		TestStepLabel: a.TestStepLabel,
+		TargetId:      a.TargetID,
+		Name:          a.Name,
+		Size:          a.Size,
+		Sha256:        a.SHA256,
+		CreateTime:    formatTime(a.CreateTime),
+	}
+}
+
+func logEntryToV2(entry job.LogEntry) *contestlistenerv2.LogEntry {
+	return &contestlistenerv2.LogEntry{
+		SequenceId: entry.SequenceID,
+		Time:       formatTime(entry.Time),
+		Level:      entry.Level,
+		Message:    entry.Message,
+	}
+}
+
+func reportToV2(report *job.Report) (*contestlistenerv2.Report, error) {
+	data, err := report.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	return &contestlistenerv2.Report{
+		RunId:        uint64(report.RunID),
+		ReporterName: report.ReporterName,
+		ReportTime:   formatTime(report.ReportTime),
+		Success:      report.Success,
+		Data:         bytes.TrimRight(data, "\n"),
+	}, nil
+}
+
+func runStatusToV2(runStatus *job.RunStatus) *contestlistenerv2.RunStatus {
+	res := &contestlistenerv2.RunStatus{
+		JobId:     int64(runStatus.JobID),
+		RunId:     uint64(runStatus.RunID),
+		StartTime: formatTime(runStatus.StartTime),
+	}
+	for _, testStatus := range runStatus.TestStatuses {
+		pbTestStatus := &contestlistenerv2.TestStatus{
+			TestName:       testStatus.TestName,
+			TargetStatuses: targetStatusesToV2(testStatus.TargetStatuses),
+		}
+		for _, stepStatus := range testStatus.TestStepStatuses {
+			pbTestStatus.TestStepStatuses = append(pbTestStatus.TestStepStatuses, &contestlistenerv2.TestStepStatus{
+				TestStepName:   stepStatus.TestStepName,
+				TestStepLabel:  stepStatus.TestStepLabel,
+				Events:         testEventsToV2(stepStatus.Events),
+				TargetStatuses: targetStatusesToV2(stepStatus.TargetStatuses),
+			})
+		}
+		res.TestStatuses = append(res.TestStatuses, pbTestStatus)
+	}
+	return res
+}
+
+func targetStatusesToV2(targetStatuses []job.TargetStatus) []*contestlistenerv2.TargetStatus {
+	var res []*contestlistenerv2.TargetStatus
+	for _, targetStatus := range targetStatuses {
+		res = append(res, &contestlistenerv2.TargetStatus{
+			Target:          targetToV2(targetStatus.Target),
+			InTime:          formatTime(targetStatus.InTime),
+			OutTime:         formatTime(targetStatus.OutTime),
+			Error:           targetStatus.Error,
+			TestStepAttempt: targetStatus.TestStepAttempt,
+			Events:          testEventsToV2(targetStatus.Events),
+		})
+	}
+	return res
+}
+
+func targetToV2(t *target.Target) *contestlistenerv2.Target {
+	if t == nil {
+		return nil
+	}
+	res := &contestlistenerv2.Target{Id: t.ID, Fqdn: t.FQDN}
+	if t.PrimaryIPv4 != nil {
+		res.PrimaryIpv4 = t.PrimaryIPv4.String()
+	}
+	if t.PrimaryIPv6 != nil {
+		res.PrimaryIpv6 = t.PrimaryIPv6.String()
+	}
+	return res
+}
+
+func inventoryTargetToV2(t *inventory.Target) *contestlistenerv2.InventoryTarget {
+	res := &contestlistenerv2.InventoryTarget{
+		Id:         t.ID,
+		Fqdn:       t.FQDN,
+		Labels:     t.Labels,
+		Attributes: t.Attributes,
+		CreateTime: formatTime(t.CreateTime),
+		UpdateTime: formatTime(t.UpdateTime),
+	}
+	if t.PrimaryIPv4 != nil {
+		res.PrimaryIpv4 = t.PrimaryIPv4.String()
+	}
+	if t.PrimaryIPv6 != nil {
+		res.PrimaryIpv6 = t.PrimaryIPv6.String()
+	}
+	return res
+}
+
+func quarantineToV2(q *health.Quarantine) *contestlistenerv2.TargetQuarantine {
+	res := &contestlistenerv2.TargetQuarantine{
+		TargetId:  q.TargetID,
+		Reason:    q.Reason,
+		Requestor: q.Requestor,
+		Time:      formatTime(q.Time),
+	}
+	if q.Release != nil {
+		res.ReleaseReason = q.Release.Reason
+		res.ReleaseRequestor = q.Release.Requestor
+		res.ReleaseTime = formatTime(q.Release.Time)
+	}
+	return res
+}
+
+func lockToV2(l target.Lock) *contestlistenerv2.TargetLock {
+	return &contestlistenerv2.TargetLock{
+		TargetId:  l.TargetID,
+		JobId:     int64(l.JobID),
+		CreatedAt: formatTime(l.CreatedAt),
+		ExpiresAt: formatTime(l.ExpiresAt),
+	}
+}
+
+// inventoryTargetFromV2 converts the target of an AddTarget or UpdateTarget
+// request, the times are set by the server.
+func inventoryTargetFromV2(t *contestlistenerv2.InventoryTarget) (inventory.Target, error) {
+	if t == nil {
+		return inventory.Target{}, errors.New("target is not set")
+	}
+	res := inventory.Target{
+		ID:         t.Id,
+		FQDN:       t.Fqdn,
+		Labels:     t.Labels,
+		Attributes: t.Attributes,
+	}
+	if t.PrimaryIpv4 != "" {
+		if res.PrimaryIPv4 = net.ParseIP(t.PrimaryIpv4); res.PrimaryIPv4 == nil {
+			return res, fmt.Errorf("invalid primary_ipv4 %q", t.PrimaryIpv4)
+		}
+	}
+	if t.PrimaryIpv6 != "" {
+		if res.PrimaryIPv6 = net.ParseIP(t.PrimaryIpv6); res.PrimaryIPv6 == nil {
+			return res, fmt.Errorf("invalid primary_ipv6 %q", t.PrimaryIpv6)
+		}
+	}
+	return res, res.Validate()
+}
+
+func testEventsToV2(events []testevent.Event) []*contestlistenerv2.TestEvent {
+	var res []*contestlistenerv2.TestEvent
+	for i := range events {
+		res = append(res, testEventToV2(&events[i]))
+	}
+	return res
+}
+
+func testEventToV2(ev *testevent.Event) *contestlistenerv2.TestEvent {
+	res := &contestlistenerv2.TestEvent{
+		SequenceId: ev.SequenceID,
+		EmitTime:   formatTime(ev.EmitTime),
+	}
+	if ev.Header != nil {
+		res.Header = &contestlistenerv2.TestEventHeader{
+			JobId:           int64(ev.Header.JobID),
+			RunId:           uint64(ev.Header.RunID),
+			TestName:        ev.Header.TestName,
+			TestAttempt:     ev.Header.TestAttempt,
+			TestStepLabel:   ev.Header.TestStepLabel,
+			TestStepAttempt: ev.Header.TestStepAttempt,
+		}
+	}
+	if ev.Data != nil {
+		res.EventName = string(ev.Data.EventName)
+		res.Target = targetToV2(ev.Data.Target)
+		res.Payload = rawPayload(ev.Data.Payload)
+	}
+	return res
+}
+
+func frameworkEventToV2(ev *frameworkevent.Event) *contestlistenerv2.FrameworkEvent {
+	return &contestlistenerv2.FrameworkEvent{
+		SequenceId: ev.SequenceID,
+		JobId:      int64(ev.JobID),
+		EventName:  string(ev.EventName),
+		Payload:    rawPayload(ev.Payload),
+		EmitTime:   formatTime(ev.EmitTime),
+	}
+}
+
+func frameworkEventsToV2(events []frameworkevent.Event) []*contestlistenerv2.FrameworkEvent {
+	var res []*contestlistenerv2.FrameworkEvent
+	for i := range events {
+		res = append(res, frameworkEventToV2(&events[i]))
+	}
+	return res
+}
+
+// eventQueriesFromV2 converts a QueryEventsRequest to the queries of
+// api.QueryEvents, a nil query skips that kind of events.
+func eventQueriesFromV2(req *contestlistenerv2.QueryEventsRequest) (testevent.QueryFields, frameworkevent.QueryFields, error) {
+	var (
+		jobID          = types.JobID(req.JobId)
+		testQuery      = testevent.QueryFields{testevent.QueryJobID(jobID)}
+		frameworkQuery = frameworkevent.QueryFields{frameworkevent.QueryJobID(jobID)}
+	)
+	if len(req.EventNames) != 0 {
+		var names []event.Name
+		for _, name := range req.EventNames {
+			names = append(names, event.Name(name))
+		}
+		testQuery = append(testQuery, testevent.QueryEventNames(names))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryEventNames(names))
+	}
+	if req.EmittedStartTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.EmittedStartTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid emitted_start_time: %w", err)
+		}
+		testQuery = append(testQuery, testevent.QueryEmittedStartTime(t))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryEmittedStartTime(t))
+	}
+	if req.EmittedEndTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, req.EmittedEndTime)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid emitted_end_time: %w", err)
+		}
+		testQuery = append(testQuery, testevent.QueryEmittedEndTime(t))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryEmittedEndTime(t))
+	}
+	if req.Limit != 0 {
+		testQuery = append(testQuery, testevent.QueryLimit(uint(req.Limit)))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryLimit(uint(req.Limit)))
+	}
+	if req.AfterTestEventSequenceId != 0 {
+		testQuery = append(testQuery, testevent.QueryAfterSequenceID(req.AfterTestEventSequenceId))
+	}
+	if req.AfterFrameworkEventSequenceId != 0 {
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryAfterSequenceID(req.AfterFrameworkEventSequenceId))
+	}
+	if req.RunId != 0 {
+		testQuery = append(testQuery, testevent.QueryRunID(types.RunID(req.RunId)))
+	}
+	if req.TestName != "" {
+		testQuery = append(testQuery, testevent.QueryTestName(req.TestName))
+	}
+	if req.StepLabel != "" {
+		testQuery = append(testQuery, testevent.QueryTestStepLabel(req.StepLabel))
+	}
+	if req.TargetId != "" {
+		testQuery = append(testQuery, testevent.QueryTargetID(req.TargetId))
+	}
+	if req.PayloadContains != "" {
+		testQuery = append(testQuery, testevent.QueryPayloadContains(req.PayloadContains))
+	}
+
+	if len(req.Kinds) == 0 {
+		return testQuery, frameworkQuery, nil
+	}
+	var withTest, withFramework bool
+	for _, kind := range req.Kinds {
+		switch kind {
+		case contestlistenerv2.EventKind_EVENT_KIND_TEST:
+			withTest = true
+		case contestlistenerv2.EventKind_EVENT_KIND_FRAMEWORK:
+			withFramework = true
+		default:
+			return nil, nil, fmt.Errorf("invalid kind of events %v", kind)
+		}
+	}
+	if !withTest {
+		testQuery = nil
+	}
+	if !withFramework {
+		frameworkQuery = nil
+	}
+	return testQuery, frameworkQuery, nil
+}
+
+// jobQueryFromV2 converts a ListJobsRequest to the query of api.List.
+func jobQueryFromV2(req *contestlistenerv2.ListJobsRequest) (*storage.JobQuery, error) {
+	var fields []storage.JobQueryField
+	if len(req.States) > 0 {
+		var states []job.State
+		for _, st := range req.States {
+			states = append(states, job.State(st))
+		}
+		fields = append(fields, storage.QueryJobStates(states...))
+	}
+	if len(req.Tags) > 0 {
+		fields = append(fields, storage.QueryJobTags(req.Tags...))
+	}
+	if req.ServerId != "" {
+		fields = append(fields, storage.QueryJobServerID(req.ServerId))
+	}
+	if req.JobRequestor != "" {
+		fields = append(fields, storage.QueryJobRequestor(req.JobRequestor))
+	}
+	if req.NamePattern != "" {
+		fields = append(fields, storage.QueryJobNamePattern(req.NamePattern))
+	}
+	for _, timeField := range []struct {
+		name  string
+		value string
+		field func(time.Time) storage.JobQueryField
+	}{
+		{"requested_after", req.RequestedAfter, storage.QueryJobRequestedAfter},
+		{"requested_before", req.RequestedBefore, storage.QueryJobRequestedBefore},
+		{"ended_after", req.EndedAfter, storage.QueryJobEndedAfter},
+		{"ended_before", req.EndedBefore, storage.QueryJobEndedBefore},
+	} {
+		if timeField.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, timeField.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", timeField.name, err)
+		}
+		fields = append(fields, timeField.field(t))
+	}
+	if req.NewestFirst {
+		fields = append(fields, storage.QueryJobsNewestFirst())
+	}
+	if req.AfterJobId != 0 {
+		fields = append(fields, storage.QueryJobAfterJobID(types.JobID(req.AfterJobId)))
+	}
+	if req.Limit != 0 {
+		fields = append(fields, storage.QueryJobLimit(uint(req.Limit)))
+	}
+	return storage.BuildJobQuery(fields...)
+}
+
+func jobSummaryToV2(summary *job.Summary) *contestlistenerv2.JobSummary {
+	res := &contestlistenerv2.JobSummary{
+		JobId:       int64(summary.JobID),
+		Name:        summary.Name,
+		State:       contestlistenerv2.JobState(summary.State),
+		Requestor:   summary.Requestor,
+		ServerId:    summary.ServerID,
+		Tags:        summary.Tags,
+		RequestTime: formatTime(summary.RequestTime),
+		StartTime:   formatTime(summary.StartTime),
+	}
+	if summary.EndTime != nil {
+		res.EndTime = formatTime(*summary.EndTime)
+	}
+	return res
+}
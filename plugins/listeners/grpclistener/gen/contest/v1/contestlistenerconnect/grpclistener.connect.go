@@ -29,6 +29,10 @@ const (
 type ConTestServiceClient interface {
 	StartJob(context.Context, *connect_go.Request[contestlistener.StartJobRequest]) (*connect_go.Response[contestlistener.StartJobResponse], error)
 	StatusJob(context.Context, *connect_go.Request[contestlistener.StatusJobRequest]) (*connect_go.ServerStreamForClient[contestlistener.StatusJobResponse], error)
+	StreamEvents(context.Context, *connect_go.Request[contestlistener.StreamEventsRequest]) (*connect_go.ServerStreamForClient[contestlistener.StreamEventsResponse], error)
+	ScheduleJob(context.Context, *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error)
+	UnscheduleJob(context.Context, *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error)
+	ListSchedules(context.Context, *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error)
 }
 
 // NewConTestServiceClient constructs a client for the contest.v1.ConTestService service. By
@@ -51,13 +55,37 @@ func NewConTestServiceClient(httpClient connect_go.HTTPClient, baseURL string, o
 			baseURL+"/contest.v1.ConTestService/StatusJob",
 			opts...,
 		),
+		streamEvents: connect_go.NewClient[contestlistener.StreamEventsRequest, contestlistener.StreamEventsResponse](
+			httpClient,
+			baseURL+"/contest.v1.ConTestService/StreamEvents",
+			opts...,
+		),
+		scheduleJob: connect_go.NewClient[contestlistener.ScheduleJobRequest, contestlistener.ScheduleJobResponse](
+			httpClient,
+			baseURL+"/contest.v1.ConTestService/ScheduleJob",
+			opts...,
+		),
+		unscheduleJob: connect_go.NewClient[contestlistener.UnscheduleJobRequest, contestlistener.UnscheduleJobResponse](
+			httpClient,
+			baseURL+"/contest.v1.ConTestService/UnscheduleJob",
+			opts...,
+		),
+		listSchedules: connect_go.NewClient[contestlistener.ListSchedulesRequest, contestlistener.ListSchedulesResponse](
+			httpClient,
+			baseURL+"/contest.v1.ConTestService/ListSchedules",
+			opts...,
+		),
 	}
 }
 
 // conTestServiceClient implements ConTestServiceClient.
 type conTestServiceClient struct {
-	startJob  *connect_go.Client[contestlistener.StartJobRequest, contestlistener.StartJobResponse]
-	statusJob *connect_go.Client[contestlistener.StatusJobRequest, contestlistener.StatusJobResponse]
+	startJob      *connect_go.Client[contestlistener.StartJobRequest, contestlistener.StartJobResponse]
+	statusJob     *connect_go.Client[contestlistener.StatusJobRequest, contestlistener.StatusJobResponse]
+	streamEvents  *connect_go.Client[contestlistener.StreamEventsRequest, contestlistener.StreamEventsResponse]
+	scheduleJob   *connect_go.Client[contestlistener.ScheduleJobRequest, contestlistener.ScheduleJobResponse]
+	unscheduleJob *connect_go.Client[contestlistener.UnscheduleJobRequest, contestlistener.UnscheduleJobResponse]
+	listSchedules *connect_go.Client[contestlistener.ListSchedulesRequest, contestlistener.ListSchedulesResponse]
 }
 
 // StartJob calls contest.v1.ConTestService.StartJob.
@@ -70,10 +98,34 @@ func (c *conTestServiceClient) StatusJob(ctx context.Context, req *connect_go.Re
 	return c.statusJob.CallServerStream(ctx, req)
 }
 
+// StreamEvents calls contest.v1.ConTestService.StreamEvents.
+func (c *conTestServiceClient) StreamEvents(ctx context.Context, req *connect_go.Request[contestlistener.StreamEventsRequest]) (*connect_go.ServerStreamForClient[contestlistener.StreamEventsResponse], error) {
+	return c.streamEvents.CallServerStream(ctx, req)
+}
+
+// ScheduleJob calls contest.v1.ConTestService.ScheduleJob.
+func (c *conTestServiceClient) ScheduleJob(ctx context.Context, req *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error) {
+	return c.scheduleJob.CallUnary(ctx, req)
+}
+
+// UnscheduleJob calls contest.v1.ConTestService.UnscheduleJob.
+func (c *conTestServiceClient) UnscheduleJob(ctx context.Context, req *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error) {
+	return c.unscheduleJob.CallUnary(ctx, req)
+}
+
+// ListSchedules calls contest.v1.ConTestService.ListSchedules.
+func (c *conTestServiceClient) ListSchedules(ctx context.Context, req *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error) {
+	return c.listSchedules.CallUnary(ctx, req)
+}
+
 // ConTestServiceHandler is an implementation of the contest.v1.ConTestService service.
 type ConTestServiceHandler interface {
 	StartJob(context.Context, *connect_go.Request[contestlistener.StartJobRequest]) (*connect_go.Response[contestlistener.StartJobResponse], error)
 	StatusJob(context.Context, *connect_go.Request[contestlistener.StatusJobRequest], *connect_go.ServerStream[contestlistener.StatusJobResponse]) error
+	StreamEvents(context.Context, *connect_go.Request[contestlistener.StreamEventsRequest], *connect_go.ServerStream[contestlistener.StreamEventsResponse]) error
+	ScheduleJob(context.Context, *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error)
+	UnscheduleJob(context.Context, *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error)
+	ListSchedules(context.Context, *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error)
 }
 
 // NewConTestServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -93,6 +145,26 @@ func NewConTestServiceHandler(svc ConTestServiceHandler, opts ...connect_go.Hand
 		svc.StatusJob,
 		opts...,
 	))
+	mux.Handle("/contest.v1.ConTestService/StreamEvents", connect_go.NewServerStreamHandler(
+		"/contest.v1.ConTestService/StreamEvents",
+		svc.StreamEvents,
+		opts...,
+	))
+	mux.Handle("/contest.v1.ConTestService/ScheduleJob", connect_go.NewUnaryHandler(
+		"/contest.v1.ConTestService/ScheduleJob",
+		svc.ScheduleJob,
+		opts...,
+	))
+	mux.Handle("/contest.v1.ConTestService/UnscheduleJob", connect_go.NewUnaryHandler(
+		"/contest.v1.ConTestService/UnscheduleJob",
+		svc.UnscheduleJob,
+		opts...,
+	))
+	mux.Handle("/contest.v1.ConTestService/ListSchedules", connect_go.NewUnaryHandler(
+		"/contest.v1.ConTestService/ListSchedules",
+		svc.ListSchedules,
+		opts...,
+	))
 	return "/contest.v1.ConTestService/", mux
 }
 
@@ -106,3 +178,19 @@ func (UnimplementedConTestServiceHandler) StartJob(context.Context, *connect_go.
 func (UnimplementedConTestServiceHandler) StatusJob(context.Context, *connect_go.Request[contestlistener.StatusJobRequest], *connect_go.ServerStream[contestlistener.StatusJobResponse]) error {
 	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v1.ConTestService.StatusJob is not implemented"))
 }
+
+func (UnimplementedConTestServiceHandler) StreamEvents(context.Context, *connect_go.Request[contestlistener.StreamEventsRequest], *connect_go.ServerStream[contestlistener.StreamEventsResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v1.ConTestService.StreamEvents is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ScheduleJob(context.Context, *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v1.ConTestService.ScheduleJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) UnscheduleJob(context.Context, *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v1.ConTestService.UnscheduleJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ListSchedules(context.Context, *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v1.ConTestService.ListSchedules is not implemented"))
+}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: contest/v2/grpclistener.proto
+
+package contestlistenerconnect
+
+import (
+	contestlistener "github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v2"
+	context "context"
+	errors "errors"
+	connect_go "github.com/bufbuild/connect-go"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect_go.IsAtLeastVersion0_1_0
+
+const (
+	// ConTestServiceName is the fully-qualified name of the ConTestService service.
+	ConTestServiceName = "contest.v2.ConTestService"
+)
+
+// ConTestServiceClient is a client for the contest.v2.ConTestService service.
+type ConTestServiceClient interface {
+	Version(context.Context, *connect_go.Request[contestlistener.VersionRequest]) (*connect_go.Response[contestlistener.VersionResponse], error)
+	StartJob(context.Context, *connect_go.Request[contestlistener.StartJobRequest]) (*connect_go.Response[contestlistener.StartJobResponse], error)
+	StopJob(context.Context, *connect_go.Request[contestlistener.StopJobRequest]) (*connect_go.Response[contestlistener.StopJobResponse], error)
+	StatusJob(context.Context, *connect_go.Request[contestlistener.StatusJobRequest]) (*connect_go.Response[contestlistener.StatusJobResponse], error)
+	WatchJob(context.Context, *connect_go.Request[contestlistener.WatchJobRequest]) (*connect_go.ServerStreamForClient[contestlistener.WatchJobResponse], error)
+	RetryJob(context.Context, *connect_go.Request[contestlistener.RetryJobRequest]) (*connect_go.Response[contestlistener.RetryJobResponse], error)
+	ListJobs(context.Context, *connect_go.Request[contestlistener.ListJobsRequest]) (*connect_go.Response[contestlistener.ListJobsResponse], error)
+	ScheduleJob(context.Context, *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error)
+	UnscheduleJob(context.Context, *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error)
+	ListSchedules(context.Context, *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error)
+	JUnit(context.Context, *connect_go.Request[contestlistener.JUnitRequest]) (*connect_go.Response[contestlistener.JUnitResponse], error)
+	StreamEvents(context.Context, *connect_go.Request[contestlistener.StreamEventsRequest]) (*connect_go.ServerStreamForClient[contestlistener.StreamEventsResponse], error)
+	QueryEvents(context.Context, *connect_go.Request[contestlistener.QueryEventsRequest]) (*connect_go.Response[contestlistener.QueryEventsResponse], error)
+	PauseJob(context.Context, *connect_go.Request[contestlistener.PauseJobRequest]) (*connect_go.Response[contestlistener.PauseJobResponse], error)
+	ResumeJob(context.Context, *connect_go.Request[contestlistener.ResumeJobRequest]) (*connect_go.Response[contestlistener.ResumeJobResponse], error)
+	ValidateJob(context.Context, *connect_go.Request[contestlistener.ValidateJobRequest]) (*connect_go.Response[contestlistener.ValidateJobResponse], error)
+	GetArtifact(context.Context, *connect_go.Request[contestlistener.GetArtifactRequest]) (*connect_go.Response[contestlistener.GetArtifactResponse], error)
+	GetJobLogs(context.Context, *connect_go.Request[contestlistener.GetJobLogsRequest]) (*connect_go.Response[contestlistener.GetJobLogsResponse], error)
+	AddTarget(context.Context, *connect_go.Request[contestlistener.AddTargetRequest]) (*connect_go.Response[contestlistener.AddTargetResponse], error)
+	UpdateTarget(context.Context, *connect_go.Request[contestlistener.UpdateTargetRequest]) (*connect_go.Response[contestlistener.UpdateTargetResponse], error)
+	RemoveTarget(context.Context, *connect_go.Request[contestlistener.RemoveTargetRequest]) (*connect_go.Response[contestlistener.RemoveTargetResponse], error)
+	ListTargets(context.Context, *connect_go.Request[contestlistener.ListTargetsRequest]) (*connect_go.Response[contestlistener.ListTargetsResponse], error)
+	QuarantineTarget(context.Context, *connect_go.Request[contestlistener.QuarantineTargetRequest]) (*connect_go.Response[contestlistener.QuarantineTargetResponse], error)
+	ReleaseTarget(context.Context, *connect_go.Request[contestlistener.ReleaseTargetRequest]) (*connect_go.Response[contestlistener.ReleaseTargetResponse], error)
+	ListQuarantinedTargets(context.Context, *connect_go.Request[contestlistener.ListQuarantinedTargetsRequest]) (*connect_go.Response[contestlistener.ListQuarantinedTargetsResponse], error)
+	ListLocks(context.Context, *connect_go.Request[contestlistener.ListLocksRequest]) (*connect_go.Response[contestlistener.ListLocksResponse], error)
+	ForceUnlock(context.Context, *connect_go.Request[contestlistener.ForceUnlockRequest]) (*connect_go.Response[contestlistener.ForceUnlockResponse], error)
+}
+
+// NewConTestServiceClient constructs a client for the contest.v2.ConTestService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewConTestServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ConTestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &conTestServiceClient{
+		version: connect_go.NewClient[contestlistener.VersionRequest, contestlistener.VersionResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/Version",
+			opts...,
+		),
+		startJob: connect_go.NewClient[contestlistener.StartJobRequest, contestlistener.StartJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/StartJob",
+			opts...,
+		),
+		stopJob: connect_go.NewClient[contestlistener.StopJobRequest, contestlistener.StopJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/StopJob",
+			opts...,
+		),
+		statusJob: connect_go.NewClient[contestlistener.StatusJobRequest, contestlistener.StatusJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/StatusJob",
+			opts...,
+		),
+		watchJob: connect_go.NewClient[contestlistener.WatchJobRequest, contestlistener.WatchJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/WatchJob",
+			opts...,
+		),
+		retryJob: connect_go.NewClient[contestlistener.RetryJobRequest, contestlistener.RetryJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/RetryJob",
+			opts...,
+		),
+		listJobs: connect_go.NewClient[contestlistener.ListJobsRequest, contestlistener.ListJobsResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ListJobs",
+			opts...,
+		),
+		scheduleJob: connect_go.NewClient[contestlistener.ScheduleJobRequest, contestlistener.ScheduleJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ScheduleJob",
+			opts...,
+		),
+		unscheduleJob: connect_go.NewClient[contestlistener.UnscheduleJobRequest, contestlistener.UnscheduleJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/UnscheduleJob",
+			opts...,
+		),
+		listSchedules: connect_go.NewClient[contestlistener.ListSchedulesRequest, contestlistener.ListSchedulesResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ListSchedules",
+			opts...,
+		),
+		jUnit: connect_go.NewClient[contestlistener.JUnitRequest, contestlistener.JUnitResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/JUnit",
+			opts...,
+		),
+		streamEvents: connect_go.NewClient[contestlistener.StreamEventsRequest, contestlistener.StreamEventsResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/StreamEvents",
+			opts...,
+		),
+		queryEvents: connect_go.NewClient[contestlistener.QueryEventsRequest, contestlistener.QueryEventsResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/QueryEvents",
+			opts...,
+		),
+		pauseJob: connect_go.NewClient[contestlistener.PauseJobRequest, contestlistener.PauseJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/PauseJob",
+			opts...,
+		),
+		resumeJob: connect_go.NewClient[contestlistener.ResumeJobRequest, contestlistener.ResumeJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ResumeJob",
+			opts...,
+		),
+		validateJob: connect_go.NewClient[contestlistener.ValidateJobRequest, contestlistener.ValidateJobResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ValidateJob",
+			opts...,
+		),
+		getArtifact: connect_go.NewClient[contestlistener.GetArtifactRequest, contestlistener.GetArtifactResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/GetArtifact",
+			opts...,
+		),
+		getJobLogs: connect_go.NewClient[contestlistener.GetJobLogsRequest, contestlistener.GetJobLogsResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/GetJobLogs",
+			opts...,
+		),
+		addTarget: connect_go.NewClient[contestlistener.AddTargetRequest, contestlistener.AddTargetResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/AddTarget",
+			opts...,
+		),
+		updateTarget: connect_go.NewClient[contestlistener.UpdateTargetRequest, contestlistener.UpdateTargetResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/UpdateTarget",
+			opts...,
+		),
+		removeTarget: connect_go.NewClient[contestlistener.RemoveTargetRequest, contestlistener.RemoveTargetResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/RemoveTarget",
+			opts...,
+		),
+		listTargets: connect_go.NewClient[contestlistener.ListTargetsRequest, contestlistener.ListTargetsResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ListTargets",
+			opts...,
+		),
+		quarantineTarget: connect_go.NewClient[contestlistener.QuarantineTargetRequest, contestlistener.QuarantineTargetResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/QuarantineTarget",
+			opts...,
+		),
+		releaseTarget: connect_go.NewClient[contestlistener.ReleaseTargetRequest, contestlistener.ReleaseTargetResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ReleaseTarget",
+			opts...,
+		),
+		listQuarantinedTargets: connect_go.NewClient[contestlistener.ListQuarantinedTargetsRequest, contestlistener.ListQuarantinedTargetsResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ListQuarantinedTargets",
+			opts...,
+		),
+		listLocks: connect_go.NewClient[contestlistener.ListLocksRequest, contestlistener.ListLocksResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ListLocks",
+			opts...,
+		),
+		forceUnlock: connect_go.NewClient[contestlistener.ForceUnlockRequest, contestlistener.ForceUnlockResponse](
+			httpClient,
+			baseURL+"/contest.v2.ConTestService/ForceUnlock",
+			opts...,
+		),
+	}
+}
+
+// conTestServiceClient implements ConTestServiceClient.
+type conTestServiceClient struct {
+	version                *connect_go.Client[contestlistener.VersionRequest, contestlistener.VersionResponse]
+	startJob               *connect_go.Client[contestlistener.StartJobRequest, contestlistener.StartJobResponse]
+	stopJob                *connect_go.Client[contestlistener.StopJobRequest, contestlistener.StopJobResponse]
+	statusJob              *connect_go.Client[contestlistener.StatusJobRequest, contestlistener.StatusJobResponse]
+	watchJob               *connect_go.Client[contestlistener.WatchJobRequest, contestlistener.WatchJobResponse]
+	retryJob               *connect_go.Client[contestlistener.RetryJobRequest, contestlistener.RetryJobResponse]
+	listJobs               *connect_go.Client[contestlistener.ListJobsRequest, contestlistener.ListJobsResponse]
+	scheduleJob            *connect_go.Client[contestlistener.ScheduleJobRequest, contestlistener.ScheduleJobResponse]
+	unscheduleJob          *connect_go.Client[contestlistener.UnscheduleJobRequest, contestlistener.UnscheduleJobResponse]
+	listSchedules          *connect_go.Client[contestlistener.ListSchedulesRequest, contestlistener.ListSchedulesResponse]
+	jUnit                  *connect_go.Client[contestlistener.JUnitRequest, contestlistener.JUnitResponse]
+	streamEvents           *connect_go.Client[contestlistener.StreamEventsRequest, contestlistener.StreamEventsResponse]
+	queryEvents            *connect_go.Client[contestlistener.QueryEventsRequest, contestlistener.QueryEventsResponse]
+	pauseJob               *connect_go.Client[contestlistener.PauseJobRequest, contestlistener.PauseJobResponse]
+	resumeJob              *connect_go.Client[contestlistener.ResumeJobRequest, contestlistener.ResumeJobResponse]
+	validateJob            *connect_go.Client[contestlistener.ValidateJobRequest, contestlistener.ValidateJobResponse]
+	getArtifact            *connect_go.Client[contestlistener.GetArtifactRequest, contestlistener.GetArtifactResponse]
+	getJobLogs             *connect_go.Client[contestlistener.GetJobLogsRequest, contestlistener.GetJobLogsResponse]
+	addTarget              *connect_go.Client[contestlistener.AddTargetRequest, contestlistener.AddTargetResponse]
+	updateTarget           *connect_go.Client[contestlistener.UpdateTargetRequest, contestlistener.UpdateTargetResponse]
+	removeTarget           *connect_go.Client[contestlistener.RemoveTargetRequest, contestlistener.RemoveTargetResponse]
+	listTargets            *connect_go.Client[contestlistener.ListTargetsRequest, contestlistener.ListTargetsResponse]
+	quarantineTarget       *connect_go.Client[contestlistener.QuarantineTargetRequest, contestlistener.QuarantineTargetResponse]
+	releaseTarget          *connect_go.Client[contestlistener.ReleaseTargetRequest, contestlistener.ReleaseTargetResponse]
+	listQuarantinedTargets *connect_go.Client[contestlistener.ListQuarantinedTargetsRequest, contestlistener.ListQuarantinedTargetsResponse]
+	listLocks              *connect_go.Client[contestlistener.ListLocksRequest, contestlistener.ListLocksResponse]
+	forceUnlock            *connect_go.Client[contestlistener.ForceUnlockRequest, contestlistener.ForceUnlockResponse]
+}
+
+// Version calls contest.v2.ConTestService.Version.
+func (c *conTestServiceClient) Version(ctx context.Context, req *connect_go.Request[contestlistener.VersionRequest]) (*connect_go.Response[contestlistener.VersionResponse], error) {
+	return c.version.CallUnary(ctx, req)
+}
+
+// StartJob calls contest.v2.ConTestService.StartJob.
+func (c *conTestServiceClient) StartJob(ctx context.Context, req *connect_go.Request[contestlistener.StartJobRequest]) (*connect_go.Response[contestlistener.StartJobResponse], error) {
+	return c.startJob.CallUnary(ctx, req)
+}
+
+// StopJob calls contest.v2.ConTestService.StopJob.
+func (c *conTestServiceClient) StopJob(ctx context.Context, req *connect_go.Request[contestlistener.StopJobRequest]) (*connect_go.Response[contestlistener.StopJobResponse], error) {
+	return c.stopJob.CallUnary(ctx, req)
+}
+
+// StatusJob calls contest.v2.ConTestService.StatusJob.
+func (c *conTestServiceClient) StatusJob(ctx context.Context, req *connect_go.Request[contestlistener.StatusJobRequest]) (*connect_go.Response[contestlistener.StatusJobResponse], error) {
+	return c.statusJob.CallUnary(ctx, req)
+}
+
+// WatchJob calls contest.v2.ConTestService.WatchJob.
+func (c *conTestServiceClient) WatchJob(ctx context.Context, req *connect_go.Request[contestlistener.WatchJobRequest]) (*connect_go.ServerStreamForClient[contestlistener.WatchJobResponse], error) {
+	return c.watchJob.CallServerStream(ctx, req)
+}
+
+// RetryJob calls contest.v2.ConTestService.RetryJob.
+func (c *conTestServiceClient) RetryJob(ctx context.Context, req *connect_go.Request[contestlistener.RetryJobRequest]) (*connect_go.Response[contestlistener.RetryJobResponse], error) {
+	return c.retryJob.CallUnary(ctx, req)
+}
+
+// ListJobs calls contest.v2.ConTestService.ListJobs.
+func (c *conTestServiceClient) ListJobs(ctx context.Context, req *connect_go.Request[contestlistener.ListJobsRequest]) (*connect_go.Response[contestlistener.ListJobsResponse], error) {
+	return c.listJobs.CallUnary(ctx, req)
+}
+
+// ScheduleJob calls contest.v2.ConTestService.ScheduleJob.
+func (c *conTestServiceClient) ScheduleJob(ctx context.Context, req *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error) {
+	return c.scheduleJob.CallUnary(ctx, req)
+}
+
+// UnscheduleJob calls contest.v2.ConTestService.UnscheduleJob.
+func (c *conTestServiceClient) UnscheduleJob(ctx context.Context, req *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error) {
+	return c.unscheduleJob.CallUnary(ctx, req)
+}
+
+// ListSchedules calls contest.v2.ConTestService.ListSchedules.
+func (c *conTestServiceClient) ListSchedules(ctx context.Context, req *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error) {
+	return c.listSchedules.CallUnary(ctx, req)
+}
+
+// JUnit calls contest.v2.ConTestService.JUnit.
+func (c *conTestServiceClient) JUnit(ctx context.Context, req *connect_go.Request[contestlistener.JUnitRequest]) (*connect_go.Response[contestlistener.JUnitResponse], error) {
+	return c.jUnit.CallUnary(ctx, req)
+}
+
+// StreamEvents calls contest.v2.ConTestService.StreamEvents.
+func (c *conTestServiceClient) StreamEvents(ctx context.Context, req *connect_go.Request[contestlistener.StreamEventsRequest]) (*connect_go.ServerStreamForClient[contestlistener.StreamEventsResponse], error) {
+	return c.streamEvents.CallServerStream(ctx, req)
+}
+
+// QueryEvents calls contest.v2.ConTestService.QueryEvents.
+func (c *conTestServiceClient) QueryEvents(ctx context.Context, req *connect_go.Request[contestlistener.QueryEventsRequest]) (*connect_go.Response[contestlistener.QueryEventsResponse], error) {
+	return c.queryEvents.CallUnary(ctx, req)
+}
+
+// PauseJob calls contest.v2.ConTestService.PauseJob.
+func (c *conTestServiceClient) PauseJob(ctx context.Context, req *connect_go.Request[contestlistener.PauseJobRequest]) (*connect_go.Response[contestlistener.PauseJobResponse], error) {
+	return c.pauseJob.CallUnary(ctx, req)
+}
+
+// ResumeJob calls contest.v2.ConTestService.ResumeJob.
+func (c *conTestServiceClient) ResumeJob(ctx context.Context, req *connect_go.Request[contestlistener.ResumeJobRequest]) (*connect_go.Response[contestlistener.ResumeJobResponse], error) {
+	return c.resumeJob.CallUnary(ctx, req)
+}
+
+// ValidateJob calls contest.v2.ConTestService.ValidateJob.
+func (c *conTestServiceClient) ValidateJob(ctx context.Context, req *connect_go.Request[contestlistener.ValidateJobRequest]) (*connect_go.Response[contestlistener.ValidateJobResponse], error) {
+	return c.validateJob.CallUnary(ctx, req)
+}
+
+// GetArtifact calls contest.v2.ConTestService.GetArtifact.
+func (c *conTestServiceClient) GetArtifact(ctx context.Context, req *connect_go.Request[contestlistener.GetArtifactRequest]) (*connect_go.Response[contestlistener.GetArtifactResponse], error) {
+	return c.getArtifact.CallUnary(ctx, req)
+}
+
+// GetJobLogs calls contest.v2.ConTestService.GetJobLogs.
+func (c *conTestServiceClient) GetJobLogs(ctx context.Context, req *connect_go.Request[contestlistener.GetJobLogsRequest]) (*connect_go.Response[contestlistener.GetJobLogsResponse], error) {
+	return c.getJobLogs.CallUnary(ctx, req)
+}
+
+// AddTarget calls contest.v2.ConTestService.AddTarget.
+func (c *conTestServiceClient) AddTarget(ctx context.Context, req *connect_go.Request[contestlistener.AddTargetRequest]) (*connect_go.Response[contestlistener.AddTargetResponse], error) {
+	return c.addTarget.CallUnary(ctx, req)
+}
+
+// UpdateTarget calls contest.v2.ConTestService.UpdateTarget.
+func (c *conTestServiceClient) UpdateTarget(ctx context.Context, req *connect_go.Request[contestlistener.UpdateTargetRequest]) (*connect_go.Response[contestlistener.UpdateTargetResponse], error) {
+	return c.updateTarget.CallUnary(ctx, req)
+}
+
+// RemoveTarget calls contest.v2.ConTestService.RemoveTarget.
+func (c *conTestServiceClient) RemoveTarget(ctx context.Context, req *connect_go.Request[contestlistener.RemoveTargetRequest]) (*connect_go.Response[contestlistener.RemoveTargetResponse], error) {
+	return c.removeTarget.CallUnary(ctx, req)
+}
+
+// ListTargets calls contest.v2.ConTestService.ListTargets.
+func (c *conTestServiceClient) ListTargets(ctx context.Context, req *connect_go.Request[contestlistener.ListTargetsRequest]) (*connect_go.Response[contestlistener.ListTargetsResponse], error) {
+	return c.listTargets.CallUnary(ctx, req)
+}
+
+// QuarantineTarget calls contest.v2.ConTestService.QuarantineTarget.
+func (c *conTestServiceClient) QuarantineTarget(ctx context.Context, req *connect_go.Request[contestlistener.QuarantineTargetRequest]) (*connect_go.Response[contestlistener.QuarantineTargetResponse], error) {
+	return c.quarantineTarget.CallUnary(ctx, req)
+}
+
+// ReleaseTarget calls contest.v2.ConTestService.ReleaseTarget.
+func (c *conTestServiceClient) ReleaseTarget(ctx context.Context, req *connect_go.Request[contestlistener.ReleaseTargetRequest]) (*connect_go.Response[contestlistener.ReleaseTargetResponse], error) {
+	return c.releaseTarget.CallUnary(ctx, req)
+}
+
+// ListQuarantinedTargets calls contest.v2.ConTestService.ListQuarantinedTargets.
+func (c *conTestServiceClient) ListQuarantinedTargets(ctx context.Context, req *connect_go.Request[contestlistener.ListQuarantinedTargetsRequest]) (*connect_go.Response[contestlistener.ListQuarantinedTargetsResponse], error) {
+	return c.listQuarantinedTargets.CallUnary(ctx, req)
+}
+
+// ListLocks calls contest.v2.ConTestService.ListLocks.
+func (c *conTestServiceClient) ListLocks(ctx context.Context, req *connect_go.Request[contestlistener.ListLocksRequest]) (*connect_go.Response[contestlistener.ListLocksResponse], error) {
+	return c.listLocks.CallUnary(ctx, req)
+}
+
+// ForceUnlock calls contest.v2.ConTestService.ForceUnlock.
+func (c *conTestServiceClient) ForceUnlock(ctx context.Context, req *connect_go.Request[contestlistener.ForceUnlockRequest]) (*connect_go.Response[contestlistener.ForceUnlockResponse], error) {
+	return c.forceUnlock.CallUnary(ctx, req)
+}
+
+// ConTestServiceHandler is an implementation of the contest.v2.ConTestService service.
+type ConTestServiceHandler interface {
+	Version(context.Context, *connect_go.Request[contestlistener.VersionRequest]) (*connect_go.Response[contestlistener.VersionResponse], error)
+	StartJob(context.Context, *connect_go.Request[contestlistener.StartJobRequest]) (*connect_go.Response[contestlistener.StartJobResponse], error)
+	StopJob(context.Context, *connect_go.Request[contestlistener.StopJobRequest]) (*connect_go.Response[contestlistener.StopJobResponse], error)
+	StatusJob(context.Context, *connect_go.Request[contestlistener.StatusJobRequest]) (*connect_go.Response[contestlistener.StatusJobResponse], error)
+	WatchJob(context.Context, *connect_go.Request[contestlistener.WatchJobRequest], *connect_go.ServerStream[contestlistener.WatchJobResponse]) error
+	RetryJob(context.Context, *connect_go.Request[contestlistener.RetryJobRequest]) (*connect_go.Response[contestlistener.RetryJobResponse], error)
+	ListJobs(context.Context, *connect_go.Request[contestlistener.ListJobsRequest]) (*connect_go.Response[contestlistener.ListJobsResponse], error)
+	ScheduleJob(context.Context, *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error)
+	UnscheduleJob(context.Context, *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error)
+	ListSchedules(context.Context, *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error)
+	JUnit(context.Context, *connect_go.Request[contestlistener.JUnitRequest]) (*connect_go.Response[contestlistener.JUnitResponse], error)
+	StreamEvents(context.Context, *connect_go.Request[contestlistener.StreamEventsRequest], *connect_go.ServerStream[contestlistener.StreamEventsResponse]) error
+	QueryEvents(context.Context, *connect_go.Request[contestlistener.QueryEventsRequest]) (*connect_go.Response[contestlistener.QueryEventsResponse], error)
+	PauseJob(context.Context, *connect_go.Request[contestlistener.PauseJobRequest]) (*connect_go.Response[contestlistener.PauseJobResponse], error)
+	ResumeJob(context.Context, *connect_go.Request[contestlistener.ResumeJobRequest]) (*connect_go.Response[contestlistener.ResumeJobResponse], error)
+	ValidateJob(context.Context, *connect_go.Request[contestlistener.ValidateJobRequest]) (*connect_go.Response[contestlistener.ValidateJobResponse], error)
+	GetArtifact(context.Context, *connect_go.Request[contestlistener.GetArtifactRequest]) (*connect_go.Response[contestlistener.GetArtifactResponse], error)
+	GetJobLogs(context.Context, *connect_go.Request[contestlistener.GetJobLogsRequest]) (*connect_go.Response[contestlistener.GetJobLogsResponse], error)
+	AddTarget(context.Context, *connect_go.Request[contestlistener.AddTargetRequest]) (*connect_go.Response[contestlistener.AddTargetResponse], error)
+	UpdateTarget(context.Context, *connect_go.Request[contestlistener.UpdateTargetRequest]) (*connect_go.Response[contestlistener.UpdateTargetResponse], error)
+	RemoveTarget(context.Context, *connect_go.Request[contestlistener.RemoveTargetRequest]) (*connect_go.Response[contestlistener.RemoveTargetResponse], error)
+	ListTargets(context.Context, *connect_go.Request[contestlistener.ListTargetsRequest]) (*connect_go.Response[contestlistener.ListTargetsResponse], error)
+	QuarantineTarget(context.Context, *connect_go.Request[contestlistener.QuarantineTargetRequest]) (*connect_go.Response[contestlistener.QuarantineTargetResponse], error)
+	ReleaseTarget(context.Context, *connect_go.Request[contestlistener.ReleaseTargetRequest]) (*connect_go.Response[contestlistener.ReleaseTargetResponse], error)
+	ListQuarantinedTargets(context.Context, *connect_go.Request[contestlistener.ListQuarantinedTargetsRequest]) (*connect_go.Response[contestlistener.ListQuarantinedTargetsResponse], error)
+	ListLocks(context.Context, *connect_go.Request[contestlistener.ListLocksRequest]) (*connect_go.Response[contestlistener.ListLocksResponse], error)
+	ForceUnlock(context.Context, *connect_go.Request[contestlistener.ForceUnlockRequest]) (*connect_go.Response[contestlistener.ForceUnlockResponse], error)
+}
+
+// NewConTestServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewConTestServiceHandler(svc ConTestServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/contest.v2.ConTestService/Version", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/Version",
+		svc.Version,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/StartJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/StartJob",
+		svc.StartJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/StopJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/StopJob",
+		svc.StopJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/StatusJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/StatusJob",
+		svc.StatusJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/WatchJob", connect_go.NewServerStreamHandler(
+		"/contest.v2.ConTestService/WatchJob",
+		svc.WatchJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/RetryJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/RetryJob",
+		svc.RetryJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ListJobs", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ListJobs",
+		svc.ListJobs,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ScheduleJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ScheduleJob",
+		svc.ScheduleJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/UnscheduleJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/UnscheduleJob",
+		svc.UnscheduleJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ListSchedules", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ListSchedules",
+		svc.ListSchedules,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/JUnit", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/JUnit",
+		svc.JUnit,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/StreamEvents", connect_go.NewServerStreamHandler(
+		"/contest.v2.ConTestService/StreamEvents",
+		svc.StreamEvents,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/QueryEvents", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/QueryEvents",
+		svc.QueryEvents,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/PauseJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/PauseJob",
+		svc.PauseJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ResumeJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ResumeJob",
+		svc.ResumeJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ValidateJob", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ValidateJob",
+		svc.ValidateJob,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/GetArtifact", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/GetArtifact",
+		svc.GetArtifact,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/GetJobLogs", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/GetJobLogs",
+		svc.GetJobLogs,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/AddTarget", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/AddTarget",
+		svc.AddTarget,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/UpdateTarget", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/UpdateTarget",
+		svc.UpdateTarget,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/RemoveTarget", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/RemoveTarget",
+		svc.RemoveTarget,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ListTargets", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ListTargets",
+		svc.ListTargets,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/QuarantineTarget", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/QuarantineTarget",
+		svc.QuarantineTarget,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ReleaseTarget", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ReleaseTarget",
+		svc.ReleaseTarget,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ListQuarantinedTargets", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ListQuarantinedTargets",
+		svc.ListQuarantinedTargets,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ListLocks", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ListLocks",
+		svc.ListLocks,
+		opts...,
+	))
+	mux.Handle("/contest.v2.ConTestService/ForceUnlock", connect_go.NewUnaryHandler(
+		"/contest.v2.ConTestService/ForceUnlock",
+		svc.ForceUnlock,
+		opts...,
+	))
+	return "/contest.v2.ConTestService/", mux
+}
+
+// UnimplementedConTestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedConTestServiceHandler struct{}
+
+func (UnimplementedConTestServiceHandler) Version(context.Context, *connect_go.Request[contestlistener.VersionRequest]) (*connect_go.Response[contestlistener.VersionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.Version is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) StartJob(context.Context, *connect_go.Request[contestlistener.StartJobRequest]) (*connect_go.Response[contestlistener.StartJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.StartJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) StopJob(context.Context, *connect_go.Request[contestlistener.StopJobRequest]) (*connect_go.Response[contestlistener.StopJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.StopJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) StatusJob(context.Context, *connect_go.Request[contestlistener.StatusJobRequest]) (*connect_go.Response[contestlistener.StatusJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.StatusJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) WatchJob(context.Context, *connect_go.Request[contestlistener.WatchJobRequest], *connect_go.ServerStream[contestlistener.WatchJobResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.WatchJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) RetryJob(context.Context, *connect_go.Request[contestlistener.RetryJobRequest]) (*connect_go.Response[contestlistener.RetryJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.RetryJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ListJobs(context.Context, *connect_go.Request[contestlistener.ListJobsRequest]) (*connect_go.Response[contestlistener.ListJobsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ListJobs is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ScheduleJob(context.Context, *connect_go.Request[contestlistener.ScheduleJobRequest]) (*connect_go.Response[contestlistener.ScheduleJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ScheduleJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) UnscheduleJob(context.Context, *connect_go.Request[contestlistener.UnscheduleJobRequest]) (*connect_go.Response[contestlistener.UnscheduleJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.UnscheduleJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ListSchedules(context.Context, *connect_go.Request[contestlistener.ListSchedulesRequest]) (*connect_go.Response[contestlistener.ListSchedulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ListSchedules is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) JUnit(context.Context, *connect_go.Request[contestlistener.JUnitRequest]) (*connect_go.Response[contestlistener.JUnitResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.JUnit is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) StreamEvents(context.Context, *connect_go.Request[contestlistener.StreamEventsRequest], *connect_go.ServerStream[contestlistener.StreamEventsResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.StreamEvents is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) QueryEvents(context.Context, *connect_go.Request[contestlistener.QueryEventsRequest]) (*connect_go.Response[contestlistener.QueryEventsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.QueryEvents is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) PauseJob(context.Context, *connect_go.Request[contestlistener.PauseJobRequest]) (*connect_go.Response[contestlistener.PauseJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.PauseJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ResumeJob(context.Context, *connect_go.Request[contestlistener.ResumeJobRequest]) (*connect_go.Response[contestlistener.ResumeJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ResumeJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ValidateJob(context.Context, *connect_go.Request[contestlistener.ValidateJobRequest]) (*connect_go.Response[contestlistener.ValidateJobResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ValidateJob is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) GetArtifact(context.Context, *connect_go.Request[contestlistener.GetArtifactRequest]) (*connect_go.Response[contestlistener.GetArtifactResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.GetArtifact is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) GetJobLogs(context.Context, *connect_go.Request[contestlistener.GetJobLogsRequest]) (*connect_go.Response[contestlistener.GetJobLogsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.GetJobLogs is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) AddTarget(context.Context, *connect_go.Request[contestlistener.AddTargetRequest]) (*connect_go.Response[contestlistener.AddTargetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.AddTarget is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) UpdateTarget(context.Context, *connect_go.Request[contestlistener.UpdateTargetRequest]) (*connect_go.Response[contestlistener.UpdateTargetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.UpdateTarget is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) RemoveTarget(context.Context, *connect_go.Request[contestlistener.RemoveTargetRequest]) (*connect_go.Response[contestlistener.RemoveTargetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.RemoveTarget is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ListTargets(context.Context, *connect_go.Request[contestlistener.ListTargetsRequest]) (*connect_go.Response[contestlistener.ListTargetsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ListTargets is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) QuarantineTarget(context.Context, *connect_go.Request[contestlistener.QuarantineTargetRequest]) (*connect_go.Response[contestlistener.QuarantineTargetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.QuarantineTarget is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ReleaseTarget(context.Context, *connect_go.Request[contestlistener.ReleaseTargetRequest]) (*connect_go.Response[contestlistener.ReleaseTargetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ReleaseTarget is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ListQuarantinedTargets(context.Context, *connect_go.Request[contestlistener.ListQuarantinedTargetsRequest]) (*connect_go.Response[contestlistener.ListQuarantinedTargetsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ListQuarantinedTargets is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ListLocks(context.Context, *connect_go.Request[contestlistener.ListLocksRequest]) (*connect_go.Response[contestlistener.ListLocksResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ListLocks is not implemented"))
+}
+
+func (UnimplementedConTestServiceHandler) ForceUnlock(context.Context, *connect_go.Request[contestlistener.ForceUnlockRequest]) (*connect_go.Response[contestlistener.ForceUnlockResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contest.v2.ConTestService.ForceUnlock is not implemented"))
+}
@@ -1,7 +1,9 @@
 package grpclistener
 
 import (
+	"bytes"
 	context "context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,11 +13,16 @@ import (
 	grpcreflect "github.com/bufbuild/connect-grpcreflect-go"
 	"github.com/linuxboot/contest/pkg/api"
 	"github.com/linuxboot/contest/pkg/buffer"
+	"github.com/linuxboot/contest/pkg/event"
+	eventstream "github.com/linuxboot/contest/pkg/event/stream"
 	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/runner"
+	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 	contestlistener "github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v1"
 	"github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v1/contestlistenerconnect"
+	contestlistenerconnectv2 "github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v2/contestlistenerconnect"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
@@ -28,6 +35,20 @@ type Endpoint struct {
 
 type GRPCListener struct {
 	listenAddr string
+	tlsConfig  *tls.Config
+}
+
+// Option is an additional argument to New to change the behavior of the
+// listener.
+type Option func(*GRPCListener)
+
+// WithTLSConfig serves the API over TLS with the given configuration, which
+// must contain the server certificate. To authenticate the requestors with
+// client certificates, it has to require and verify them as well.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(grpcl *GRPCListener) {
+		grpcl.tlsConfig = cfg
+	}
 }
 
 type GRPCServer struct {
@@ -36,10 +57,12 @@ type GRPCServer struct {
 	Endpoints map[int]*Endpoint
 }
 
-var waitForUpdate = 5 * time.Second
-
-func New(listenAddr string) *GRPCListener {
-	return &GRPCListener{listenAddr: listenAddr}
+func New(listenAddr string, opts ...Option) *GRPCListener {
+	grpcl := &GRPCListener{listenAddr: listenAddr}
+	for _, opt := range opts {
+		opt(grpcl)
+	}
+	return grpcl
 }
 
 func (grpcl *GRPCListener) Serve(ctx xcontext.Context, a *api.API) error {
@@ -50,6 +73,7 @@ func (grpcl *GRPCListener) Serve(ctx xcontext.Context, a *api.API) error {
 	// Add reflection API
 	reflector := grpcreflect.NewStaticReflector(
 		"contest.v1.ConTestService",
+		"contest.v2.ConTestService",
 	)
 
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
@@ -61,24 +85,40 @@ func (grpcl *GRPCListener) Serve(ctx xcontext.Context, a *api.API) error {
 		api:       a,
 		Endpoints: make(map[int]*Endpoint),
 	}))
+	mux.Handle(contestlistenerconnectv2.NewConTestServiceHandler(&GRPCServerV2{
+		ctx: ctx,
+		api: a,
+	}))
 
+	// Without TLS, HTTP/2 has to be served in cleartext.
+	handler := api.CredentialsHandler(mux)
+	if grpcl.tlsConfig == nil {
+		handler = h2c.NewHandler(handler, &http2.Server{})
+	}
+	srv := &http.Server{
+		Addr:      grpcl.listenAddr,
+		Handler:   handler,
+		TLSConfig: grpcl.tlsConfig,
+	}
 	errCh := make(chan error, 1)
 	// start the listener asynchronously, and report errors and completion via
 	// channels.
 	go func() {
-		errCh <- http.ListenAndServe(
-			":8080",
-			h2c.NewHandler(mux, &http2.Server{}),
-		)
+		if srv.TLSConfig != nil {
+			// The certificates are in the TLS configuration.
+			errCh <- srv.ListenAndServeTLS("", "")
+			return
+		}
+		errCh <- srv.ListenAndServe()
 	}()
-	ctx.Infof("Started GRPC API listener on :8080")
+	ctx.Infof("Started GRPC API listener on %s", grpcl.listenAddr)
 	// wait for cancellation or for completion
 	select {
 	case err := <-errCh:
 		return err
 	case <-ctx.Done():
 		ctx.Debugf("Received server shut down request")
-		return nil
+		return srv.Close()
 	}
 }
 
@@ -103,7 +143,7 @@ func (s *GRPCServer) StartJob(ctx context.Context, req *connect.Request[contestl
 		}), fmt.Errorf("Requestor is not set")
 	}
 
-	resp, err := s.api.Start(s.ctx, api.EventRequestor(req.Msg.Requestor), string(req.Msg.Job))
+	resp, err := s.api.Start(xcontext.WithStdContext(s.ctx, ctx), api.EventRequestor(req.Msg.Requestor), string(req.Msg.Job))
 	if err != nil {
 		return connect.NewResponse(&contestlistener.StartJobResponse{
 			JobId: 0,
@@ -133,6 +173,19 @@ func (s *GRPCServer) StartJob(ctx context.Context, req *connect.Request[contestl
 	}), nil
 }
 
+// statusEvents are the events which change the status of a job: its state,
+// its runs and where its targets are. StatusJob does not need the others,
+// e.g. the output of the steps.
+var statusEvents = append([]event.Name{
+	runner.EventRunStarted,
+	target.EventTargetAcquired,
+	target.EventTargetAcquireErr,
+	target.EventTargetIn,
+	target.EventTargetInErr,
+	target.EventTargetOut,
+	target.EventTargetErr,
+}, job.JobStateEvents...)
+
 func (s *GRPCServer) StatusJob(ctx context.Context, req *connect.Request[contestlistener.StatusJobRequest], stream *connect.ServerStream[contestlistener.StatusJobResponse]) error {
 	if req.Msg.Requestor == "" {
 		s.ctx.Errorf("Requestor is not set")
@@ -146,9 +199,40 @@ func (s *GRPCServer) StatusJob(ctx context.Context, req *connect.Request[contest
 		return fmt.Errorf("JobID does not exist.")
 	}
 
+	subCtx, cancel := xcontext.WithCancel(xcontext.WithStdContext(s.ctx, ctx))
+	defer cancel()
+	sub, err := s.subscribe(subCtx, api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId), eventstream.Filter{EventNames: statusEvents}, eventstream.Cursor{})
+	if err != nil {
+		return err
+	}
+
+	// A new status is sent every time the job emits events which change it,
+	// along with the lines it logged since the previous status, until the job
+	// completes or is paused. The events themselves are only delivered by
+	// StreamEvents.
+	var afterSequenceID uint64
 	for {
+		_, ok := <-sub.Events
+		for drained := false; ok && !drained; {
+			select {
+			case _, ok = <-sub.Events:
+			default:
+				drained = true
+			}
+		}
+		if err := sub.Err(); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 
-		resp, err := s.getResponseFromAPI(req.Msg)
+		log, err := s.jobLogs(ctx, req.Msg, &afterSequenceID, !ok)
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.getResponseFromAPI(ctx, req.Msg)
 		if err != nil {
 			s.ctx.Errorf("getResponseFromAPI: %w", err)
 
@@ -172,56 +256,122 @@ func (s *GRPCServer) StatusJob(ctx context.Context, req *connect.Request[contest
 			Status: resp.Status.State,
 			Error:  resp.Status.StateErrMsg,
 			Report: reportBytes,
+			Log:    log,
 		}); err != nil {
 			return err
 		}
 
-		fmt.Printf("Job State: %s\n", resp.Status.State)
+		s.ctx.Debugf("Job State: %s", resp.Status.State)
 
-		// Job is not running anymore
-		if resp.Status.State != string(job.EventJobStarted) {
-			break
+		if !ok {
+			// The job completed or was paused.
+			return nil
 		}
-
-		// Buffer was full - let's poll faster again.
-		time.Sleep(waitForUpdate)
 	}
+}
 
-	resp, err := s.getResponseFromAPI(req.Msg)
-	if err != nil {
-		s.ctx.Errorf("getResponseFromAPI: %w", err)
+// StreamEvents streams the events of a job as they are emitted.
+func (s *GRPCServer) StreamEvents(ctx context.Context, req *connect.Request[contestlistener.StreamEventsRequest], stream *connect.ServerStream[contestlistener.StreamEventsResponse]) error {
+	if req.Msg.Requestor == "" {
+		s.ctx.Errorf("Requestor is not set")
 
-		return fmt.Errorf("getResponseFromAPI() = '%w'", err)
+		return fmt.Errorf("Requestor is not set")
 	}
 
-	if resp.Status == nil {
-		s.ctx.Errorf("api.Status(): Returned job.Status == nil")
+	filter := eventstream.Filter{
+		RunID:         types.RunID(req.Msg.RunId),
+		TestName:      req.Msg.TestName,
+		TestStepLabel: req.Msg.StepLabel,
+		TargetID:      req.Msg.TargetId,
+	}
+	for _, name := range req.Msg.EventNames {
+		filter.EventNames = append(filter.EventNames, event.Name(name))
+	}
+	cursor := eventstream.Cursor{
+		TestEventSequenceID:      req.Msg.AfterTestEventSequenceId,
+		FrameworkEventSequenceID: req.Msg.AfterFrameworkEventSequenceId,
+	}
 
-		return fmt.Errorf("api.Status(): Returned job.Status == nil")
+	subCtx, cancel := xcontext.WithCancel(xcontext.WithStdContext(s.ctx, ctx))
+	defer cancel()
+	sub, err := s.subscribe(subCtx, api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId), filter, cursor)
+	if err != nil {
+		return err
 	}
+	for ev := range sub.Events {
+		data, err := json.Marshal(ev)
+		if err != nil {
+			return fmt.Errorf("unable to marshal event: %w", err)
+		}
+		cursor = cursor.Position(ev)
+		if err := stream.Send(&contestlistener.StreamEventsResponse{
+			Event:                    data,
+			TestEventSequenceId:      cursor.TestEventSequenceID,
+			FrameworkEventSequenceId: cursor.FrameworkEventSequenceID,
+		}); err != nil {
+			return err
+		}
+	}
+	if err := sub.Err(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
 
-	reportBytes, err := json.Marshal(resp.Status)
+func (s *GRPCServer) subscribe(ctx xcontext.Context, requestor api.EventRequestor, jobID types.JobID, filter eventstream.Filter, cursor eventstream.Cursor) (*eventstream.Subscription, error) {
+	resp, err := s.api.Subscribe(ctx, requestor, jobID, filter, cursor)
 	if err != nil {
-		s.ctx.Errorf("Unable to Marshal Status")
+		s.ctx.Errorf("api.Subscribe() = '%v'", err)
 
-		return fmt.Errorf("Unable to Marshal Status")
+		return nil, err
 	}
-
-	if err := stream.Send(&contestlistener.StatusJobResponse{
-		Status: resp.Status.State,
-		Error:  resp.Status.StateErrMsg,
-		Report: reportBytes,
-	}); err != nil {
-		return err
+	if resp.Err != nil {
+		return nil, resp.Err
 	}
+	r, ok := resp.Data.(api.ResponseDataSubscribe)
+	if !ok {
+		return nil, fmt.Errorf("unknown Message")
+	}
+	return r.Subscription, nil
+}
 
-	fmt.Printf("Last Job State: %s\n", resp.Status.State)
+// logsWaitPoll is how often jobLogs asks for the last log lines of a job
+// which is over, until they are all stored.
+const logsWaitPoll = 100 * time.Millisecond
 
-	return nil
+// jobLogs returns the lines a job logged after the given sequence ID, one per
+// line, and moves the sequence ID past them. If wait is set, the job is over
+// and jobLogs waits for all of its lines to be stored.
+func (s *GRPCServer) jobLogs(ctx context.Context, msg *contestlistener.StatusJobRequest, afterSequenceID *uint64, wait bool) ([]byte, error) {
+	var log bytes.Buffer
+	for {
+		apiResp, err := s.api.Logs(xcontext.WithStdContext(s.ctx, ctx), api.EventRequestor(msg.Requestor), types.JobID(msg.JobId), *afterSequenceID, 0)
+		if err != nil {
+			return nil, fmt.Errorf("api.Logs() = '%w'", err)
+		}
+		if apiResp.Err != nil {
+			return nil, fmt.Errorf("api.Logs() = '%w'", apiResp.Err)
+		}
+		data := apiResp.Data.(api.ResponseDataLogs)
+		for _, entry := range data.Entries {
+			fmt.Fprintf(&log, "%s %s %s\n", entry.Time.Format(time.RFC3339Nano), entry.Level, entry.Message)
+			*afterSequenceID = entry.SequenceID
+		}
+		if data.Done || (!wait && uint(len(data.Entries)) < api.DefaultLogsLimit) {
+			return log.Bytes(), nil
+		}
+		if len(data.Entries) == 0 {
+			select {
+			case <-time.After(logsWaitPoll):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
 }
 
-func (s *GRPCServer) getResponseFromAPI(msg *contestlistener.StatusJobRequest) (api.ResponseDataStatus, error) {
-	apiResp, err := s.api.Status(s.ctx, api.EventRequestor(msg.Requestor), types.JobID(msg.JobId))
+func (s *GRPCServer) getResponseFromAPI(ctx context.Context, msg *contestlistener.StatusJobRequest) (api.ResponseDataStatus, error) {
+	apiResp, err := s.api.Status(xcontext.WithStdContext(s.ctx, ctx), api.EventRequestor(msg.Requestor), types.JobID(msg.JobId))
 	if err != nil {
 		s.ctx.Errorf("api.Status() = '%v'", err)
 
@@ -234,3 +384,106 @@ func (s *GRPCServer) getResponseFromAPI(msg *contestlistener.StatusJobRequest) (
 
 	return api.ResponseDataStatus{}, fmt.Errorf("unknown Message")
 }
+
+func (s *GRPCServer) ScheduleJob(ctx context.Context, req *connect.Request[contestlistener.ScheduleJobRequest]) (*connect.Response[contestlistener.ScheduleJobResponse], error) {
+	if req.Msg.Job == nil {
+		s.ctx.Errorf("Job is nil")
+		return connect.NewResponse(&contestlistener.ScheduleJobResponse{
+			Error: "Job is nil",
+		}), fmt.Errorf("Job is nil")
+	}
+
+	if req.Msg.Requestor == "" {
+		s.ctx.Errorf("Requestor is not set")
+		return connect.NewResponse(&contestlistener.ScheduleJobResponse{
+			Error: "Requestor is not set",
+		}), fmt.Errorf("Requestor is not set")
+	}
+
+	resp, err := s.api.Schedule(xcontext.WithStdContext(s.ctx, ctx), api.EventRequestor(req.Msg.Requestor), string(req.Msg.Job), req.Msg.Cron, req.Msg.SkipIfRunning)
+	if err != nil {
+		return connect.NewResponse(&contestlistener.ScheduleJobResponse{
+			Error: err.Error(),
+		}), err
+	}
+	if resp.Err != nil {
+		return connect.NewResponse(&contestlistener.ScheduleJobResponse{
+			Error: resp.Err.Error(),
+		}), resp.Err
+	}
+
+	r, ok := resp.Data.(api.ResponseDataSchedule)
+	if !ok {
+		return nil, fmt.Errorf("unknown Message")
+	}
+	return connect.NewResponse(&contestlistener.ScheduleJobResponse{
+		ScheduleId: uint64(r.ScheduleID),
+	}), nil
+}
+
+func (s *GRPCServer) UnscheduleJob(ctx context.Context, req *connect.Request[contestlistener.UnscheduleJobRequest]) (*connect.Response[contestlistener.UnscheduleJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		s.ctx.Errorf("Requestor is not set")
+		return connect.NewResponse(&contestlistener.UnscheduleJobResponse{
+			Error: "Requestor is not set",
+		}), fmt.Errorf("Requestor is not set")
+	}
+
+	resp, err := s.api.Unschedule(xcontext.WithStdContext(s.ctx, ctx), api.EventRequestor(req.Msg.Requestor), types.ScheduleID(req.Msg.ScheduleId))
+	if err != nil {
+		return connect.NewResponse(&contestlistener.UnscheduleJobResponse{
+			Error: err.Error(),
+		}), err
+	}
+	if resp.Err != nil {
+		return connect.NewResponse(&contestlistener.UnscheduleJobResponse{
+			Error: resp.Err.Error(),
+		}), resp.Err
+	}
+	return connect.NewResponse(&contestlistener.UnscheduleJobResponse{}), nil
+}
+
+func (s *GRPCServer) ListSchedules(ctx context.Context, req *connect.Request[contestlistener.ListSchedulesRequest]) (*connect.Response[contestlistener.ListSchedulesResponse], error) {
+	if req.Msg.Requestor == "" {
+		s.ctx.Errorf("Requestor is not set")
+		return connect.NewResponse(&contestlistener.ListSchedulesResponse{
+			Error: "Requestor is not set",
+		}), fmt.Errorf("Requestor is not set")
+	}
+
+	resp, err := s.api.ListSchedules(xcontext.WithStdContext(s.ctx, ctx), api.EventRequestor(req.Msg.Requestor))
+	if err != nil {
+		return connect.NewResponse(&contestlistener.ListSchedulesResponse{
+			Error: err.Error(),
+		}), err
+	}
+	if resp.Err != nil {
+		return connect.NewResponse(&contestlistener.ListSchedulesResponse{
+			Error: resp.Err.Error(),
+		}), resp.Err
+	}
+
+	r, ok := resp.Data.(api.ResponseDataListSchedules)
+	if !ok {
+		return nil, fmt.Errorf("unknown Message")
+	}
+	schedules := make([]*contestlistener.Schedule, 0, len(r.Schedules))
+	for _, sched := range r.Schedules {
+		pbSchedule := &contestlistener.Schedule{
+			ScheduleId:    uint64(sched.ID),
+			Cron:          sched.CronExpression,
+			Job:           []byte(sched.JobDescriptor),
+			Requestor:     sched.Requestor,
+			SkipIfRunning: sched.SkipIfRunning,
+			CreateTime:    sched.CreateTime.Format(time.RFC3339),
+			LastJobId:     int32(sched.LastJobID),
+		}
+		if !sched.LastRunTime.IsZero() {
+			pbSchedule.LastRunTime = sched.LastRunTime.Format(time.RFC3339)
+		}
+		schedules = append(schedules, pbSchedule)
+	}
+	return connect.NewResponse(&contestlistener.ListSchedulesResponse{
+		Schedules: schedules,
+	}), nil
+}
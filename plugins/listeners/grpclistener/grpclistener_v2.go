@@ -0,0 +1,594 @@
+package grpclistener
+
+import (
+	context "context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/event"
+	eventstream "github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	contestlistenerv2 "github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v2"
+)
+
+// GRPCServerV2 implements the v2 ConTestService. Unlike GRPCServer, it serves
+// any job in the storage, not only the jobs started through it, and returns
+// errors as gRPC status errors.
+type GRPCServerV2 struct {
+	ctx xcontext.Context
+	api *api.API
+}
+
+var errNoRequestor = connect.NewError(connect.CodeInvalidArgument, errors.New("requestor is not set"))
+
+// apiContext returns the context of an API call, which is cancelled along
+// with the request.
+func (s *GRPCServerV2) apiContext(ctx context.Context) xcontext.Context {
+	return xcontext.WithStdContext(s.ctx, ctx)
+}
+
+// apiError converts the errors of an API call to gRPC status errors: err
+// means the API could not be reached or the requestor could not be
+// authenticated, respErr that the request failed.
+func apiError(err, respErr error) error {
+	for _, e := range []error{err, respErr} {
+		switch {
+		case errors.Is(e, api.ErrUnauthenticated):
+			return connect.NewError(connect.CodeUnauthenticated, e)
+		case errors.Is(e, api.ErrPermissionDenied):
+			return connect.NewError(connect.CodePermissionDenied, e)
+		case errors.Is(e, storage.ErrJobNotFound), errors.Is(e, artifact.ErrNotFound),
+			errors.Is(e, inventory.ErrNotFound), errors.Is(e, health.ErrNotFound):
+			return connect.NewError(connect.CodeNotFound, e)
+		case errors.Is(e, artifact.ErrTooLarge):
+			return connect.NewError(connect.CodeResourceExhausted, e)
+		case errors.Is(e, inventory.ErrAlreadyExists), errors.Is(e, health.ErrQuarantined):
+			return connect.NewError(connect.CodeAlreadyExists, e)
+		case errors.Is(e, health.ErrNotQuarantined):
+			return connect.NewError(connect.CodeFailedPrecondition, e)
+		}
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	if respErr != nil {
+		return connect.NewError(connect.CodeUnknown, respErr)
+	}
+	return nil
+}
+
+func (s *GRPCServerV2) Version(ctx context.Context, req *connect.Request[contestlistenerv2.VersionRequest]) (*connect.Response[contestlistenerv2.VersionResponse], error) {
+	resp := s.api.Version()
+	return connect.NewResponse(&contestlistenerv2.VersionResponse{
+		Version:  resp.Data.(api.ResponseDataVersion).Version,
+		ServerId: resp.ServerID,
+	}), nil
+}
+
+func (s *GRPCServerV2) StartJob(ctx context.Context, req *connect.Request[contestlistenerv2.StartJobRequest]) (*connect.Response[contestlistenerv2.StartJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if len(req.Msg.Job) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job is not set"))
+	}
+	resp, err := s.api.Start(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), string(req.Msg.Job))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.StartJobResponse{
+		JobId: int64(resp.Data.(api.ResponseDataStart).JobID),
+	}), nil
+}
+
+func (s *GRPCServerV2) ValidateJob(ctx context.Context, req *connect.Request[contestlistenerv2.ValidateJobRequest]) (*connect.Response[contestlistenerv2.ValidateJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if len(req.Msg.Job) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job is not set"))
+	}
+	resp, err := s.api.Validate(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), string(req.Msg.Job))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	var problems []*contestlistenerv2.DescriptorProblem
+	for _, p := range resp.Data.(api.ResponseDataValidate).Problems {
+		problems = append(problems, &contestlistenerv2.DescriptorProblem{Path: p.Path, Message: p.Message})
+	}
+	return connect.NewResponse(&contestlistenerv2.ValidateJobResponse{Problems: problems}), nil
+}
+
+func (s *GRPCServerV2) StopJob(ctx context.Context, req *connect.Request[contestlistenerv2.StopJobRequest]) (*connect.Response[contestlistenerv2.StopJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.Stop(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.StopJobResponse{}), nil
+}
+
+func (s *GRPCServerV2) status(ctx context.Context, requestor string, jobID types.JobID) (*contestlistenerv2.Status, error) {
+	resp, err := s.api.Status(s.apiContext(ctx), api.EventRequestor(requestor), jobID)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	status := resp.Data.(api.ResponseDataStatus).Status
+	if status == nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("no status returned"))
+	}
+	pbStatus, err := statusToV2(jobID, status)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return pbStatus, nil
+}
+
+func (s *GRPCServerV2) StatusJob(ctx context.Context, req *connect.Request[contestlistenerv2.StatusJobRequest]) (*connect.Response[contestlistenerv2.StatusJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	status, err := s.status(ctx, req.Msg.Requestor, types.JobID(req.Msg.JobId))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.StatusJobResponse{Status: status}), nil
+}
+
+// WatchJob sends the status of the job along with the events it emitted
+// since the previous status, until the job completes or is paused.
+func (s *GRPCServerV2) WatchJob(ctx context.Context, req *connect.Request[contestlistenerv2.WatchJobRequest], stream *connect.ServerStream[contestlistenerv2.WatchJobResponse]) error {
+	if req.Msg.Requestor == "" {
+		return errNoRequestor
+	}
+	jobID := types.JobID(req.Msg.JobId)
+	subCtx, cancel := xcontext.WithCancel(s.apiContext(ctx))
+	defer cancel()
+	sub, err := s.subscribe(subCtx, req.Msg.Requestor, jobID, eventstream.Filter{}, eventstream.Cursor{})
+	if err != nil {
+		return err
+	}
+	for {
+		resp := &contestlistenerv2.WatchJobResponse{}
+		ev, ok := <-sub.Events
+		for drained := false; ok && !drained; {
+			if ev.TestEvent != nil {
+				resp.TestEvents = append(resp.TestEvents, testEventToV2(ev.TestEvent))
+			} else {
+				resp.FrameworkEvents = append(resp.FrameworkEvents, frameworkEventToV2(ev.FrameworkEvent))
+			}
+			select {
+			case ev, ok = <-sub.Events:
+			default:
+				drained = true
+			}
+		}
+		if err := sub.Err(); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if resp.Status, err = s.status(ctx, req.Msg.Requestor, jobID); err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+}
+
+func (s *GRPCServerV2) RetryJob(ctx context.Context, req *connect.Request[contestlistenerv2.RetryJobRequest]) (*connect.Response[contestlistenerv2.RetryJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	opts := api.RetryOptions{
+		OnlyFailedTests:   req.Msg.OnlyFailedTests,
+		OnlyFailedTargets: req.Msg.OnlyFailedTargets,
+	}
+	resp, err := s.api.Retry(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId), opts)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.RetryJobResponse{
+		NewJobId: int64(resp.Data.(api.ResponseDataRetry).NewJobID),
+	}), nil
+}
+
+func (s *GRPCServerV2) ListJobs(ctx context.Context, req *connect.Request[contestlistenerv2.ListJobsRequest]) (*connect.Response[contestlistenerv2.ListJobsResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	query, err := jobQueryFromV2(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	resp, err := s.api.List(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), query)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	jobs := resp.Data.(api.ResponseDataList).Jobs
+	res := &contestlistenerv2.ListJobsResponse{
+		JobIds: make([]int64, 0, len(jobs)),
+	}
+	for i := range jobs {
+		res.JobIds = append(res.JobIds, int64(jobs[i].JobID))
+		res.Jobs = append(res.Jobs, jobSummaryToV2(&jobs[i]))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *GRPCServerV2) ScheduleJob(ctx context.Context, req *connect.Request[contestlistenerv2.ScheduleJobRequest]) (*connect.Response[contestlistenerv2.ScheduleJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if len(req.Msg.Job) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job is not set"))
+	}
+	resp, err := s.api.Schedule(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), string(req.Msg.Job), req.Msg.Cron, req.Msg.SkipIfRunning)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.ScheduleJobResponse{
+		ScheduleId: uint64(resp.Data.(api.ResponseDataSchedule).ScheduleID),
+	}), nil
+}
+
+func (s *GRPCServerV2) UnscheduleJob(ctx context.Context, req *connect.Request[contestlistenerv2.UnscheduleJobRequest]) (*connect.Response[contestlistenerv2.UnscheduleJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.Unschedule(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.ScheduleID(req.Msg.ScheduleId))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.UnscheduleJobResponse{}), nil
+}
+
+func (s *GRPCServerV2) ListSchedules(ctx context.Context, req *connect.Request[contestlistenerv2.ListSchedulesRequest]) (*connect.Response[contestlistenerv2.ListSchedulesResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.ListSchedules(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	r := resp.Data.(api.ResponseDataListSchedules)
+	schedules := make([]*contestlistenerv2.Schedule, 0, len(r.Schedules))
+	for _, sched := range r.Schedules {
+		schedules = append(schedules, &contestlistenerv2.Schedule{
+			ScheduleId:    uint64(sched.ID),
+			Cron:          sched.CronExpression,
+			Job:           []byte(sched.JobDescriptor),
+			Requestor:     sched.Requestor,
+			SkipIfRunning: sched.SkipIfRunning,
+			CreateTime:    formatTime(sched.CreateTime),
+			LastJobId:     int64(sched.LastJobID),
+			LastRunTime:   formatTime(sched.LastRunTime),
+		})
+	}
+	return connect.NewResponse(&contestlistenerv2.ListSchedulesResponse{Schedules: schedules}), nil
+}
+
+func (s *GRPCServerV2) JUnit(ctx context.Context, req *connect.Request[contestlistenerv2.JUnitRequest]) (*connect.Response[contestlistenerv2.JUnitResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.JUnit(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.JUnitResponse{
+		Xml: []byte(resp.Data.(api.ResponseDataJUnit).XML),
+	}), nil
+}
+
+func (s *GRPCServerV2) GetArtifact(ctx context.Context, req *connect.Request[contestlistenerv2.GetArtifactRequest]) (*connect.Response[contestlistenerv2.GetArtifactResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.Artifact(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId), req.Msg.ArtifactId)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	data := resp.Data.(api.ResponseDataArtifact)
+	return connect.NewResponse(&contestlistenerv2.GetArtifactResponse{
+		Artifact: artifactToV2(data.Artifact),
+		Content:  data.Content,
+	}), nil
+}
+
+// GetJobLogs returns the lines logged while running a job.
+func (s *GRPCServerV2) GetJobLogs(ctx context.Context, req *connect.Request[contestlistenerv2.GetJobLogsRequest]) (*connect.Response[contestlistenerv2.GetJobLogsResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.Logs(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId), req.Msg.AfterSequenceId, uint(req.Msg.Limit))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	data := resp.Data.(api.ResponseDataLogs)
+	out := &contestlistenerv2.GetJobLogsResponse{Done: data.Done}
+	for _, entry := range data.Entries {
+		out.Entries = append(out.Entries, logEntryToV2(entry))
+	}
+	return connect.NewResponse(out), nil
+}
+
+// AddTarget adds a target to the inventory.
+func (s *GRPCServerV2) AddTarget(ctx context.Context, req *connect.Request[contestlistenerv2.AddTargetRequest]) (*connect.Response[contestlistenerv2.AddTargetResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	t, err := inventoryTargetFromV2(req.Msg.Target)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	resp, err := s.api.AddTarget(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), t)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	stored := resp.Data.(api.ResponseDataAddTarget).Target
+	return connect.NewResponse(&contestlistenerv2.AddTargetResponse{Target: inventoryTargetToV2(&stored)}), nil
+}
+
+// UpdateTarget replaces a target of the inventory.
+func (s *GRPCServerV2) UpdateTarget(ctx context.Context, req *connect.Request[contestlistenerv2.UpdateTargetRequest]) (*connect.Response[contestlistenerv2.UpdateTargetResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	t, err := inventoryTargetFromV2(req.Msg.Target)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	resp, err := s.api.UpdateTarget(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), t)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	stored := resp.Data.(api.ResponseDataUpdateTarget).Target
+	return connect.NewResponse(&contestlistenerv2.UpdateTargetResponse{Target: inventoryTargetToV2(&stored)}), nil
+}
+
+// RemoveTarget removes a target from the inventory.
+func (s *GRPCServerV2) RemoveTarget(ctx context.Context, req *connect.Request[contestlistenerv2.RemoveTargetRequest]) (*connect.Response[contestlistenerv2.RemoveTargetResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if req.Msg.TargetId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("target_id is not set"))
+	}
+	resp, err := s.api.RemoveTarget(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), req.Msg.TargetId)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.RemoveTargetResponse{}), nil
+}
+
+// ListTargets lists the targets of the inventory matching a selector.
+func (s *GRPCServerV2) ListTargets(ctx context.Context, req *connect.Request[contestlistenerv2.ListTargetsRequest]) (*connect.Response[contestlistenerv2.ListTargetsResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if _, err := inventory.ParseSelector(req.Msg.Selector); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	resp, err := s.api.ListTargets(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), req.Msg.Selector)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	out := &contestlistenerv2.ListTargetsResponse{}
+	targets := resp.Data.(api.ResponseDataListTargets).Targets
+	for i := range targets {
+		out.Targets = append(out.Targets, inventoryTargetToV2(&targets[i]))
+	}
+	return connect.NewResponse(out), nil
+}
+
+// QuarantineTarget quarantines a target, so that it is not handed out to
+// jobs until it is released.
+func (s *GRPCServerV2) QuarantineTarget(ctx context.Context, req *connect.Request[contestlistenerv2.QuarantineTargetRequest]) (*connect.Response[contestlistenerv2.QuarantineTargetResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if req.Msg.TargetId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("target_id is not set"))
+	}
+	if req.Msg.Reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reason is not set"))
+	}
+	resp, err := s.api.QuarantineTarget(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), req.Msg.TargetId, req.Msg.Reason)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	q := resp.Data.(api.ResponseDataQuarantineTarget).Quarantine
+	return connect.NewResponse(&contestlistenerv2.QuarantineTargetResponse{Quarantine: quarantineToV2(&q)}), nil
+}
+
+// ReleaseTarget releases a target from quarantine.
+func (s *GRPCServerV2) ReleaseTarget(ctx context.Context, req *connect.Request[contestlistenerv2.ReleaseTargetRequest]) (*connect.Response[contestlistenerv2.ReleaseTargetResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if req.Msg.TargetId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("target_id is not set"))
+	}
+	if req.Msg.Reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reason is not set"))
+	}
+	resp, err := s.api.ReleaseTarget(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), req.Msg.TargetId, req.Msg.Reason)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	q := resp.Data.(api.ResponseDataReleaseTarget).Quarantine
+	return connect.NewResponse(&contestlistenerv2.ReleaseTargetResponse{Quarantine: quarantineToV2(&q)}), nil
+}
+
+// ListQuarantinedTargets lists the targets in quarantine.
+func (s *GRPCServerV2) ListQuarantinedTargets(ctx context.Context, req *connect.Request[contestlistenerv2.ListQuarantinedTargetsRequest]) (*connect.Response[contestlistenerv2.ListQuarantinedTargetsResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.ListQuarantinedTargets(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	out := &contestlistenerv2.ListQuarantinedTargetsResponse{}
+	quarantines := resp.Data.(api.ResponseDataListQuarantinedTargets).Quarantines
+	for i := range quarantines {
+		out.Quarantines = append(out.Quarantines, quarantineToV2(&quarantines[i]))
+	}
+	return connect.NewResponse(out), nil
+}
+
+// ListLocks lists the locks held on the targets.
+func (s *GRPCServerV2) ListLocks(ctx context.Context, req *connect.Request[contestlistenerv2.ListLocksRequest]) (*connect.Response[contestlistenerv2.ListLocksResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.ListLocks(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), req.Msg.TargetIds)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	out := &contestlistenerv2.ListLocksResponse{}
+	for _, l := range resp.Data.(api.ResponseDataListLocks).Locks {
+		out.Locks = append(out.Locks, lockToV2(l))
+	}
+	return connect.NewResponse(out), nil
+}
+
+// ForceUnlock unlocks targets whichever job holds them.
+func (s *GRPCServerV2) ForceUnlock(ctx context.Context, req *connect.Request[contestlistenerv2.ForceUnlockRequest]) (*connect.Response[contestlistenerv2.ForceUnlockResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if len(req.Msg.TargetIds) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("target_ids is not set"))
+	}
+	if req.Msg.Reason == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reason is not set"))
+	}
+	resp, err := s.api.ForceUnlock(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), req.Msg.TargetIds, req.Msg.Reason)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	out := &contestlistenerv2.ForceUnlockResponse{}
+	for _, l := range resp.Data.(api.ResponseDataForceUnlock).Locks {
+		out.Locks = append(out.Locks, lockToV2(l))
+	}
+	return connect.NewResponse(out), nil
+}
+
+// StreamEvents streams the events of a job as they are emitted.
+func (s *GRPCServerV2) StreamEvents(ctx context.Context, req *connect.Request[contestlistenerv2.StreamEventsRequest], stream *connect.ServerStream[contestlistenerv2.StreamEventsResponse]) error {
+	if req.Msg.Requestor == "" {
+		return errNoRequestor
+	}
+	filter := eventstream.Filter{
+		RunID:         types.RunID(req.Msg.RunId),
+		TestName:      req.Msg.TestName,
+		TestStepLabel: req.Msg.StepLabel,
+		TargetID:      req.Msg.TargetId,
+	}
+	for _, name := range req.Msg.EventNames {
+		filter.EventNames = append(filter.EventNames, event.Name(name))
+	}
+	cursor := eventstream.Cursor{
+		TestEventSequenceID:      req.Msg.AfterTestEventSequenceId,
+		FrameworkEventSequenceID: req.Msg.AfterFrameworkEventSequenceId,
+	}
+
+	subCtx, cancel := xcontext.WithCancel(s.apiContext(ctx))
+	defer cancel()
+	sub, err := s.subscribe(subCtx, req.Msg.Requestor, types.JobID(req.Msg.JobId), filter, cursor)
+	if err != nil {
+		return err
+	}
+	for ev := range sub.Events {
+		cursor = cursor.Position(ev)
+		resp := &contestlistenerv2.StreamEventsResponse{
+			TestEventSequenceId:      cursor.TestEventSequenceID,
+			FrameworkEventSequenceId: cursor.FrameworkEventSequenceID,
+		}
+		if ev.TestEvent != nil {
+			resp.TestEvent = testEventToV2(ev.TestEvent)
+		} else {
+			resp.FrameworkEvent = frameworkEventToV2(ev.FrameworkEvent)
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	if err := sub.Err(); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return ctx.Err()
+}
+
+// QueryEvents returns a page of the events of a job.
+func (s *GRPCServerV2) QueryEvents(ctx context.Context, req *connect.Request[contestlistenerv2.QueryEventsRequest]) (*connect.Response[contestlistenerv2.QueryEventsResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	if req.Msg.JobId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("job ID is not set"))
+	}
+	if req.Msg.Limit > api.MaxEventsLimit {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("limit %d exceeds the maximum of %d", req.Msg.Limit, api.MaxEventsLimit))
+	}
+	testQuery, frameworkQuery, err := eventQueriesFromV2(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	resp, err := s.api.QueryEvents(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), testQuery, frameworkQuery)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	data := resp.Data.(api.ResponseDataEvents)
+	return connect.NewResponse(&contestlistenerv2.QueryEventsResponse{
+		TestEvents:      testEventsToV2(data.TestEvents),
+		FrameworkEvents: frameworkEventsToV2(data.FrameworkEvents),
+	}), nil
+}
+
+func (s *GRPCServerV2) PauseJob(ctx context.Context, req *connect.Request[contestlistenerv2.PauseJobRequest]) (*connect.Response[contestlistenerv2.PauseJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.Pause(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.PauseJobResponse{}), nil
+}
+
+func (s *GRPCServerV2) ResumeJob(ctx context.Context, req *connect.Request[contestlistenerv2.ResumeJobRequest]) (*connect.Response[contestlistenerv2.ResumeJobResponse], error) {
+	if req.Msg.Requestor == "" {
+		return nil, errNoRequestor
+	}
+	resp, err := s.api.Resume(s.apiContext(ctx), api.EventRequestor(req.Msg.Requestor), types.JobID(req.Msg.JobId))
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&contestlistenerv2.ResumeJobResponse{}), nil
+}
+
+func (s *GRPCServerV2) subscribe(ctx xcontext.Context, requestor string, jobID types.JobID, filter eventstream.Filter, cursor eventstream.Cursor) (*eventstream.Subscription, error) {
+	resp, err := s.api.Subscribe(ctx, api.EventRequestor(requestor), jobID, filter, cursor)
+	if err := apiError(err, resp.Err); err != nil {
+		return nil, err
+	}
+	return resp.Data.(api.ResponseDataSubscribe).Subscription, nil
+}
@@ -0,0 +1,522 @@
+package grpclistener
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	contestlistenerv2 "github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v2"
+	contestlistenerconnectv2 "github.com/linuxboot/contest/plugins/listeners/grpclistener/gen/contest/v2/contestlistenerconnect"
+)
+
+// newTestClient serves the v2 service with an API whose events are handled
+// by handle, in place of a JobManager.
+func newTestClient(t *testing.T, handle func(ev *api.Event) *api.EventResponse) contestlistenerconnectv2.ConTestServiceClient {
+	a, err := api.New(api.OptionServerID("test"))
+	require.NoError(t, err)
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		for {
+			select {
+			case ev := <-a.Events:
+				ev.RespCh <- handle(ev)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	path, handler := contestlistenerconnectv2.NewConTestServiceHandler(&GRPCServerV2{
+		ctx: logrusctx.NewContext(logger.LevelDebug),
+		api: a,
+	})
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	require.Equal(t, "/contest.v2.ConTestService/", path)
+	return contestlistenerconnectv2.NewConTestServiceClient(srv.Client(), srv.URL)
+}
+
+func TestV2StatusJob(t *testing.T) {
+	startTime := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	payload := json.RawMessage(`{"foo":"bar"}`)
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		msg := ev.Msg.(api.EventStatusMsg)
+		if msg.JobID != 42 {
+			return &api.EventResponse{Err: errors.New("job not found")}
+		}
+		tgt := &target.Target{ID: "T1"}
+		return &api.EventResponse{Status: &job.Status{
+			Name:       "job",
+			State:      string(job.EventJobStarted),
+			StartTime:  startTime,
+			TargetWait: &target.Waiter{JobID: 42, Priority: 3, Since: startTime, MinTargets: 2, Position: 1},
+			RunStatuses: []job.RunStatus{{
+				RunCoordinates: job.RunCoordinates{JobID: 42, RunID: 1},
+				TestStatuses: []job.TestStatus{{
+					TestCoordinates: job.TestCoordinates{TestName: "test"},
+					TestStepStatuses: []job.TestStepStatus{{
+						TestStepCoordinates: job.TestStepCoordinates{TestStepName: "cmd", TestStepLabel: "step"},
+						TargetStatuses: []job.TargetStatus{{
+							Target: tgt,
+							InTime: startTime,
+							Error:  "failed",
+							Events: []testevent.Event{{
+								SequenceID: 7,
+								Header:     &testevent.Header{JobID: 42, RunID: 1, TestName: "test", TestStepLabel: "step"},
+								Data:       &testevent.Data{EventName: "Foo", Target: tgt, Payload: &payload},
+							}},
+						}},
+					}},
+				}},
+			}},
+			JobReport: &job.JobReport{
+				JobID:        42,
+				RunReports:   [][]*job.Report{{{JobID: 42, RunID: 1, ReporterName: "TargetSuccess", Success: true, Data: "ok"}}},
+				FinalReports: []*job.Report{},
+			},
+		}}
+	})
+
+	resp, err := client.StatusJob(context.Background(), connect.NewRequest(&contestlistenerv2.StatusJobRequest{Requestor: "test", JobId: 42}))
+	require.NoError(t, err)
+	status := resp.Msg.Status
+	require.Equal(t, int64(42), status.JobId)
+	require.Equal(t, contestlistenerv2.JobState_JOB_STATE_STARTED, status.State)
+	require.Equal(t, "2022-01-02T03:04:05Z", status.StartTime)
+	require.Empty(t, status.EndTime)
+	require.Equal(t, int32(1), status.TargetWait.Position)
+	require.Equal(t, int32(3), status.TargetWait.Priority)
+	require.Equal(t, "2022-01-02T03:04:05Z", status.TargetWait.Since)
+	require.Equal(t, uint32(2), status.TargetWait.MinTargets)
+
+	require.Len(t, status.RunStatuses, 1)
+	stepStatus := status.RunStatuses[0].TestStatuses[0].TestStepStatuses[0]
+	require.Equal(t, "step", stepStatus.TestStepLabel)
+	targetStatus := stepStatus.TargetStatuses[0]
+	require.Equal(t, "T1", targetStatus.Target.Id)
+	require.Equal(t, "failed", targetStatus.Error)
+	require.Equal(t, uint64(7), targetStatus.Events[0].SequenceId)
+	require.Equal(t, "Foo", targetStatus.Events[0].EventName)
+	require.JSONEq(t, `{"foo":"bar"}`, string(targetStatus.Events[0].Payload))
+
+	require.Len(t, status.JobReport.RunReports, 1)
+	report := status.JobReport.RunReports[0].Reports[0]
+	require.Equal(t, "TargetSuccess", report.ReporterName)
+	require.Equal(t, `"ok"`, string(report.Data))
+
+	// Errors of the API are returned as status errors.
+	_, err = client.StatusJob(context.Background(), connect.NewRequest(&contestlistenerv2.StatusJobRequest{Requestor: "test", JobId: 1}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeUnknown, connect.CodeOf(err))
+	require.Contains(t, err.Error(), "job not found")
+
+	_, err = client.StatusJob(context.Background(), connect.NewRequest(&contestlistenerv2.StatusJobRequest{JobId: 42}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestV2RetryAndList(t *testing.T) {
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		switch msg := ev.Msg.(type) {
+		case api.EventRetryMsg:
+			require.True(t, msg.Options.OnlyFailedTargets)
+			return &api.EventResponse{JobID: msg.JobID + 1}
+		case api.EventListMsg:
+			require.Equal(t, []job.State{job.JobStateCompleted}, msg.Query.States)
+			require.Equal(t, "someone", msg.Query.Requestor)
+			require.Equal(t, "nightly-*", msg.Query.NamePattern)
+			require.True(t, msg.Query.EndedAfter.Equal(time.Unix(100, 0)))
+			require.True(t, msg.Query.NewestFirst)
+			require.Equal(t, types.JobID(3), msg.Query.AfterJobID)
+			require.Equal(t, uint(2), msg.Query.Limit)
+			endTime := time.Unix(200, 0)
+			return &api.EventResponse{Jobs: []job.Summary{
+				{JobID: 2, Name: "nightly-2", State: job.JobStateCompleted, Requestor: "someone", Tags: []string{"nightly"}, EndTime: &endTime},
+				{JobID: 1, Name: "nightly-1", State: job.JobStateCompleted, Requestor: "someone"},
+			}}
+		}
+		return &api.EventResponse{Err: errors.New("unexpected request")}
+	})
+
+	retryResp, err := client.RetryJob(context.Background(), connect.NewRequest(&contestlistenerv2.RetryJobRequest{
+		Requestor:         "test",
+		JobId:             10,
+		OnlyFailedTargets: true,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, int64(11), retryResp.Msg.NewJobId)
+
+	listResp, err := client.ListJobs(context.Background(), connect.NewRequest(&contestlistenerv2.ListJobsRequest{
+		Requestor:    "test",
+		States:       []contestlistenerv2.JobState{contestlistenerv2.JobState_JOB_STATE_COMPLETED},
+		JobRequestor: "someone",
+		NamePattern:  "nightly-*",
+		EndedAfter:   time.Unix(100, 0).Format(time.RFC3339),
+		NewestFirst:  true,
+		AfterJobId:   3,
+		Limit:        2,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 1}, listResp.Msg.JobIds)
+	require.Equal(t, 2, len(listResp.Msg.Jobs))
+	require.Equal(t, "nightly-2", listResp.Msg.Jobs[0].Name)
+	require.Equal(t, contestlistenerv2.JobState_JOB_STATE_COMPLETED, listResp.Msg.Jobs[0].State)
+	require.Equal(t, []string{"nightly"}, listResp.Msg.Jobs[0].Tags)
+	require.NotEmpty(t, listResp.Msg.Jobs[0].EndTime)
+	require.Empty(t, listResp.Msg.Jobs[1].EndTime)
+
+	_, err = client.ListJobs(context.Background(), connect.NewRequest(&contestlistenerv2.ListJobsRequest{
+		Requestor:      "test",
+		RequestedAfter: "yesterday",
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	versionResp, err := client.Version(context.Background(), connect.NewRequest(&contestlistenerv2.VersionRequest{Requestor: "test"}))
+	require.NoError(t, err)
+	require.Equal(t, api.CurrentAPIVersion, versionResp.Msg.Version)
+	require.Equal(t, "test", versionResp.Msg.ServerId)
+}
+
+func TestV2ValidateJob(t *testing.T) {
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		msg := ev.Msg.(api.EventValidateMsg)
+		require.Equal(t, `{"JobName": ""}`, msg.JobDescriptor)
+		return &api.EventResponse{Problems: []job.DescriptorProblem{
+			{Path: "JobName", Message: "job name cannot be empty"},
+			{Path: "TestDescriptors", Message: "need at least one TestDescriptor in the JobDescriptor"},
+		}}
+	})
+
+	resp, err := client.ValidateJob(context.Background(), connect.NewRequest(&contestlistenerv2.ValidateJobRequest{
+		Requestor: "test",
+		Job:       []byte(`{"JobName": ""}`),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Msg.Problems))
+	require.Equal(t, "JobName", resp.Msg.Problems[0].Path)
+	require.Equal(t, "need at least one TestDescriptor in the JobDescriptor", resp.Msg.Problems[1].Message)
+
+	_, err = client.ValidateJob(context.Background(), connect.NewRequest(&contestlistenerv2.ValidateJobRequest{Requestor: "test"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestV2GetArtifact(t *testing.T) {
+	createTime := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		msg := ev.Msg.(api.EventArtifactMsg)
+		if msg.JobID != 42 || msg.ArtifactID != 3 {
+			return &api.EventResponse{Err: errors.New("artifact not found")}
+		}
+		return &api.EventResponse{
+			Artifact: &artifact.Artifact{
+				ID:            3,
+				JobID:         42,
+				RunID:         1,
+				TestStepLabel: "step",
+				TargetID:      "T1",
+				Name:          "qemu.log",
+				Size:          5,
+				CreateTime:    createTime,
+			},
+			ArtifactContent: []byte("hello"),
+		}
+	})
+
+	resp, err := client.GetArtifact(context.Background(), connect.NewRequest(&contestlistenerv2.GetArtifactRequest{
+		Requestor:  "test",
+		JobId:      42,
+		ArtifactId: 3,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(resp.Msg.Content))
+	require.Equal(t, "qemu.log", resp.Msg.Artifact.Name)
+	require.Equal(t, "T1", resp.Msg.Artifact.TargetId)
+	require.Equal(t, "step", resp.Msg.Artifact.TestStepLabel)
+	require.Equal(t, "2022-01-02T03:04:05Z", resp.Msg.Artifact.CreateTime)
+
+	_, err = client.GetArtifact(context.Background(), connect.NewRequest(&contestlistenerv2.GetArtifactRequest{Requestor: "test", JobId: 42}))
+	require.Equal(t, connect.CodeUnknown, connect.CodeOf(err))
+}
+
+func TestV2GetJobLogs(t *testing.T) {
+	logTime := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		msg := ev.Msg.(api.EventLogsMsg)
+		require.Equal(t, types.JobID(42), msg.JobID)
+		require.Equal(t, uint64(7), msg.AfterSequenceID)
+		require.Equal(t, uint(api.DefaultLogsLimit), msg.Limit)
+		return &api.EventResponse{
+			Logs: []job.LogEntry{
+				{SequenceID: 8, JobID: 42, Time: logTime, Level: "info", Message: "Running job"},
+			},
+			LogsDone: true,
+		}
+	})
+
+	resp, err := client.GetJobLogs(context.Background(), connect.NewRequest(&contestlistenerv2.GetJobLogsRequest{
+		Requestor:       "test",
+		JobId:           42,
+		AfterSequenceId: 7,
+	}))
+	require.NoError(t, err)
+	require.True(t, resp.Msg.Done)
+	require.Equal(t, 1, len(resp.Msg.Entries))
+	require.Equal(t, uint64(8), resp.Msg.Entries[0].SequenceId)
+	require.Equal(t, "2022-01-02T03:04:05Z", resp.Msg.Entries[0].Time)
+	require.Equal(t, "info", resp.Msg.Entries[0].Level)
+	require.Equal(t, "Running job", resp.Msg.Entries[0].Message)
+
+	_, err = client.GetJobLogs(context.Background(), connect.NewRequest(&contestlistenerv2.GetJobLogsRequest{JobId: 42}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestV2PauseAndResume(t *testing.T) {
+	var events []api.EventType
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		events = append(events, ev.Type)
+		switch msg := ev.Msg.(type) {
+		case api.EventPauseMsg:
+			require.Equal(t, types.JobID(5), msg.JobID)
+			return &api.EventResponse{JobID: msg.JobID}
+		case api.EventResumeMsg:
+			return &api.EventResponse{Err: fmt.Errorf("job %d is not paused (state JobStateCompleted)", msg.JobID)}
+		}
+		return &api.EventResponse{Err: errors.New("unexpected request")}
+	})
+
+	_, err := client.PauseJob(context.Background(), connect.NewRequest(&contestlistenerv2.PauseJobRequest{Requestor: "test", JobId: 5}))
+	require.NoError(t, err)
+
+	_, err = client.ResumeJob(context.Background(), connect.NewRequest(&contestlistenerv2.ResumeJobRequest{Requestor: "test", JobId: 5}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not paused")
+
+	_, err = client.ResumeJob(context.Background(), connect.NewRequest(&contestlistenerv2.ResumeJobRequest{JobId: 5}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Equal(t, []api.EventType{api.EventTypePause, api.EventTypeResume}, events)
+}
+
+func TestV2Inventory(t *testing.T) {
+	createTime := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		switch msg := ev.Msg.(type) {
+		case api.EventAddTargetMsg:
+			require.Equal(t, "t1", msg.Target.ID)
+			require.Equal(t, "10.0.0.1", msg.Target.PrimaryIPv4.String())
+			require.Equal(t, map[string]string{"board": "tioga"}, msg.Target.Labels)
+			require.Equal(t, map[string]string{"bmc_host": "t1-bmc"}, msg.Target.Attributes)
+			stored := msg.Target
+			stored.CreateTime, stored.UpdateTime = createTime, createTime
+			return &api.EventResponse{InventoryTargets: []inventory.Target{stored}}
+		case api.EventRemoveTargetMsg:
+			return &api.EventResponse{Err: fmt.Errorf("could not remove target %q: %w", msg.TargetID, inventory.ErrNotFound)}
+		case api.EventListTargetsMsg:
+			require.Equal(t, "board=tioga,!broken", msg.Selector.String())
+			return &api.EventResponse{InventoryTargets: []inventory.Target{{ID: "t1", Labels: map[string]string{"board": "tioga"}}}}
+		}
+		return &api.EventResponse{Err: errors.New("unexpected request")}
+	})
+
+	addResp, err := client.AddTarget(context.Background(), connect.NewRequest(&contestlistenerv2.AddTargetRequest{
+		Requestor: "test",
+		Target: &contestlistenerv2.InventoryTarget{
+			Id:          "t1",
+			PrimaryIpv4: "10.0.0.1",
+			Labels:      map[string]string{"board": "tioga"},
+			Attributes:  map[string]string{"bmc_host": "t1-bmc"},
+		},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "t1", addResp.Msg.Target.Id)
+	require.Equal(t, "10.0.0.1", addResp.Msg.Target.PrimaryIpv4)
+	require.Equal(t, "2022-01-02T03:04:05Z", addResp.Msg.Target.CreateTime)
+
+	_, err = client.AddTarget(context.Background(), connect.NewRequest(&contestlistenerv2.AddTargetRequest{
+		Requestor: "test",
+		Target:    &contestlistenerv2.InventoryTarget{Id: "t1", PrimaryIpv4: "not-an-ip"},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = client.RemoveTarget(context.Background(), connect.NewRequest(&contestlistenerv2.RemoveTargetRequest{Requestor: "test", TargetId: "t2"}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	listResp, err := client.ListTargets(context.Background(), connect.NewRequest(&contestlistenerv2.ListTargetsRequest{Requestor: "test", Selector: "board=tioga,!broken"}))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Msg.Targets))
+	require.Equal(t, map[string]string{"board": "tioga"}, listResp.Msg.Targets[0].Labels)
+
+	_, err = client.ListTargets(context.Background(), connect.NewRequest(&contestlistenerv2.ListTargetsRequest{Requestor: "test", Selector: "board=tio ga"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestV2Quarantine(t *testing.T) {
+	quarantineTime := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		switch msg := ev.Msg.(type) {
+		case api.EventQuarantineTargetMsg:
+			require.Equal(t, "t1", msg.TargetID)
+			require.Equal(t, "bricked", msg.Reason)
+			return &api.EventResponse{Quarantines: []health.Quarantine{{
+				TargetID:  msg.TargetID,
+				Reason:    msg.Reason,
+				Requestor: string(msg.Requestor()),
+				Time:      quarantineTime,
+			}}}
+		case api.EventReleaseTargetMsg:
+			return &api.EventResponse{Err: fmt.Errorf("could not release target %q: %w", msg.TargetID, health.ErrNotQuarantined)}
+		case api.EventListQuarantinedTargetsMsg:
+			return &api.EventResponse{Quarantines: []health.Quarantine{{TargetID: "t1", Reason: "bricked", Time: quarantineTime}}}
+		}
+		return &api.EventResponse{Err: errors.New("unexpected request")}
+	})
+
+	quarantineResp, err := client.QuarantineTarget(context.Background(), connect.NewRequest(&contestlistenerv2.QuarantineTargetRequest{
+		Requestor: "test",
+		TargetId:  "t1",
+		Reason:    "bricked",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "t1", quarantineResp.Msg.Quarantine.TargetId)
+	require.Equal(t, "test", quarantineResp.Msg.Quarantine.Requestor)
+	require.Equal(t, "2022-01-02T03:04:05Z", quarantineResp.Msg.Quarantine.Time)
+	require.Empty(t, quarantineResp.Msg.Quarantine.ReleaseTime)
+
+	_, err = client.QuarantineTarget(context.Background(), connect.NewRequest(&contestlistenerv2.QuarantineTargetRequest{Requestor: "test", TargetId: "t1"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = client.ReleaseTarget(context.Background(), connect.NewRequest(&contestlistenerv2.ReleaseTargetRequest{Requestor: "test", TargetId: "t2", Reason: "fixed"}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+	listResp, err := client.ListQuarantinedTargets(context.Background(), connect.NewRequest(&contestlistenerv2.ListQuarantinedTargetsRequest{Requestor: "test"}))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Msg.Quarantines))
+	require.Equal(t, "bricked", listResp.Msg.Quarantines[0].Reason)
+}
+
+func TestV2Locks(t *testing.T) {
+	lockTime := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	lock := target.Lock{TargetID: "t1", JobID: 42, CreatedAt: lockTime, ExpiresAt: lockTime.Add(time.Minute)}
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		switch msg := ev.Msg.(type) {
+		case api.EventListLocksMsg:
+			require.Equal(t, []string{"t1"}, msg.TargetIDs)
+			return &api.EventResponse{Locks: []target.Lock{lock}}
+		case api.EventForceUnlockMsg:
+			require.Equal(t, "stale", msg.Reason)
+			if msg.TargetIDs[0] != "t1" {
+				return &api.EventResponse{Err: fmt.Errorf("target %q is not locked", msg.TargetIDs[0])}
+			}
+			return &api.EventResponse{Locks: []target.Lock{lock}}
+		}
+		return &api.EventResponse{Err: errors.New("unexpected request")}
+	})
+
+	listResp, err := client.ListLocks(context.Background(), connect.NewRequest(&contestlistenerv2.ListLocksRequest{Requestor: "test", TargetIds: []string{"t1"}}))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Msg.Locks))
+	require.Equal(t, "t1", listResp.Msg.Locks[0].TargetId)
+	require.Equal(t, int64(42), listResp.Msg.Locks[0].JobId)
+	require.Equal(t, "2022-01-02T03:04:05Z", listResp.Msg.Locks[0].CreatedAt)
+	require.Equal(t, "2022-01-02T03:05:05Z", listResp.Msg.Locks[0].ExpiresAt)
+
+	_, err = client.ForceUnlock(context.Background(), connect.NewRequest(&contestlistenerv2.ForceUnlockRequest{Requestor: "test", TargetIds: []string{"t1"}}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	unlockResp, err := client.ForceUnlock(context.Background(), connect.NewRequest(&contestlistenerv2.ForceUnlockRequest{
+		Requestor: "test",
+		TargetIds: []string{"t1"},
+		Reason:    "stale",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(unlockResp.Msg.Locks))
+	require.Equal(t, int64(42), unlockResp.Msg.Locks[0].JobId)
+
+	_, err = client.ForceUnlock(context.Background(), connect.NewRequest(&contestlistenerv2.ForceUnlockRequest{
+		Requestor: "test",
+		TargetIds: []string{"t2"},
+		Reason:    "stale",
+	}))
+	require.Error(t, err)
+}
+
+func TestV2ErrorCodes(t *testing.T) {
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		switch msg := ev.Msg.(type) {
+		case api.EventStatusMsg:
+			if msg.JobID == 1 {
+				return &api.EventResponse{Err: fmt.Errorf("%w: \"bob\" cannot status a job requested by \"alice\"", api.ErrPermissionDenied)}
+			}
+			return &api.EventResponse{Err: fmt.Errorf("failed to fetch request for job ID %d: %w", msg.JobID, storage.ErrJobNotFound)}
+		case api.EventArtifactMsg:
+			return &api.EventResponse{Err: fmt.Errorf("%w: job %d has no artifact %d", artifact.ErrNotFound, msg.JobID, msg.ArtifactID)}
+		}
+		return &api.EventResponse{Err: errors.New("unexpected request")}
+	})
+
+	_, err := client.StatusJob(context.Background(), connect.NewRequest(&contestlistenerv2.StatusJobRequest{Requestor: "bob", JobId: 1}))
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = client.StatusJob(context.Background(), connect.NewRequest(&contestlistenerv2.StatusJobRequest{Requestor: "bob", JobId: 2}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = client.GetArtifact(context.Background(), connect.NewRequest(&contestlistenerv2.GetArtifactRequest{Requestor: "bob", JobId: 2, ArtifactId: 3}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = client.PauseJob(context.Background(), connect.NewRequest(&contestlistenerv2.PauseJobRequest{Requestor: "bob", JobId: 2}))
+	require.Equal(t, connect.CodeUnknown, connect.CodeOf(err))
+}
+
+func TestV2QueryEvents(t *testing.T) {
+	client := newTestClient(t, func(ev *api.Event) *api.EventResponse {
+		msg := ev.Msg.(api.EventEventsMsg)
+		require.Nil(t, msg.FrameworkQuery)
+		query, err := msg.TestQuery.BuildQuery()
+		require.NoError(t, err)
+		require.Equal(t, types.JobID(42), query.JobID)
+		require.Equal(t, "host1", query.TargetID)
+		require.Equal(t, "error", query.PayloadContains)
+		require.Equal(t, uint64(7), query.AfterSequenceID)
+		require.Equal(t, uint(api.DefaultEventsLimit), query.Limit)
+		return &api.EventResponse{TestEvents: []testevent.Event{{
+			SequenceID: 8,
+			Header:     &testevent.Header{JobID: 42, TestName: "test"},
+			Data:       &testevent.Data{EventName: "Error", Target: &target.Target{ID: "host1"}},
+		}}}
+	})
+
+	resp, err := client.QueryEvents(context.Background(), connect.NewRequest(&contestlistenerv2.QueryEventsRequest{
+		Requestor:                "test",
+		JobId:                    42,
+		TargetId:                 "host1",
+		PayloadContains:          "error",
+		AfterTestEventSequenceId: 7,
+		Kinds:                    []contestlistenerv2.EventKind{contestlistenerv2.EventKind_EVENT_KIND_TEST},
+	}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.FrameworkEvents)
+	require.Len(t, resp.Msg.TestEvents, 1)
+	require.Equal(t, uint64(8), resp.Msg.TestEvents[0].SequenceId)
+	require.Equal(t, "host1", resp.Msg.TestEvents[0].Target.Id)
+
+	_, err = client.QueryEvents(context.Background(), connect.NewRequest(&contestlistenerv2.QueryEventsRequest{
+		Requestor: "test",
+		JobId:     42,
+		Limit:     api.MaxEventsLimit + 1,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
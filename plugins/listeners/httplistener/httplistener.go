@@ -7,16 +7,22 @@ package httplistener
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/linuxboot/contest/pkg/api"
 	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/types"
@@ -26,11 +32,29 @@ import (
 // HTTPListener implements the api.Listener interface.
 type HTTPListener struct {
 	listenAddr string
+	tlsConfig  *tls.Config
+}
+
+// Option is an additional argument to New to change the behavior of the
+// listener.
+type Option func(*HTTPListener)
+
+// WithTLSConfig serves the API over TLS with the given configuration, which
+// must contain the server certificate. To authenticate the requestors with
+// client certificates, it has to require and verify them as well.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(h *HTTPListener) {
+		h.tlsConfig = cfg
+	}
 }
 
 // New instantiates a new httplistener object.
-func New(listenAddr string) *HTTPListener {
-	return &HTTPListener{listenAddr: listenAddr}
+func New(listenAddr string, opts ...Option) *HTTPListener {
+	h := &HTTPListener{listenAddr: listenAddr}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // HTTPAPIResponse is returned when an API method succeeds. It wraps the content
@@ -70,6 +94,17 @@ type HTTPAPIError struct {
 	Msg string
 }
 
+func strToScheduleID(s string) (types.ScheduleID, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, errors.New("schedule ID cannot be empty")
+	}
+	scheduleIDInt, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return types.ScheduleID(scheduleIDInt), nil
+}
+
 func strToJobID(s string) (types.JobID, error) {
 	if strings.TrimSpace(s) == "" {
 		return 0, errors.New("job ID cannot be empty")
@@ -81,6 +116,277 @@ func strToJobID(s string) (types.JobID, error) {
 	return types.JobID(jobIDInt), nil
 }
 
+// parseOptionalBool parses a boolean form value, an empty value means false.
+func parseOptionalBool(s string) (bool, error) {
+	if strings.TrimSpace(s) == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+// parseLogsRequest parses the sequence ID to follow the log from and the
+// maximum number of entries of a logs request.
+func parseLogsRequest(r *http.Request) (uint64, uint, error) {
+	var (
+		afterSequenceID uint64
+		limit           uint64
+		err             error
+	)
+	if s := r.PostFormValue("afterSequenceID"); s != "" {
+		if afterSequenceID, err = strconv.ParseUint(s, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid afterSequenceID: %w", err)
+		}
+	}
+	if s := r.PostFormValue("limit"); s != "" {
+		if limit, err = strconv.ParseUint(s, 10, 32); err != nil {
+			return 0, 0, fmt.Errorf("invalid limit: %w", err)
+		}
+	}
+	return afterSequenceID, uint(limit), nil
+}
+
+// parseTargetRequest parses the JSON-encoded inventory target of an
+// addtarget or updatetarget request.
+func parseTargetRequest(r *http.Request) (inventory.Target, error) {
+	var t inventory.Target
+	targetStr := r.PostFormValue("target")
+	if targetStr == "" {
+		return t, errors.New("missing target")
+	}
+	if err := json.Unmarshal([]byte(targetStr), &t); err != nil {
+		return t, fmt.Errorf("invalid target: %w", err)
+	}
+	return t, nil
+}
+
+// maxTailWait bounds how long a tail request waits for new events, to reply
+// within the write timeout of the server.
+const maxTailWait = 5 * time.Second
+
+// parseTailRequest parses the filter, the cursor and the wait time of a tail
+// request.
+func parseTailRequest(r *http.Request) (stream.Filter, stream.Cursor, time.Duration, error) {
+	var (
+		filter stream.Filter
+		cursor stream.Cursor
+		wait   = maxTailWait
+	)
+	if runIDStr := r.PostFormValue("runID"); runIDStr != "" {
+		runID, err := strconv.ParseUint(runIDStr, 10, 64)
+		if err != nil {
+			return filter, cursor, 0, fmt.Errorf("invalid run ID: %w", err)
+		}
+		filter.RunID = types.RunID(runID)
+	}
+	filter.TestName = r.PostFormValue("testName")
+	filter.TestStepLabel = r.PostFormValue("stepLabel")
+	filter.TargetID = r.PostFormValue("targetID")
+	if namesStr := r.PostFormValue("eventNames"); namesStr != "" {
+		for _, name := range strings.Split(namesStr, ",") {
+			filter.EventNames = append(filter.EventNames, event.Name(name))
+		}
+	}
+	for param, id := range map[string]*uint64{
+		"testEventSequenceID":      &cursor.TestEventSequenceID,
+		"frameworkEventSequenceID": &cursor.FrameworkEventSequenceID,
+	} {
+		if s := r.PostFormValue(param); s != "" {
+			v, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return filter, cursor, 0, fmt.Errorf("invalid %s: %w", param, err)
+			}
+			*id = v
+		}
+	}
+	if waitStr := r.PostFormValue("wait"); waitStr != "" {
+		d, err := time.ParseDuration(waitStr)
+		if err != nil {
+			return filter, cursor, 0, fmt.Errorf("invalid wait: %w", err)
+		}
+		if d < 0 || d > maxTailWait {
+			return filter, cursor, 0, fmt.Errorf("invalid wait %s, must be between 0 and %s", d, maxTailWait)
+		}
+		wait = d
+	}
+	return filter, cursor, wait, nil
+}
+
+// parseTargetIDs parses the comma-separated "targetIDs" parameter.
+func parseTargetIDs(r *http.Request) []string {
+	targetIDsStr := r.PostFormValue("targetIDs")
+	if len(targetIDsStr) == 0 {
+		return nil
+	}
+	return strings.Split(targetIDsStr, ",")
+}
+
+// parseListRequest parses the job query of a list request. The requestor of
+// the jobs is matched by the "jobRequestor" parameter, since "requestor"
+// identifies the client.
+func parseListRequest(r *http.Request) (*storage.JobQuery, error) {
+	var fields []storage.JobQueryField
+	if statesStr := r.PostFormValue("states"); len(statesStr) > 0 {
+		var states []job.State
+		for _, sts := range strings.Split(statesStr, ",") {
+			st, err := job.EventNameToJobState(event.Name(sts))
+			if err != nil {
+				return nil, err
+			}
+			states = append(states, st)
+		}
+		fields = append(fields, storage.QueryJobStates(states...))
+	}
+	if tagsStr := r.PostFormValue("tags"); len(tagsStr) > 0 {
+		fields = append(fields, storage.QueryJobTags(strings.Split(tagsStr, ",")...))
+	}
+	for param, field := range map[string]func(string) storage.JobQueryField{
+		"serverID":     storage.QueryJobServerID,
+		"jobRequestor": storage.QueryJobRequestor,
+		"namePattern":  storage.QueryJobNamePattern,
+	} {
+		if s := r.PostFormValue(param); s != "" {
+			fields = append(fields, field(s))
+		}
+	}
+	for param, field := range map[string]func(time.Time) storage.JobQueryField{
+		"requestedAfter":  storage.QueryJobRequestedAfter,
+		"requestedBefore": storage.QueryJobRequestedBefore,
+		"endedAfter":      storage.QueryJobEndedAfter,
+		"endedBefore":     storage.QueryJobEndedBefore,
+	} {
+		if s := r.PostFormValue(param); s != "" {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", param, err)
+			}
+			fields = append(fields, field(t))
+		}
+	}
+	newestFirst, err := parseOptionalBool(r.PostFormValue("newestFirst"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid newestFirst: %w", err)
+	}
+	if newestFirst {
+		fields = append(fields, storage.QueryJobsNewestFirst())
+	}
+	if s := r.PostFormValue("afterJobID"); s != "" {
+		jobID, err := strToJobID(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid afterJobID: %w", err)
+		}
+		if jobID != 0 {
+			fields = append(fields, storage.QueryJobAfterJobID(jobID))
+		}
+	}
+	// A limit of 0 means no limit.
+	if s := r.PostFormValue("limit"); s != "" {
+		limit, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit: %w", err)
+		}
+		if limit != 0 {
+			fields = append(fields, storage.QueryJobLimit(uint(limit)))
+		}
+	}
+	return storage.BuildJobQuery(fields...)
+}
+
+// parseEventsRequest parses the queries of an events request. The kinds of
+// events to return are selected by the "kinds" parameter, both by default.
+// Filters on the test header, target and payload only apply to test events.
+func parseEventsRequest(r *http.Request, jobID types.JobID) (testevent.QueryFields, frameworkevent.QueryFields, error) {
+	var (
+		testQuery      = testevent.QueryFields{testevent.QueryJobID(jobID)}
+		frameworkQuery = frameworkevent.QueryFields{frameworkevent.QueryJobID(jobID)}
+	)
+	if namesStr := r.PostFormValue("eventNames"); namesStr != "" {
+		var names []event.Name
+		for _, name := range strings.Split(namesStr, ",") {
+			names = append(names, event.Name(name))
+		}
+		testQuery = append(testQuery, testevent.QueryEventNames(names))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryEventNames(names))
+	}
+	if s := r.PostFormValue("emittedStartTime"); s != "" {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid emittedStartTime: %w", err)
+		}
+		testQuery = append(testQuery, testevent.QueryEmittedStartTime(t))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryEmittedStartTime(t))
+	}
+	if s := r.PostFormValue("emittedEndTime"); s != "" {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid emittedEndTime: %w", err)
+		}
+		testQuery = append(testQuery, testevent.QueryEmittedEndTime(t))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryEmittedEndTime(t))
+	}
+	if s := r.PostFormValue("limit"); s != "" {
+		limit, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid limit: %w", err)
+		}
+		testQuery = append(testQuery, testevent.QueryLimit(uint(limit)))
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryLimit(uint(limit)))
+	}
+	if s := r.PostFormValue("testEventSequenceID"); s != "" {
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid testEventSequenceID: %w", err)
+		}
+		testQuery = append(testQuery, testevent.QueryAfterSequenceID(id))
+	}
+	if s := r.PostFormValue("frameworkEventSequenceID"); s != "" {
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid frameworkEventSequenceID: %w", err)
+		}
+		frameworkQuery = append(frameworkQuery, frameworkevent.QueryAfterSequenceID(id))
+	}
+	if runIDStr := r.PostFormValue("runID"); runIDStr != "" {
+		runID, err := strconv.ParseUint(runIDStr, 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid run ID: %w", err)
+		}
+		testQuery = append(testQuery, testevent.QueryRunID(types.RunID(runID)))
+	}
+	for param, field := range map[string]func(string) testevent.QueryField{
+		"testName":        testevent.QueryTestName,
+		"stepLabel":       testevent.QueryTestStepLabel,
+		"targetID":        testevent.QueryTargetID,
+		"payloadContains": testevent.QueryPayloadContains,
+	} {
+		if s := r.PostFormValue(param); s != "" {
+			testQuery = append(testQuery, field(s))
+		}
+	}
+
+	kindsStr := r.PostFormValue("kinds")
+	if kindsStr == "" {
+		return testQuery, frameworkQuery, nil
+	}
+	var withTest, withFramework bool
+	for _, kind := range strings.Split(kindsStr, ",") {
+		switch kind {
+		case "test":
+			withTest = true
+		case "framework":
+			withFramework = true
+		default:
+			return nil, nil, fmt.Errorf("invalid kind of events %q, must be test or framework", kind)
+		}
+	}
+	if !withTest {
+		testQuery = nil
+	}
+	if !withFramework {
+		frameworkQuery = nil
+	}
+	return testQuery, frameworkQuery, nil
+}
+
 type apiHandler struct {
 	ctx xcontext.Context
 	api *api.API
@@ -111,7 +417,21 @@ func (h *apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	jobDesc := r.PostFormValue("jobDesc")
 	requestor := api.EventRequestor(r.PostFormValue("requestor"))
 
-	ctx := h.ctx.WithTags(xcontext.Fields{
+	// The API methods authenticate the requestor on their own, this is just
+	// to reply with the appropriate status.
+	if verb != "version" {
+		if requestor, err = h.api.Authenticate(r.Context(), requestor); err != nil {
+			status := http.StatusUnauthorized
+			if errors.Is(err, api.ErrPermissionDenied) {
+				status = http.StatusForbidden
+			}
+			msg, _ := json.Marshal(HTTPAPIError{Msg: err.Error()})
+			h.reply(w, status, string(msg))
+			return
+		}
+	}
+
+	ctx := xcontext.WithStdContext(h.ctx, r.Context()).WithTags(xcontext.Fields{
 		"http_verb":      verb,
 		"http_requestor": requestor,
 	}).WithField("http_job_id", jobIDStr)
@@ -127,6 +447,16 @@ func (h *apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			httpStatus = http.StatusBadRequest
 			errMsg = fmt.Sprintf("Start failed: %v", err)
 		}
+	case "validate":
+		if jobDesc == "" {
+			httpStatus = http.StatusBadRequest
+			errMsg = "Missing job description"
+			break
+		}
+		if resp, err = h.api.Validate(ctx, requestor, jobDesc); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Validate failed: %v", err)
+		}
 	case "status":
 		jobID, err := strToJobID(jobIDStr)
 		if err != nil {
@@ -149,6 +479,28 @@ func (h *apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			httpStatus = http.StatusBadRequest
 			errMsg = fmt.Sprintf("Stop failed: %v", err)
 		}
+	case "pause":
+		jobID, err := strToJobID(jobIDStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Pause failed: %v", err)
+			break
+		}
+		if resp, err = h.api.Pause(ctx, requestor, jobID); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Pause failed: %v", err)
+		}
+	case "resume":
+		jobID, err := strToJobID(jobIDStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Resume failed: %v", err)
+			break
+		}
+		if resp, err = h.api.Resume(ctx, requestor, jobID); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Resume failed: %v", err)
+		}
 	case "retry":
 		jobID, err := strToJobID(jobIDStr)
 		if err != nil {
@@ -156,29 +508,23 @@ func (h *apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			errMsg = fmt.Sprintf("Retry failed: %v", err)
 			break
 		}
-		if resp, err = h.api.Retry(ctx, requestor, jobID); err != nil {
+		var opts api.RetryOptions
+		if opts.OnlyFailedTests, err = parseOptionalBool(r.PostFormValue("onlyFailedTests")); err != nil {
 			httpStatus = http.StatusBadRequest
 			errMsg = fmt.Sprintf("Retry failed: %v", err)
+			break
 		}
-	case "list":
-		var fields []storage.JobQueryField
-		if statesStr := r.PostFormValue("states"); len(statesStr) > 0 {
-			var states []job.State
-			for _, sts := range strings.Split(statesStr, ",") {
-				st, err := job.EventNameToJobState(event.Name(sts))
-				if err != nil {
-					httpStatus = http.StatusBadRequest
-					errMsg = fmt.Sprintf("List failed: %v", err)
-					break
-				}
-				states = append(states, st)
-			}
-			fields = append(fields, storage.QueryJobStates(states...))
+		if opts.OnlyFailedTargets, err = parseOptionalBool(r.PostFormValue("onlyFailedTargets")); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Retry failed: %v", err)
+			break
 		}
-		if tagsStr := r.PostFormValue("tags"); len(tagsStr) > 0 {
-			fields = append(fields, storage.QueryJobTags(strings.Split(tagsStr, ",")...))
+		if resp, err = h.api.Retry(ctx, requestor, jobID, opts); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Retry failed: %v", err)
 		}
-		jobQuery, err := storage.BuildJobQuery(fields...)
+	case "list":
+		jobQuery, err := parseListRequest(r)
 		if err != nil {
 			httpStatus = http.StatusBadRequest
 			errMsg = fmt.Sprintf("Invalid query: %v", err)
@@ -188,6 +534,200 @@ func (h *apiHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			httpStatus = http.StatusBadRequest
 			errMsg = fmt.Sprintf("List failed: %v", err)
 		}
+	case "schedule":
+		if jobDesc == "" {
+			httpStatus = http.StatusBadRequest
+			errMsg = "Missing job description"
+			break
+		}
+		cronExpr := r.PostFormValue("cron")
+		if cronExpr == "" {
+			httpStatus = http.StatusBadRequest
+			errMsg = "Missing cron expression"
+			break
+		}
+		skipIfRunning, err := parseOptionalBool(r.PostFormValue("skipIfRunning"))
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Schedule failed: %v", err)
+			break
+		}
+		if resp, err = h.api.Schedule(ctx, requestor, jobDesc, cronExpr, skipIfRunning); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Schedule failed: %v", err)
+		}
+	case "unschedule":
+		scheduleID, err := strToScheduleID(r.PostFormValue("scheduleID"))
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Unschedule failed: %v", err)
+			break
+		}
+		if resp, err = h.api.Unschedule(ctx, requestor, scheduleID); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Unschedule failed: %v", err)
+		}
+	case "listschedules":
+		if resp, err = h.api.ListSchedules(ctx, requestor); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("ListSchedules failed: %v", err)
+		}
+	case "junit":
+		jobID, err := strToJobID(jobIDStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("JUnit failed: %v", err)
+			break
+		}
+		if resp, err = h.api.JUnit(ctx, requestor, jobID); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("JUnit failed: %v", err)
+			break
+		}
+		// Serve the document itself for downloading, rather than wrapped in
+		// the API response.
+		if r.PostFormValue("format") == "xml" && resp.Err == nil {
+			w.Header().Set("Content-Type", "application/xml")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"job-%d-junit.xml\"", jobID))
+			h.reply(w, http.StatusOK, resp.Data.(api.ResponseDataJUnit).XML)
+			return
+		}
+	case "artifact":
+		jobID, err := strToJobID(jobIDStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Artifact failed: %v", err)
+			break
+		}
+		artifactID, err := strconv.ParseUint(r.PostFormValue("artifactID"), 10, 64)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Artifact failed: invalid artifact ID: %v", err)
+			break
+		}
+		if resp, err = h.api.Artifact(ctx, requestor, jobID, artifactID); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Artifact failed: %v", err)
+			break
+		}
+		// Serve the content itself for downloading, rather than base64
+		// encoded in the API response.
+		if r.PostFormValue("format") == "raw" && resp.Err == nil {
+			data := resp.Data.(api.ResponseDataArtifact)
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", path.Base(data.Artifact.Name)))
+			h.reply(w, http.StatusOK, string(data.Content))
+			return
+		}
+	case "logs":
+		jobID, err := strToJobID(jobIDStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Logs failed: %v", err)
+			break
+		}
+		afterSequenceID, limit, err := parseLogsRequest(r)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Logs failed: %v", err)
+			break
+		}
+		if resp, err = h.api.Logs(ctx, requestor, jobID, afterSequenceID, limit); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Logs failed: %v", err)
+			break
+		}
+	case "tail":
+		jobID, err := strToJobID(jobIDStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Tail failed: %v", err)
+			break
+		}
+		filter, cursor, wait, err := parseTailRequest(r)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Tail failed: %v", err)
+			break
+		}
+		if resp, err = h.api.Tail(ctx, requestor, jobID, filter, cursor, wait); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Tail failed: %v", err)
+		}
+	case "events":
+		jobID, err := strToJobID(jobIDStr)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Events failed: %v", err)
+			break
+		}
+		testQuery, frameworkQuery, err := parseEventsRequest(r, jobID)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Events failed: %v", err)
+			break
+		}
+		if resp, err = h.api.QueryEvents(ctx, requestor, testQuery, frameworkQuery); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("Events failed: %v", err)
+		}
+	case "addtarget":
+		t, err := parseTargetRequest(r)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("AddTarget failed: %v", err)
+			break
+		}
+		if resp, err = h.api.AddTarget(ctx, requestor, t); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("AddTarget failed: %v", err)
+		}
+	case "updatetarget":
+		t, err := parseTargetRequest(r)
+		if err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("UpdateTarget failed: %v", err)
+			break
+		}
+		if resp, err = h.api.UpdateTarget(ctx, requestor, t); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("UpdateTarget failed: %v", err)
+		}
+	case "removetarget":
+		if resp, err = h.api.RemoveTarget(ctx, requestor, r.PostFormValue("targetID")); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("RemoveTarget failed: %v", err)
+		}
+	case "listtargets":
+		if resp, err = h.api.ListTargets(ctx, requestor, r.PostFormValue("selector")); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("ListTargets failed: %v", err)
+		}
+	case "quarantine":
+		if resp, err = h.api.QuarantineTarget(ctx, requestor, r.PostFormValue("targetID"), r.PostFormValue("reason")); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("QuarantineTarget failed: %v", err)
+		}
+	case "release":
+		if resp, err = h.api.ReleaseTarget(ctx, requestor, r.PostFormValue("targetID"), r.PostFormValue("reason")); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("ReleaseTarget failed: %v", err)
+		}
+	case "quarantined":
+		if resp, err = h.api.ListQuarantinedTargets(ctx, requestor); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("ListQuarantinedTargets failed: %v", err)
+		}
+	case "locks":
+		if resp, err = h.api.ListLocks(ctx, requestor, parseTargetIDs(r)); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("ListLocks failed: %v", err)
+		}
+	case "forceunlock":
+		if resp, err = h.api.ForceUnlock(ctx, requestor, parseTargetIDs(r), r.PostFormValue("reason")); err != nil {
+			httpStatus = http.StatusBadRequest
+			errMsg = fmt.Sprintf("ForceUnlock failed: %v", err)
+		}
 	case "version":
 		resp = h.api.Version()
 	default:
@@ -225,6 +765,11 @@ func listenWithCancellation(ctx xcontext.Context, s *http.Server) error {
 	// start the listener asynchronously, and report errors and completion via
 	// channels.
 	go func() {
+		if s.TLSConfig != nil {
+			// The certificates are in the TLS configuration.
+			errCh <- s.ListenAndServeTLS("", "")
+			return
+		}
 		errCh <- s.ListenAndServe()
 	}()
 	ctx.Infof("Started HTTP API listener on %s", s.Addr)
@@ -246,7 +791,8 @@ func (h *HTTPListener) Serve(ctx xcontext.Context, a *api.API) error {
 	}
 	s := http.Server{
 		Addr:         h.listenAddr,
-		Handler:      &apiHandler{ctx: ctx, api: a},
+		Handler:      api.CredentialsHandler(&apiHandler{ctx: ctx, api: a}),
+		TLSConfig:    h.tlsConfig,
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 	}
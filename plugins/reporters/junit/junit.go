@@ -0,0 +1,167 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package junit implements a reporter which renders the results of a job as a
+// JUnit XML document, so that they can be ingested by CI systems.
+package junit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/events"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/lib/junit"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// Name defines the name of the reporter used within the plugin registry
+var Name = junit.ReporterName
+
+// Parameters contains the optional parameters of the reporter, used both for
+// run and final reporting.
+type Parameters struct {
+	// Name of the testsuites element.
+	Name string
+}
+
+// JUnitReporter implements a reporter which maps every Test of a run to a
+// testsuite, and every Target going through a TestStep to a testcase. The
+// report data is the XML document; the job is successful if no testcase
+// failed.
+type JUnitReporter struct{}
+
+// ValidateRunParameters validates the parameters for the run reporter
+func (j *JUnitReporter) ValidateRunParameters(params []byte) (interface{}, error) {
+	return parseParameters(params)
+}
+
+// ValidateFinalParameters validates the parameters for the final reporter
+func (j *JUnitReporter) ValidateFinalParameters(params []byte) (interface{}, error) {
+	return parseParameters(params)
+}
+
+func parseParameters(params []byte) (Parameters, error) {
+	var p Parameters
+	if len(params) == 0 || string(params) == "null" {
+		return p, nil
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return p, fmt.Errorf("could not parse parameters: %w", err)
+	}
+	return p, nil
+}
+
+// Name returns the Name of the reporter
+func (j *JUnitReporter) Name() string {
+	return Name
+}
+
+// RunReport calculates the report to be associated with a job run.
+func (j *JUnitReporter) RunReport(ctx xcontext.Context, parameters interface{}, runStatus *job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
+	return report(parameters, *runStatus)
+}
+
+// FinalReport calculates the final report to be associated to a job.
+func (j *JUnitReporter) FinalReport(ctx xcontext.Context, parameters interface{}, runStatuses []job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
+	return report(parameters, runStatuses...)
+}
+
+func report(parameters interface{}, runStatuses ...job.RunStatus) (bool, interface{}, error) {
+	p, ok := parameters.(Parameters)
+	if !ok {
+		return false, nil, fmt.Errorf("report parameters should be of type Parameters")
+	}
+	doc := junit.TestSuites{Name: p.Name}
+	for _, runStatus := range runStatuses {
+		for _, testStatus := range runStatus.TestStatuses {
+			doc.Add(testSuite(runStatus, testStatus))
+		}
+	}
+	xml, err := doc.Marshal()
+	if err != nil {
+		return false, nil, err
+	}
+	return doc.Failures == 0, xml, nil
+}
+
+// testSuite maps a Test of a run to a testsuite.
+func testSuite(runStatus job.RunStatus, testStatus job.TestStatus) junit.TestSuite {
+	suite := junit.TestSuite{
+		Name: fmt.Sprintf("%s (run %d)", testStatus.TestName, runStatus.RunID),
+		Properties: []junit.Property{
+			{Name: "job_id", Value: runStatus.JobID.String()},
+			{Name: "run_id", Value: runStatus.RunID.String()},
+		},
+	}
+	if !runStatus.StartTime.IsZero() {
+		suite.Timestamp = runStatus.StartTime.UTC().Format(time.RFC3339)
+	}
+	for _, stepStatus := range testStatus.TestStepStatuses {
+		for _, targetStatus := range stepStatus.TargetStatuses {
+			suite.Add(testCase(stepStatus, targetStatus))
+		}
+	}
+	return suite
+}
+
+// testCase maps a Target going through a TestStep to a testcase.
+func testCase(stepStatus job.TestStepStatus, targetStatus job.TargetStatus) junit.TestCase {
+	tc := junit.TestCase{
+		Name: stepStatus.TestStepLabel,
+	}
+	if targetStatus.Target != nil {
+		tc.ClassName = targetStatus.Target.ID
+	}
+	switch {
+	case targetStatus.Error != "":
+		tc.Failure = &junit.Failure{Message: targetStatus.Error, Text: targetStatus.Error}
+	case targetStatus.OutTime.IsZero():
+		tc.Skipped = &junit.Skipped{Message: "target did not complete the step"}
+	}
+	if !targetStatus.InTime.IsZero() && !targetStatus.OutTime.IsZero() {
+		tc.Time = junit.Seconds(targetStatus.OutTime.Sub(targetStatus.InTime))
+	}
+
+	var stdout, stderr []string
+	for _, ev := range targetStatus.Events {
+		if ev.Data == nil || ev.Data.Payload == nil {
+			continue
+		}
+		switch ev.Data.EventName {
+		case events.EventStdout:
+			stdout = append(stdout, eventMsg(*ev.Data.Payload))
+		case events.EventStderr:
+			stderr = append(stderr, eventMsg(*ev.Data.Payload))
+		}
+	}
+	tc.SystemOut = strings.Join(stdout, "\n")
+	tc.SystemErr = strings.Join(stderr, "\n")
+	return tc
+}
+
+// eventMsg extracts the message from the payload of a Stdout or Stderr event.
+func eventMsg(payload json.RawMessage) string {
+	var p struct {
+		Msg string
+	}
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return string(payload)
+	}
+	return p.Msg
+}
+
+// New builds a new JUnitReporter
+func New() job.Reporter {
+	return &JUnitReporter{}
+}
+
+// Load returns the name and factory which are needed to register the Reporter
+func Load() (string, job.ReporterFactory) {
+	return Name, New
+}
@@ -0,0 +1,138 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package junit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/events"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/lib/junit"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+var start = time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func msgEvent(name event.Name, msg string) testevent.Event {
+	payload := json.RawMessage(`{"Msg":"` + msg + `"}`)
+	return testevent.Event{Data: &testevent.Data{EventName: name, Payload: &payload}}
+}
+
+func runStatus(runID types.RunID, failT2 bool) job.RunStatus {
+	coords := job.TestCoordinates{
+		RunCoordinates: job.RunCoordinates{JobID: 7, RunID: runID},
+		TestName:       "boot",
+	}
+	t1 := job.TargetStatus{
+		Target:  &target.Target{ID: "T1"},
+		InTime:  start,
+		OutTime: start.Add(1500 * time.Millisecond),
+		Events: []testevent.Event{
+			msgEvent(events.EventStdout, "booted"),
+			msgEvent(events.EventStdout, "login ok"),
+			msgEvent(events.EventStderr, "warning: slow"),
+		},
+	}
+	t2 := job.TargetStatus{
+		Target:  &target.Target{ID: "T2"},
+		InTime:  start,
+		OutTime: start.Add(2 * time.Second),
+	}
+	if failT2 {
+		t2.Error = "ping timed out"
+	}
+	return job.RunStatus{
+		RunCoordinates: coords.RunCoordinates,
+		StartTime:      start,
+		TestStatuses: []job.TestStatus{
+			{
+				TestCoordinates: coords,
+				TestStepStatuses: []job.TestStepStatus{
+					{
+						TestStepCoordinates: job.TestStepCoordinates{TestCoordinates: coords, TestStepLabel: "power on"},
+						TargetStatuses:      []job.TargetStatus{t1, t2},
+					},
+					{
+						TestStepCoordinates: job.TestStepCoordinates{TestCoordinates: coords, TestStepLabel: "power off"},
+						TargetStatuses: []job.TargetStatus{
+							{Target: &target.Target{ID: "T1"}, InTime: start},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRunReport(t *testing.T) {
+	r := New()
+	params, err := r.ValidateRunParameters(nil)
+	require.NoError(t, err)
+	rs := runStatus(1, true)
+	success, data, err := r.RunReport(xcontext.Background(), params, &rs, nil)
+	require.NoError(t, err)
+	require.False(t, success)
+
+	doc, err := junit.Parse(data.(string))
+	require.NoError(t, err)
+	require.Equal(t, 3, doc.Tests)
+	require.Equal(t, 1, doc.Failures)
+	require.Equal(t, 1, doc.Skipped)
+	require.Equal(t, 3.5, doc.Time)
+	require.Len(t, doc.Suites, 1)
+
+	suite := doc.Suites[0]
+	require.Equal(t, "boot (run 1)", suite.Name)
+	require.Equal(t, "2023-05-01T10:00:00Z", suite.Timestamp)
+	require.Equal(t, []junit.Property{{Name: "job_id", Value: "7"}, {Name: "run_id", Value: "1"}}, suite.Properties)
+	require.Len(t, suite.Cases, 3)
+
+	require.Equal(t, junit.TestCase{
+		Name:      "power on",
+		ClassName: "T1",
+		Time:      1.5,
+		SystemOut: "booted\nlogin ok",
+		SystemErr: "warning: slow",
+	}, suite.Cases[0])
+	require.Equal(t, junit.TestCase{
+		Name:      "power on",
+		ClassName: "T2",
+		Time:      2,
+		Failure:   &junit.Failure{Message: "ping timed out", Text: "ping timed out"},
+	}, suite.Cases[1])
+	require.Equal(t, "power off", suite.Cases[2].Name)
+	require.NotNil(t, suite.Cases[2].Skipped)
+}
+
+func TestFinalReport(t *testing.T) {
+	r := New()
+	params, err := r.ValidateFinalParameters([]byte(`{"Name": "nightly"}`))
+	require.NoError(t, err)
+	_, data, err := r.FinalReport(xcontext.Background(), params, []job.RunStatus{runStatus(1, false), runStatus(2, true)}, nil)
+	require.NoError(t, err)
+
+	doc, err := junit.Parse(data.(string))
+	require.NoError(t, err)
+	require.Equal(t, "nightly", doc.Name)
+	require.Equal(t, 6, doc.Tests)
+	require.Equal(t, 1, doc.Failures)
+	require.Len(t, doc.Suites, 2)
+	require.Equal(t, "boot (run 1)", doc.Suites[0].Name)
+	require.Equal(t, "boot (run 2)", doc.Suites[1].Name)
+}
+
+func TestInvalidParameters(t *testing.T) {
+	_, err := New().ValidateRunParameters([]byte(`{"Name": 1}`))
+	require.Error(t, err)
+}
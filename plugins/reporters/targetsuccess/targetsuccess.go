@@ -12,6 +12,7 @@ import (
 	"github.com/linuxboot/contest/pkg/event/testevent"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/lib/comparison"
+	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
 
@@ -43,6 +44,55 @@ type TargetSuccessReport struct {
 	DesiredSuccess  string
 }
 
+// SuccessRatio counts the Targets which succeeded out of all the Targets.
+type SuccessRatio struct {
+	Success uint64
+	Total   uint64
+	// Percentage of Targets which succeeded
+	Percentage float64
+}
+
+func (r *SuccessRatio) add(success, total uint64) {
+	r.Success += success
+	r.Total += total
+	if r.Total > 0 {
+		r.Percentage = float64(r.Success) / float64(r.Total) * 100
+	}
+}
+
+// TestSuccess is the success ratio of a Test.
+type TestSuccess struct {
+	TestName string
+	SuccessRatio
+}
+
+// RunSuccess is the success ratio of a run, over all of its Tests.
+type RunSuccess struct {
+	RunID types.RunID
+	SuccessRatio
+	Tests []TestSuccess
+}
+
+// TargetSuccessFinalReport wraps the final report with the success ratios
+// of the Job: per Test over all the runs, per run and overall.
+type TargetSuccessFinalReport struct {
+	TargetSuccessReport
+	Overall SuccessRatio
+	Tests   []TestSuccess
+	Runs    []RunSuccess
+}
+
+// countTargets counts the Targets which succeeded and all the Targets of a Test.
+func countTargets(testStatus job.TestStatus) (success, total uint64) {
+	for _, t := range testStatus.TargetStatuses {
+		if t.Error == "" {
+			success++
+		}
+		total++
+	}
+	return success, total
+}
+
 // ValidateRunParameters validates the parameters for the run reporter
 func (ts *TargetSuccessReporter) ValidateRunParameters(params []byte) (interface{}, error) {
 	var rp RunParameters
@@ -75,10 +125,7 @@ func (ts *TargetSuccessReporter) Name() string {
 // RunReport calculates the report to be associated with a job run.
 func (ts *TargetSuccessReporter) RunReport(ctx xcontext.Context, parameters interface{}, runStatus *job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
 
-	var (
-		success, fail uint64
-		testReports   []string
-	)
+	var testReports []string
 
 	runSuccess := true
 
@@ -89,26 +136,15 @@ func (ts *TargetSuccessReporter) RunReport(ctx xcontext.Context, parameters inte
 
 	// Flag the run as successful only if all Tests within the Run where successful
 	for _, t := range runStatus.TestStatuses {
-		fail = 0
-		success = 0
-
-		for _, t := range t.TargetStatuses {
-			if t.Error != "" {
-				fail++
-			} else {
-				success++
-			}
-
-		}
-
-		if success+fail == 0 {
+		success, total := countTargets(t)
+		if total == 0 {
 			return false, nil, fmt.Errorf("overall count of success and failures is zero for test %s", t.TestCoordinates.TestName)
 		}
 		cmpExpr, err := comparison.ParseExpression(reportParameters.SuccessExpression)
 		if err != nil {
 			return false, nil, fmt.Errorf("error while calculating run report for test %s: %v", t.TestCoordinates.TestName, err)
 		}
-		res, err := cmpExpr.EvaluateSuccess(success, success+fail)
+		res, err := cmpExpr.EvaluateSuccess(success, total)
 		if err != nil {
 			return false, nil, fmt.Errorf("error while calculating run report for test %s: %v", t.TestCoordinates.TestName, err)
 		}
@@ -125,8 +161,66 @@ func (ts *TargetSuccessReporter) RunReport(ctx xcontext.Context, parameters inte
 }
 
 // FinalReport calculates the final report to be associated to a job.
+// The success ratio of the Targets is aggregated over all the Tests of all
+// the runs. Percentage expressions are evaluated against the overall ratio,
+// value expressions against the average number of successful Targets per run.
 func (ts *TargetSuccessReporter) FinalReport(ctx xcontext.Context, parameters interface{}, runStatuses []job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
-	return false, nil, fmt.Errorf("final reporting not implemented yet in %s", Name)
+	reportParameters, ok := parameters.(FinalParameters)
+	if !ok {
+		return false, nil, fmt.Errorf("report parameters should be of type FinalParameters")
+	}
+	if len(runStatuses) == 0 {
+		return false, nil, fmt.Errorf("no runs to calculate the final report from")
+	}
+
+	var report TargetSuccessFinalReport
+	testIndices := make(map[string]int)
+	for _, runStatus := range runStatuses {
+		runSuccess := RunSuccess{RunID: runStatus.RunID}
+		for _, t := range runStatus.TestStatuses {
+			success, total := countTargets(t)
+			testSuccess := TestSuccess{TestName: t.TestName}
+			testSuccess.add(success, total)
+			runSuccess.Tests = append(runSuccess.Tests, testSuccess)
+			runSuccess.add(success, total)
+
+			idx, ok := testIndices[t.TestName]
+			if !ok {
+				idx = len(report.Tests)
+				testIndices[t.TestName] = idx
+				report.Tests = append(report.Tests, TestSuccess{TestName: t.TestName})
+			}
+			report.Tests[idx].add(success, total)
+		}
+		report.Runs = append(report.Runs, runSuccess)
+		report.Overall.add(runSuccess.Success, runSuccess.Total)
+	}
+	if report.Overall.Total == 0 {
+		return false, nil, fmt.Errorf("overall count of success and failures is zero for all runs")
+	}
+
+	cmpExpr, err := comparison.ParseExpression(reportParameters.AverageSuccessExpression)
+	if err != nil {
+		return false, nil, fmt.Errorf("error while calculating final report: %v", err)
+	}
+	var res *comparison.Result
+	if cmpExpr.Type == comparison.TypeValue {
+		res = cmpExpr.Evaluate(float64(report.Overall.Success) / float64(len(runStatuses)))
+	} else {
+		res, err = cmpExpr.EvaluateSuccess(report.Overall.Success, report.Overall.Total)
+		if err != nil {
+			return false, nil, fmt.Errorf("error while calculating final report: %v", err)
+		}
+	}
+
+	report.AchievedSuccess = res.LHS
+	report.DesiredSuccess = res.RHS
+	if res.Pass {
+		report.Message = fmt.Sprintf("Job passes average success criteria over %d runs: %s", len(runStatuses), res.Expr)
+	} else {
+		report.Message = fmt.Sprintf("Job does not pass average success criteria over %d runs: %s", len(runStatuses), res.Expr)
+	}
+	return res.Pass, report, nil
 }
 
 // New builds a new TargetSuccessReporter
@@ -0,0 +1,106 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package targetsuccess
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+func testStatus(runID types.RunID, testName string, failed ...bool) job.TestStatus {
+	ts := job.TestStatus{
+		TestCoordinates: job.TestCoordinates{
+			RunCoordinates: job.RunCoordinates{JobID: 1, RunID: runID},
+			TestName:       testName,
+		},
+	}
+	for i, f := range failed {
+		status := job.TargetStatus{Target: &target.Target{ID: string(rune('A' + i))}}
+		if f {
+			status.Error = "failed"
+		}
+		ts.TargetStatuses = append(ts.TargetStatuses, status)
+	}
+	return ts
+}
+
+func runStatuses() []job.RunStatus {
+	return []job.RunStatus{
+		{
+			RunCoordinates: job.RunCoordinates{JobID: 1, RunID: 1},
+			TestStatuses: []job.TestStatus{
+				testStatus(1, "boot", false, false),
+				testStatus(1, "stress", false, true),
+			},
+		},
+		{
+			RunCoordinates: job.RunCoordinates{JobID: 1, RunID: 2},
+			TestStatuses: []job.TestStatus{
+				testStatus(2, "boot", false, false),
+				testStatus(2, "stress", false, false),
+			},
+		},
+	}
+}
+
+func finalReport(t *testing.T, expr string) (bool, TargetSuccessFinalReport) {
+	ts := &TargetSuccessReporter{}
+	params, err := ts.ValidateFinalParameters([]byte(`{"AverageSuccessExpression": "` + expr + `"}`))
+	require.NoError(t, err)
+	pass, report, err := ts.FinalReport(xcontext.Background(), params, runStatuses(), nil)
+	require.NoError(t, err)
+	require.IsType(t, TargetSuccessFinalReport{}, report)
+	return pass, report.(TargetSuccessFinalReport)
+}
+
+func TestFinalReport(t *testing.T) {
+	pass, report := finalReport(t, ">=80%")
+	require.True(t, pass)
+	require.Equal(t, "87.50%", report.AchievedSuccess)
+	require.Equal(t, "80.00%", report.DesiredSuccess)
+	require.Equal(t, SuccessRatio{Success: 7, Total: 8, Percentage: 87.5}, report.Overall)
+
+	require.Len(t, report.Tests, 2)
+	require.Equal(t, "boot", report.Tests[0].TestName)
+	require.Equal(t, SuccessRatio{Success: 4, Total: 4, Percentage: 100}, report.Tests[0].SuccessRatio)
+	require.Equal(t, "stress", report.Tests[1].TestName)
+	require.Equal(t, SuccessRatio{Success: 3, Total: 4, Percentage: 75}, report.Tests[1].SuccessRatio)
+
+	require.Len(t, report.Runs, 2)
+	require.Equal(t, types.RunID(1), report.Runs[0].RunID)
+	require.Equal(t, SuccessRatio{Success: 3, Total: 4, Percentage: 75}, report.Runs[0].SuccessRatio)
+	require.Len(t, report.Runs[0].Tests, 2)
+	require.Equal(t, SuccessRatio{Success: 1, Total: 2, Percentage: 50}, report.Runs[0].Tests[1].SuccessRatio)
+	require.Equal(t, types.RunID(2), report.Runs[1].RunID)
+	require.Equal(t, SuccessRatio{Success: 4, Total: 4, Percentage: 100}, report.Runs[1].SuccessRatio)
+
+	pass, _ = finalReport(t, "=100%")
+	require.False(t, pass)
+}
+
+func TestFinalReportValueExpression(t *testing.T) {
+	// 7 successful targets over 2 runs is 3.5 per run on average.
+	pass, report := finalReport(t, ">3")
+	require.True(t, pass)
+	require.Equal(t, "3.50", report.AchievedSuccess)
+
+	pass, _ = finalReport(t, ">=4")
+	require.False(t, pass)
+}
+
+func TestFinalReportNoTargets(t *testing.T) {
+	ts := &TargetSuccessReporter{}
+	_, _, err := ts.FinalReport(xcontext.Background(), FinalParameters{AverageSuccessExpression: ">50%"}, []job.RunStatus{{}}, nil)
+	require.Error(t, err)
+	_, _, err = ts.FinalReport(xcontext.Background(), FinalParameters{AverageSuccessExpression: ">50%"}, nil, nil)
+	require.Error(t, err)
+}
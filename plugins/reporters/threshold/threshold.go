@@ -0,0 +1,332 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package threshold
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/events"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/lib/comparison"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// Name defines the name of the reporter used within the plugin registry
+var Name = "Threshold"
+
+// Aggregates supported by thresholds, besides percentiles like "p95".
+const (
+	AggregateMean   = "mean"
+	AggregateMedian = "median"
+	AggregateMin    = "min"
+	AggregateMax    = "max"
+)
+
+// Threshold is a condition on the samples of a metric, collected from the
+// Metric events of all the targets.
+type Threshold struct {
+	// Metric is the name of the metric, as emitted by the test steps.
+	Metric string
+	// Aggregate reduces the samples to a single value: mean (default),
+	// median, min, max or a percentile like p95.
+	Aggregate string
+	// Expression is evaluated against the aggregated value, e.g. ">=1000".
+	Expression string
+	// Regression is evaluated against the relative change of the aggregated
+	// value from the same aggregate of the baseline job, e.g. ">=-5%" for a
+	// metric which should not drop by more than 5%.
+	Regression string
+}
+
+// Parameters contains the parameters of both the run and the final reporter
+type Parameters struct {
+	Thresholds []Threshold
+	// BaselineJobID is the job whose metrics regressions are computed
+	// against. Required if any of the thresholds defines a Regression.
+	BaselineJobID types.JobID
+}
+
+// threshold is a validated Threshold
+type threshold struct {
+	Threshold
+	expression *comparison.Expression
+	regression *comparison.Expression
+}
+
+// thresholdParameters are the validated Parameters
+type thresholdParameters struct {
+	thresholds    []threshold
+	baselineJobID types.JobID
+}
+
+// ThresholdResult is the outcome of the evaluation of a Threshold
+type ThresholdResult struct {
+	Metric    string
+	Aggregate string
+	Unit      string `json:",omitempty"`
+	Samples   int
+	Value     float64
+	// Baseline is the aggregated value of the baseline job, and Change the
+	// relative change from it in percent.
+	Baseline *float64 `json:",omitempty"`
+	Change   *float64 `json:",omitempty"`
+	Success  bool
+	Message  string
+}
+
+// ThresholdReport wraps the results of the evaluation of all the thresholds
+type ThresholdReport struct {
+	Message string
+	Results []ThresholdResult
+}
+
+// ThresholdReporter implements a reporter which determines whether the Job has
+// been successful or not based on the metrics emitted by the test steps
+type ThresholdReporter struct {
+}
+
+// ValidateRunParameters validates the parameters for the run reporter
+func (tr *ThresholdReporter) ValidateRunParameters(params []byte) (interface{}, error) {
+	return parseParameters(params)
+}
+
+// ValidateFinalParameters validates the parameters for the final reporter
+func (tr *ThresholdReporter) ValidateFinalParameters(params []byte) (interface{}, error) {
+	return parseParameters(params)
+}
+
+func parseParameters(data []byte) (*thresholdParameters, error) {
+	var params Parameters
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, err
+	}
+	if len(params.Thresholds) == 0 {
+		return nil, fmt.Errorf("no thresholds specified")
+	}
+	res := &thresholdParameters{baselineJobID: params.BaselineJobID}
+	for i, t := range params.Thresholds {
+		if t.Metric == "" {
+			return nil, fmt.Errorf("threshold %d: no metric specified", i)
+		}
+		if t.Aggregate == "" {
+			t.Aggregate = AggregateMean
+		}
+		if _, err := aggregate(t.Aggregate, []float64{0}); err != nil {
+			return nil, fmt.Errorf("threshold %d: %w", i, err)
+		}
+		if t.Expression == "" && t.Regression == "" {
+			return nil, fmt.Errorf("threshold %d: either Expression or Regression must be specified", i)
+		}
+		pt := threshold{Threshold: t}
+		if t.Expression != "" {
+			expr, err := comparison.ParseExpression(t.Expression)
+			if err != nil {
+				return nil, fmt.Errorf("threshold %d: could not parse expression: %w", i, err)
+			}
+			if expr.Type != comparison.TypeValue {
+				return nil, fmt.Errorf("threshold %d: expression '%s' must compare against a value", i, t.Expression)
+			}
+			pt.expression = expr
+		}
+		if t.Regression != "" {
+			if params.BaselineJobID == 0 {
+				return nil, fmt.Errorf("threshold %d: regression requires a BaselineJobID", i)
+			}
+			expr, err := comparison.ParseExpression(t.Regression)
+			if err != nil {
+				return nil, fmt.Errorf("threshold %d: could not parse regression: %w", i, err)
+			}
+			if expr.Type != comparison.TypePercentage {
+				return nil, fmt.Errorf("threshold %d: regression '%s' must compare against a percentage", i, t.Regression)
+			}
+			pt.regression = expr
+		}
+		res.thresholds = append(res.thresholds, pt)
+	}
+	return res, nil
+}
+
+// Name returns the Name of the reporter
+func (tr *ThresholdReporter) Name() string {
+	return Name
+}
+
+// RunReport evaluates the thresholds over the metrics of a single run
+func (tr *ThresholdReporter) RunReport(ctx xcontext.Context, parameters interface{}, runStatus *job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
+	return tr.report(ctx, parameters, []job.RunStatus{*runStatus}, ev)
+}
+
+// FinalReport evaluates the thresholds over the metrics of all the runs
+func (tr *ThresholdReporter) FinalReport(ctx xcontext.Context, parameters interface{}, runStatuses []job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
+	return tr.report(ctx, parameters, runStatuses, ev)
+}
+
+func (tr *ThresholdReporter) report(ctx xcontext.Context, parameters interface{}, runStatuses []job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
+	params, ok := parameters.(*thresholdParameters)
+	if !ok {
+		return false, nil, fmt.Errorf("report parameters should be of type *threshold.thresholdParameters")
+	}
+
+	var targetEvents []testevent.Event
+	for _, runStatus := range runStatuses {
+		for _, testStatus := range runStatus.TestStatuses {
+			for _, stepStatus := range testStatus.TestStepStatuses {
+				for _, targetStatus := range stepStatus.TargetStatuses {
+					targetEvents = append(targetEvents, targetStatus.Events...)
+				}
+			}
+		}
+	}
+	samples, units := collectMetrics(ctx, targetEvents)
+
+	var baseline map[string][]float64
+	if params.baselineJobID != 0 {
+		baselineEvents, err := ev.Fetch(ctx,
+			testevent.QueryJobID(params.baselineJobID),
+			testevent.QueryEventName(events.EventMetric),
+		)
+		if err != nil {
+			return false, nil, fmt.Errorf("could not fetch metrics of baseline job %d: %w", params.baselineJobID, err)
+		}
+		baseline, _ = collectMetrics(ctx, baselineEvents)
+	}
+
+	report := ThresholdReport{}
+	failed := 0
+	for _, t := range params.thresholds {
+		res := evaluate(t, samples[t.Metric], baseline[t.Metric], params.baselineJobID)
+		res.Unit = units[t.Metric]
+		if !res.Success {
+			failed++
+		}
+		report.Results = append(report.Results, res)
+	}
+	success := failed == 0
+	if success {
+		report.Message = fmt.Sprintf("All %d thresholds met", len(params.thresholds))
+	} else {
+		report.Message = fmt.Sprintf("%d out of %d thresholds not met", failed, len(params.thresholds))
+	}
+	return success, report, nil
+}
+
+// collectMetrics groups the samples of the Metric events by metric name.
+func collectMetrics(ctx xcontext.Context, targetEvents []testevent.Event) (map[string][]float64, map[string]string) {
+	samples := make(map[string][]float64)
+	units := make(map[string]string)
+	for _, e := range targetEvents {
+		if e.Data == nil || e.Data.EventName != events.EventMetric || e.Data.Payload == nil {
+			continue
+		}
+		var metric events.Metric
+		if err := json.Unmarshal(*e.Data.Payload, &metric); err != nil {
+			ctx.Warnf("Ignoring malformed metric event: %v", err)
+			continue
+		}
+		samples[metric.Name] = append(samples[metric.Name], metric.Value)
+		if metric.Unit != "" {
+			units[metric.Name] = metric.Unit
+		}
+	}
+	return samples, units
+}
+
+func evaluate(t threshold, samples, baselineSamples []float64, baselineJobID types.JobID) ThresholdResult {
+	res := ThresholdResult{
+		Metric:    t.Metric,
+		Aggregate: t.Aggregate,
+		Samples:   len(samples),
+	}
+	if len(samples) == 0 {
+		res.Message = fmt.Sprintf("no samples of metric '%s'", t.Metric)
+		return res
+	}
+	// The aggregate was validated along with the parameters.
+	res.Value, _ = aggregate(t.Aggregate, samples)
+
+	var messages []string
+	res.Success = true
+	if t.expression != nil {
+		cmp := t.expression.Evaluate(res.Value)
+		res.Success = res.Success && cmp.Pass
+		messages = append(messages, fmt.Sprintf("%s %s: %s", t.Aggregate, t.Metric, cmp.Expr))
+	}
+	if t.regression != nil {
+		if len(baselineSamples) == 0 {
+			res.Success = false
+			messages = append(messages, fmt.Sprintf("no samples of metric '%s' in baseline job %d", t.Metric, baselineJobID))
+		} else {
+			baseline, _ := aggregate(t.Aggregate, baselineSamples)
+			res.Baseline = &baseline
+			if baseline == 0 {
+				res.Success = false
+				messages = append(messages, fmt.Sprintf("cannot compute the change of %s %s from a zero baseline", t.Aggregate, t.Metric))
+			} else {
+				change := (res.Value - baseline) / math.Abs(baseline) * 100
+				res.Change = &change
+				cmp := t.regression.Evaluate(change)
+				res.Success = res.Success && cmp.Pass
+				messages = append(messages, fmt.Sprintf("change of %s %s from baseline job %d: %s", t.Aggregate, t.Metric, baselineJobID, cmp.Expr))
+			}
+		}
+	}
+	res.Message = strings.Join(messages, ", ")
+	return res
+}
+
+// aggregate reduces the samples to a single value. samples must not be empty.
+func aggregate(name string, samples []float64) (float64, error) {
+	switch name {
+	case AggregateMean:
+		var sum float64
+		for _, s := range samples {
+			sum += s
+		}
+		return sum / float64(len(samples)), nil
+	case AggregateMedian:
+		return percentile(50, samples), nil
+	case AggregateMin:
+		return percentile(0, samples), nil
+	case AggregateMax:
+		return percentile(100, samples), nil
+	}
+	if strings.HasPrefix(name, "p") {
+		p, err := strconv.ParseFloat(name[1:], 64)
+		if err == nil && p >= 0 && p <= 100 {
+			return percentile(p, samples), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown aggregate '%s'", name)
+}
+
+// percentile returns the p-th percentile of the samples using the nearest-rank
+// method.
+func percentile(p float64, samples []float64) float64 {
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// New builds a new ThresholdReporter
+func New() job.Reporter {
+	return &ThresholdReporter{}
+}
+
+// Load returns the name and factory which are needed to register the Reporter
+func Load() (string, job.ReporterFactory) {
+	return Name, New
+}
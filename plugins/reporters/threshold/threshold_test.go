@@ -0,0 +1,167 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package threshold
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/events"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+func metricEvent(jobID types.JobID, name string, value float64) testevent.Event {
+	payload, _ := json.Marshal(events.Metric{Name: name, Value: value, Unit: "events/s"})
+	msg := json.RawMessage(payload)
+	return testevent.Event{
+		Header: &testevent.Header{JobID: jobID},
+		Data:   &testevent.Data{EventName: events.EventMetric, Target: &target.Target{ID: "T1"}, Payload: &msg},
+	}
+}
+
+// runStatus builds a run where each value was measured on a different target.
+func runStatus(runID types.RunID, metric string, values ...float64) job.RunStatus {
+	var targets []job.TargetStatus
+	for i, v := range values {
+		targets = append(targets, job.TargetStatus{
+			Target: &target.Target{ID: fmt.Sprintf("T%d", i)},
+			Events: []testevent.Event{metricEvent(1, metric, v)},
+		})
+	}
+	return job.RunStatus{
+		RunCoordinates: job.RunCoordinates{JobID: 1, RunID: runID},
+		TestStatuses: []job.TestStatus{
+			{TestStepStatuses: []job.TestStepStatus{{TargetStatuses: targets}}},
+		},
+	}
+}
+
+type fetcher []testevent.Event
+
+func (f fetcher) Fetch(_ xcontext.Context, fields ...testevent.QueryField) ([]testevent.Event, error) {
+	query, err := testevent.BuildQuery(fields...)
+	if err != nil {
+		return nil, err
+	}
+	var res []testevent.Event
+	for _, ev := range f {
+		if ev.Header.JobID == query.JobID {
+			res = append(res, ev)
+		}
+	}
+	return res, nil
+}
+
+func TestAggregate(t *testing.T) {
+	samples := []float64{5, 1, 4, 2, 3, 6, 7, 8, 9, 10}
+	for _, tc := range []struct {
+		aggregate string
+		value     float64
+	}{
+		{"mean", 5.5},
+		{"median", 5},
+		{"min", 1},
+		{"max", 10},
+		{"p95", 10},
+		{"p90", 9},
+		{"p10", 1},
+	} {
+		v, err := aggregate(tc.aggregate, samples)
+		require.NoError(t, err)
+		require.Equal(t, tc.value, v, tc.aggregate)
+	}
+	_, err := aggregate("p101", samples)
+	require.Error(t, err)
+	_, err = aggregate("average", samples)
+	require.Error(t, err)
+}
+
+func TestInvalidParameters(t *testing.T) {
+	for _, params := range []string{
+		`{}`,
+		`{"Thresholds": [{"Expression": ">1"}]}`,
+		`{"Thresholds": [{"Metric": "m"}]}`,
+		`{"Thresholds": [{"Metric": "m", "Aggregate": "avg", "Expression": ">1"}]}`,
+		`{"Thresholds": [{"Metric": "m", "Expression": ">1%"}]}`,
+		`{"Thresholds": [{"Metric": "m", "Regression": ">-5%"}]}`,
+		`{"BaselineJobID": 3, "Thresholds": [{"Metric": "m", "Regression": ">-5"}]}`,
+	} {
+		_, err := New().ValidateRunParameters([]byte(params))
+		require.Error(t, err, params)
+	}
+}
+
+func TestRunReport(t *testing.T) {
+	r := New()
+	params, err := r.ValidateRunParameters([]byte(`{"Thresholds": [
+		{"Metric": "EventsPerSecond", "Expression": ">=100"},
+		{"Metric": "EventsPerSecond", "Aggregate": "min", "Expression": ">=100"},
+		{"Metric": "LatencyP95", "Expression": "<10"}
+	]}`))
+	require.NoError(t, err)
+
+	rs := runStatus(1, "EventsPerSecond", 90, 120, 150)
+	success, data, err := r.RunReport(xcontext.Background(), params, &rs, fetcher{})
+	require.NoError(t, err)
+	require.False(t, success)
+
+	report := data.(ThresholdReport)
+	require.Equal(t, "2 out of 3 thresholds not met", report.Message)
+	require.Len(t, report.Results, 3)
+	require.True(t, report.Results[0].Success)
+	require.Equal(t, 120.0, report.Results[0].Value)
+	require.Equal(t, 3, report.Results[0].Samples)
+	require.Equal(t, "events/s", report.Results[0].Unit)
+	require.Equal(t, "mean EventsPerSecond: 120.00 >= 100.00", report.Results[0].Message)
+	require.False(t, report.Results[1].Success)
+	require.Equal(t, "min EventsPerSecond: 90.00 is not >= 100.00", report.Results[1].Message)
+	require.False(t, report.Results[2].Success)
+	require.Equal(t, "no samples of metric 'LatencyP95'", report.Results[2].Message)
+}
+
+func TestFinalReportRegression(t *testing.T) {
+	r := New()
+	params, err := r.ValidateFinalParameters([]byte(`{"BaselineJobID": 42, "Thresholds": [
+		{"Metric": "EventsPerSecond", "Regression": ">=-5%"}
+	]}`))
+	require.NoError(t, err)
+
+	baseline := fetcher{
+		metricEvent(42, "EventsPerSecond", 100),
+		metricEvent(42, "EventsPerSecond", 100),
+		metricEvent(43, "EventsPerSecond", 1000),
+	}
+	runs := []job.RunStatus{
+		runStatus(1, "EventsPerSecond", 98),
+		runStatus(2, "EventsPerSecond", 96),
+	}
+	success, data, err := r.FinalReport(xcontext.Background(), params, runs, baseline)
+	require.NoError(t, err)
+	require.True(t, success)
+	res := data.(ThresholdReport).Results[0]
+	require.Equal(t, 97.0, res.Value)
+	require.Equal(t, 100.0, *res.Baseline)
+	require.Equal(t, -3.0, *res.Change)
+	require.Equal(t, "change of mean EventsPerSecond from baseline job 42: -3.00% >= -5.00%", res.Message)
+
+	runs = append(runs, runStatus(3, "EventsPerSecond", 70))
+	success, data, err = r.FinalReport(xcontext.Background(), params, runs, baseline)
+	require.NoError(t, err)
+	require.False(t, success)
+	require.Equal(t, -12.0, *data.(ThresholdReport).Results[0].Change)
+
+	success, data, err = r.FinalReport(xcontext.Background(), params, runs, fetcher{})
+	require.NoError(t, err)
+	require.False(t, success)
+	require.Equal(t, "no samples of metric 'EventsPerSecond' in baseline job 42", data.(ThresholdReport).Results[0].Message)
+}
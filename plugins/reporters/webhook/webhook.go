@@ -0,0 +1,301 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/insomniacslk/xjson"
+
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// Name defines the name of the reporter used within the plugin registry
+var Name = "Webhook"
+
+// Defaults of the optional parameters.
+const (
+	DefaultContentType     = "application/json"
+	DefaultTemplate        = "{{ json . }}"
+	DefaultSignatureHeader = "X-Contest-Signature"
+	DefaultRetryInterval   = xjson.Duration(time.Second)
+	DefaultTimeout         = xjson.Duration(10 * time.Second)
+)
+
+// Values of Parameters.Notify.
+const (
+	NotifyAlways    = "always"
+	NotifyOnFailure = "on_failure"
+)
+
+// Parameters contains the parameters of both the run and the final reporter
+type Parameters struct {
+	// URL the notification is POSTed to.
+	URL string
+	// Headers are added to the request, e.g. for authentication.
+	Headers map[string]string
+	// ContentType of the body, DefaultContentType if empty.
+	ContentType string
+	// Template is a text/template rendering the body from a Notification.
+	// The "json" function marshals its argument to JSON. By default the
+	// Notification itself is sent as JSON, without the run statuses.
+	Template string
+	// Notify is either "always" (default) or "on_failure", to only send a
+	// notification if a target failed.
+	Notify string
+	// Secret, if set, is used to sign the body with HMAC-SHA256. The
+	// signature is sent hex encoded as "sha256=<signature>" in the
+	// SignatureHeader.
+	Secret          string
+	SignatureHeader string
+	// Retries is the number of times a failed delivery is retried. The
+	// interval between the attempts starts at RetryInterval and doubles
+	// after every attempt.
+	Retries       uint
+	RetryInterval xjson.Duration
+	// Timeout of each attempt.
+	Timeout xjson.Duration
+}
+
+// Notification is the data the body template is executed on
+type Notification struct {
+	JobID types.JobID
+	// RunID is the run which finished, 0 for the final report.
+	RunID types.RunID
+	Final bool
+	// Targets is the number of targets which took part in the tests, over
+	// all the runs, and FailedTargets the number of them which failed a step.
+	Targets       int
+	FailedTargets int
+	Failed        bool
+	// Runs are the statuses of the runs covered by the report.
+	Runs []job.RunStatus `json:"-"`
+}
+
+// WebhookReport wraps the outcome of the delivery of a notification
+type WebhookReport struct {
+	Message    string
+	URL        string
+	Attempts   uint
+	StatusCode int `json:",omitempty"`
+}
+
+// WebhookReporter implements a reporter which sends a notification to a
+// webhook when a run or the job finishes. The report is successful if the
+// notification was delivered or did not need to be sent.
+type WebhookReporter struct {
+	client *http.Client
+}
+
+type webhookParameters struct {
+	Parameters
+	template *template.Template
+}
+
+// ValidateRunParameters validates the parameters for the run reporter
+func (wr *WebhookReporter) ValidateRunParameters(params []byte) (interface{}, error) {
+	return parseParameters(params)
+}
+
+// ValidateFinalParameters validates the parameters for the final reporter
+func (wr *WebhookReporter) ValidateFinalParameters(params []byte) (interface{}, error) {
+	return parseParameters(params)
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+func parseParameters(data []byte) (*webhookParameters, error) {
+	params := Parameters{
+		ContentType:     DefaultContentType,
+		Template:        DefaultTemplate,
+		Notify:          NotifyAlways,
+		SignatureHeader: DefaultSignatureHeader,
+		RetryInterval:   DefaultRetryInterval,
+		Timeout:         DefaultTimeout,
+	}
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(params.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URL '%s': scheme must be http or https", params.URL)
+	}
+	if params.Notify != NotifyAlways && params.Notify != NotifyOnFailure {
+		return nil, fmt.Errorf("invalid Notify '%s', must be '%s' or '%s'", params.Notify, NotifyAlways, NotifyOnFailure)
+	}
+	if params.Timeout <= 0 {
+		return nil, fmt.Errorf("invalid Timeout %s, must be positive", time.Duration(params.Timeout))
+	}
+	if params.RetryInterval < 0 {
+		return nil, fmt.Errorf("invalid RetryInterval %s, must not be negative", time.Duration(params.RetryInterval))
+	}
+	tmpl, err := template.New("body").Funcs(templateFuncs).Option("missingkey=error").Parse(params.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Template: %w", err)
+	}
+	return &webhookParameters{Parameters: params, template: tmpl}, nil
+}
+
+// Name returns the Name of the reporter
+func (wr *WebhookReporter) Name() string {
+	return Name
+}
+
+// RunReport notifies the webhook about a finished run
+func (wr *WebhookReporter) RunReport(ctx xcontext.Context, parameters interface{}, runStatus *job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
+	n := newNotification(runStatus.JobID, []job.RunStatus{*runStatus})
+	n.RunID = runStatus.RunID
+	return wr.report(ctx, parameters, n)
+}
+
+// FinalReport notifies the webhook about a finished job
+func (wr *WebhookReporter) FinalReport(ctx xcontext.Context, parameters interface{}, runStatuses []job.RunStatus, ev testevent.Fetcher) (bool, interface{}, error) {
+	var jobID types.JobID
+	if len(runStatuses) > 0 {
+		jobID = runStatuses[0].JobID
+	}
+	n := newNotification(jobID, runStatuses)
+	n.Final = true
+	return wr.report(ctx, parameters, n)
+}
+
+func newNotification(jobID types.JobID, runStatuses []job.RunStatus) *Notification {
+	n := &Notification{JobID: jobID, Runs: runStatuses}
+	for _, runStatus := range runStatuses {
+		for _, testStatus := range runStatus.TestStatuses {
+			failed := make(map[string]bool)
+			for _, stepStatus := range testStatus.TestStepStatuses {
+				for _, targetStatus := range stepStatus.TargetStatuses {
+					if targetStatus.Target == nil {
+						continue
+					}
+					failed[targetStatus.Target.ID] = failed[targetStatus.Target.ID] || targetStatus.Error != ""
+				}
+			}
+			n.Targets += len(failed)
+			for _, f := range failed {
+				if f {
+					n.FailedTargets++
+				}
+			}
+		}
+	}
+	n.Failed = n.FailedTargets > 0
+	return n
+}
+
+func (wr *WebhookReporter) report(ctx xcontext.Context, parameters interface{}, n *Notification) (bool, interface{}, error) {
+	params, ok := parameters.(*webhookParameters)
+	if !ok {
+		return false, nil, fmt.Errorf("report parameters should be of type *webhook.webhookParameters")
+	}
+	report := WebhookReport{URL: params.URL}
+	if params.Notify == NotifyOnFailure && !n.Failed {
+		report.Message = "No target failed, notification not sent"
+		return true, report, nil
+	}
+
+	var body bytes.Buffer
+	if err := params.template.Execute(&body, n); err != nil {
+		return false, nil, fmt.Errorf("could not render the body: %w", err)
+	}
+
+	interval := time.Duration(params.RetryInterval)
+	for {
+		report.Attempts++
+		statusCode, retriable, err := wr.post(ctx, params, body.Bytes())
+		report.StatusCode = statusCode
+		if err == nil {
+			report.Message = "Notification delivered"
+			return true, report, nil
+		}
+		ctx.Warnf("Webhook delivery to %s failed (attempt %d): %v", params.URL, report.Attempts, err)
+		if !retriable || report.Attempts > params.Retries {
+			report.Message = fmt.Sprintf("Notification not delivered after %d attempts: %v", report.Attempts, err)
+			return false, report, nil
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			report.Message = fmt.Sprintf("Notification not delivered after %d attempts: %v", report.Attempts, ctx.Err())
+			return false, report, nil
+		}
+		interval *= 2
+	}
+}
+
+// post sends the body once. Errors are retriable unless the webhook rejected
+// the request with a client error.
+func (wr *WebhookReporter) post(ctx xcontext.Context, params *webhookParameters, body []byte) (int, bool, error) {
+	reqCtx, cancel := xcontext.WithTimeout(ctx, time.Duration(params.Timeout))
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, params.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, false, err
+	}
+	for k, v := range params.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", params.ContentType)
+	if params.Secret != "" {
+		req.Header.Set(params.SignatureHeader, "sha256="+Sign([]byte(params.Secret), body))
+	}
+
+	resp, err := wr.client.Do(req)
+	if err != nil {
+		return 0, true, err
+	}
+	defer resp.Body.Close()
+	// Drain a bit of the body to allow reusing the connection and to report
+	// the error of the webhook.
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, false, nil
+	}
+	err = fmt.Errorf("webhook returned %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	retriable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout
+	return resp.StatusCode, retriable, err
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body, which receivers can
+// use to authenticate notifications.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// New builds a new WebhookReporter
+func New() job.Reporter {
+	return &WebhookReporter{client: &http.Client{}}
+}
+
+// Load returns the name and factory which are needed to register the Reporter
+func Load() (string, job.ReporterFactory) {
+	return Name, New
+}
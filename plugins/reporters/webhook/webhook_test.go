@@ -0,0 +1,194 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+type request struct {
+	header http.Header
+	body   string
+}
+
+// webhook records the requests it receives and replies with the given status
+// codes in turn, 200 once they are exhausted.
+type webhook struct {
+	*httptest.Server
+	mu       sync.Mutex
+	codes    []int
+	requests []request
+}
+
+func newWebhook(t *testing.T, codes ...int) *webhook {
+	wh := &webhook{codes: codes}
+	wh.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wh.mu.Lock()
+		defer wh.mu.Unlock()
+		wh.requests = append(wh.requests, request{header: r.Header, body: string(body)})
+		if len(wh.codes) > 0 {
+			code := wh.codes[0]
+			wh.codes = wh.codes[1:]
+			http.Error(w, "try again", code)
+		}
+	}))
+	t.Cleanup(wh.Close)
+	return wh
+}
+
+func (wh *webhook) received() []request {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+	return append([]request(nil), wh.requests...)
+}
+
+func runStatus(runID types.RunID, errs ...string) job.RunStatus {
+	var targets []job.TargetStatus
+	for i, err := range errs {
+		targets = append(targets, job.TargetStatus{
+			Target: &target.Target{ID: string(rune('A' + i))},
+			Error:  err,
+		})
+	}
+	return job.RunStatus{
+		RunCoordinates: job.RunCoordinates{JobID: 5, RunID: runID},
+		TestStatuses: []job.TestStatus{
+			{
+				TestCoordinates:  job.TestCoordinates{TestName: "boot"},
+				TestStepStatuses: []job.TestStepStatus{{TargetStatuses: targets}},
+			},
+		},
+	}
+}
+
+func params(t *testing.T, wh *webhook, extra string) interface{} {
+	p, err := New().ValidateRunParameters([]byte(`{"URL": "` + wh.URL + `", "RetryInterval": "1ms"` + extra + `}`))
+	require.NoError(t, err)
+	return p
+}
+
+func TestRunReportDefaultBody(t *testing.T) {
+	wh := newWebhook(t)
+	rs := runStatus(2, "", "ping timed out")
+	success, data, err := New().RunReport(xcontext.Background(), params(t, wh, ""), &rs, nil)
+	require.NoError(t, err)
+	require.True(t, success)
+	require.Equal(t, WebhookReport{Message: "Notification delivered", URL: wh.URL, Attempts: 1, StatusCode: 200}, data)
+
+	reqs := wh.received()
+	require.Len(t, reqs, 1)
+	require.Equal(t, DefaultContentType, reqs[0].header.Get("Content-Type"))
+	require.Empty(t, reqs[0].header.Get(DefaultSignatureHeader))
+	var n Notification
+	require.NoError(t, json.Unmarshal([]byte(reqs[0].body), &n))
+	require.Equal(t, Notification{JobID: 5, RunID: 2, Targets: 2, FailedTargets: 1, Failed: true}, n)
+}
+
+func TestFinalReportTemplate(t *testing.T) {
+	wh := newWebhook(t)
+	p := params(t, wh, `,
+		"ContentType": "text/plain",
+		"Headers": {"Authorization": "Bearer token"},
+		"Template": "job {{ .JobID }} final={{ .Final }}{{ range .Runs }} run{{ .RunID }}{{ end }}: {{ .FailedTargets }}/{{ .Targets }} failed"`)
+	success, _, err := New().FinalReport(xcontext.Background(), p, []job.RunStatus{runStatus(1, ""), runStatus(2, "x", "")}, nil)
+	require.NoError(t, err)
+	require.True(t, success)
+
+	reqs := wh.received()
+	require.Len(t, reqs, 1)
+	require.Equal(t, "job 5 final=true run1 run2: 1/3 failed", reqs[0].body)
+	require.Equal(t, "text/plain", reqs[0].header.Get("Content-Type"))
+	require.Equal(t, "Bearer token", reqs[0].header.Get("Authorization"))
+}
+
+func TestSignature(t *testing.T) {
+	wh := newWebhook(t)
+	rs := runStatus(1, "")
+	_, _, err := New().RunReport(xcontext.Background(), params(t, wh, `, "Secret": "s3cr3t"`), &rs, nil)
+	require.NoError(t, err)
+
+	reqs := wh.received()
+	require.Len(t, reqs, 1)
+	require.Equal(t, "sha256="+Sign([]byte("s3cr3t"), []byte(reqs[0].body)), reqs[0].header.Get(DefaultSignatureHeader))
+	require.NotEqual(t, Sign([]byte("other"), []byte(reqs[0].body)), Sign([]byte("s3cr3t"), []byte(reqs[0].body)))
+}
+
+func TestNotifyOnFailure(t *testing.T) {
+	wh := newWebhook(t)
+	p := params(t, wh, `, "Notify": "on_failure"`)
+	rs := runStatus(1, "", "")
+	success, data, err := New().RunReport(xcontext.Background(), p, &rs, nil)
+	require.NoError(t, err)
+	require.True(t, success)
+	require.Equal(t, "No target failed, notification not sent", data.(WebhookReport).Message)
+	require.Empty(t, wh.received())
+
+	rs = runStatus(2, "", "failed")
+	success, _, err = New().RunReport(xcontext.Background(), p, &rs, nil)
+	require.NoError(t, err)
+	require.True(t, success)
+	require.Len(t, wh.received(), 1)
+}
+
+func TestRetries(t *testing.T) {
+	wh := newWebhook(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	rs := runStatus(1, "")
+	success, data, err := New().RunReport(xcontext.Background(), params(t, wh, `, "Retries": 2`), &rs, nil)
+	require.NoError(t, err)
+	require.True(t, success)
+	require.Equal(t, uint(3), data.(WebhookReport).Attempts)
+	require.Len(t, wh.received(), 3)
+}
+
+func TestRetriesExhausted(t *testing.T) {
+	wh := newWebhook(t, 500, 500, 500)
+	rs := runStatus(1, "")
+	success, data, err := New().RunReport(xcontext.Background(), params(t, wh, `, "Retries": 1`), &rs, nil)
+	require.NoError(t, err)
+	require.False(t, success)
+	report := data.(WebhookReport)
+	require.Equal(t, uint(2), report.Attempts)
+	require.Equal(t, 500, report.StatusCode)
+	require.Contains(t, report.Message, "Notification not delivered after 2 attempts")
+	require.Len(t, wh.received(), 2)
+}
+
+func TestClientErrorNotRetried(t *testing.T) {
+	wh := newWebhook(t, http.StatusBadRequest)
+	rs := runStatus(1, "")
+	success, data, err := New().RunReport(xcontext.Background(), params(t, wh, `, "Retries": 3`), &rs, nil)
+	require.NoError(t, err)
+	require.False(t, success)
+	require.Equal(t, uint(1), data.(WebhookReport).Attempts)
+	require.Len(t, wh.received(), 1)
+}
+
+func TestInvalidParameters(t *testing.T) {
+	for _, p := range []string{
+		`{}`,
+		`{"URL": "ftp://example.com"}`,
+		`{"URL": "http://example.com", "Notify": "sometimes"}`,
+		`{"URL": "http://example.com", "Template": "{{ .JobID "}`,
+		`{"URL": "http://example.com", "Timeout": "0s"}`,
+	} {
+		_, err := New().ValidateFinalParameters([]byte(p))
+		require.Error(t, err, p)
+	}
+}
@@ -8,6 +8,7 @@ package memory
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"sync"
@@ -16,8 +17,11 @@ import (
 	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
 	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
@@ -31,6 +35,12 @@ type Memory struct {
 	frameworkEvents []frameworkevent.Event
 	jobIDCounter    types.JobID
 	jobInfo         map[types.JobID]*jobInfo
+	scheduleCounter types.ScheduleID
+	schedules       map[types.ScheduleID]*job.Schedule
+	logs            []job.LogEntry
+	inventory       map[string]*inventory.Target
+	outcomes        map[string][]health.Outcome
+	quarantines     map[string]*health.Quarantine
 }
 
 type jobInfo struct {
@@ -41,7 +51,7 @@ type jobInfo struct {
 }
 
 func emptyEventQuery(eventQuery *event.Query) bool {
-	return eventQuery.JobID == 0 && len(eventQuery.EventNames) == 0 && eventQuery.EmittedStartTime.IsZero() && eventQuery.EmittedEndTime.IsZero()
+	return eventQuery.JobID == 0 && len(eventQuery.EventNames) == 0 && eventQuery.EmittedStartTime.IsZero() && eventQuery.EmittedEndTime.IsZero() && eventQuery.AfterSequenceID == 0
 }
 
 // emptyFrameworkEventQuery returns whether the Query contains only default values
@@ -55,7 +65,8 @@ func emptyFrameworkEventQuery(eventQuery *frameworkevent.Query) bool {
 // values. If so, the Query is considered "empty" and doesn't result in
 // any lookup in the database
 func emptyTestEventQuery(eventQuery *testevent.Query) bool {
-	return emptyEventQuery(&eventQuery.Query) && eventQuery.TestName == "" && eventQuery.TestStepLabel == ""
+	return emptyEventQuery(&eventQuery.Query) && eventQuery.TestName == "" && eventQuery.TestStepLabel == "" &&
+		eventQuery.TargetID == "" && eventQuery.PayloadContains == ""
 }
 
 // StoreTestEvent stores a test event into the database
@@ -105,6 +116,10 @@ func eventTimeMatch(queryStartTime, queryEndTime time.Time, emittedTime time.Tim
 	return true
 }
 
+func eventSequenceMatch(queryAfterSequenceID, sequenceID uint64) bool {
+	return sequenceID > queryAfterSequenceID
+}
+
 func eventTestMatch(queryTestName, testName string) bool {
 	if queryTestName != "" && testName != queryTestName {
 		return false
@@ -119,6 +134,25 @@ func eventTestStepMatch(queryTestStepLabel, testStepLabel string) bool {
 	return true
 }
 
+func eventTargetMatch(queryTargetID string, t *target.Target) bool {
+	if queryTargetID != "" && (t == nil || t.ID != queryTargetID) {
+		return false
+	}
+	return true
+}
+
+func eventPayloadMatch(queryPayloadContains string, payload *json.RawMessage) bool {
+	if queryPayloadContains != "" && (payload == nil || !strings.Contains(string(*payload), queryPayloadContains)) {
+		return false
+	}
+	return true
+}
+
+// eventLimitReached returns whether a query returning count events is done.
+func eventLimitReached(queryLimit uint, count int) bool {
+	return queryLimit != 0 && uint(count) >= queryLimit
+}
+
 // GetTestEvents returns all test events that match the given query.
 func (m *Memory) GetTestEvents(_ xcontext.Context, eventQuery *testevent.Query) ([]testevent.Event, error) {
 	m.lock.Lock()
@@ -135,9 +169,15 @@ func (m *Memory) GetTestEvents(_ xcontext.Context, eventQuery *testevent.Query)
 			eventRunMatch(eventQuery.RunID, event.Header.RunID) &&
 			eventNameMatch(eventQuery.EventNames, event.Data.EventName) &&
 			eventTimeMatch(eventQuery.EmittedStartTime, eventQuery.EmittedEndTime, event.EmitTime) &&
+			eventSequenceMatch(eventQuery.AfterSequenceID, event.SequenceID) &&
 			eventTestMatch(eventQuery.TestName, event.Header.TestName) &&
-			eventTestStepMatch(eventQuery.TestStepLabel, event.Header.TestStepLabel) {
+			eventTestStepMatch(eventQuery.TestStepLabel, event.Header.TestStepLabel) &&
+			eventTargetMatch(eventQuery.TargetID, event.Data.Target) &&
+			eventPayloadMatch(eventQuery.PayloadContains, event.Data.Payload) {
 			matchingTestEvents = append(matchingTestEvents, event)
+			if eventLimitReached(eventQuery.Limit, len(matchingTestEvents)) {
+				break
+			}
 		}
 	}
 	return matchingTestEvents, nil
@@ -151,6 +191,12 @@ func (m *Memory) Reset() error {
 	m.frameworkEvents = []frameworkevent.Event{}
 	m.jobInfo = make(map[types.JobID]*jobInfo)
 	m.jobIDCounter = 1
+	m.schedules = make(map[types.ScheduleID]*job.Schedule)
+	m.scheduleCounter = 1
+	m.logs = nil
+	m.inventory = make(map[string]*inventory.Target)
+	m.outcomes = make(map[string][]health.Outcome)
+	m.quarantines = make(map[string]*health.Quarantine)
 	return nil
 }
 
@@ -180,7 +226,7 @@ func (m *Memory) GetJobRequest(_ xcontext.Context, jobID types.JobID) (*job.Requ
 	defer m.lock.Unlock()
 	v := m.jobInfo[jobID]
 	if v == nil || v.request == nil {
-		return nil, fmt.Errorf("could not find job request with id %v", jobID)
+		return nil, fmt.Errorf("could not find job request with id %v: %w", jobID, storage.ErrJobNotFound)
 	}
 	return v.request, nil
 }
@@ -238,13 +284,72 @@ func (m *Memory) GetJobReport(ctx xcontext.Context, jobID types.JobID) (*job.Job
 	return jr, nil
 }
 
-func (m *Memory) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]types.JobID, error) {
+// jobSummary builds the summary of a job, deriving its state and its start
+// and end times from the framework events.
+// Requires that the caller holds the lock.
+func (m *Memory) jobSummary(jobID types.JobID, info *jobInfo) job.Summary {
+	summary := job.Summary{
+		JobID:       jobID,
+		Name:        info.request.JobName,
+		State:       job.JobStateUnknown,
+		Requestor:   info.request.Requestor,
+		ServerID:    info.request.ServerID,
+		Tags:        append([]string{}, info.desc.Tags...),
+		RequestTime: info.request.RequestTime,
+	}
+	sort.Strings(summary.Tags)
+	var lastEventTime time.Time
+	for _, event := range m.frameworkEvents {
+		if !eventJobMatch(jobID, event.JobID) || !eventNameMatch(job.JobStateEvents, event.EventName) {
+			continue
+		}
+		if !event.EmitTime.Before(lastEventTime) {
+			summary.State, _ = job.EventNameToJobState(event.EventName)
+			lastEventTime = event.EmitTime
+		}
+		if event.EventName == job.EventJobStarted {
+			summary.StartTime = event.EmitTime
+		} else if job.IsJobCompletionEvent(event.EventName) {
+			endTime := event.EmitTime
+			summary.EndTime = &endTime
+		}
+	}
+	return summary
+}
+
+// jobNameRegexp translates a job name pattern of a JobQuery into a regular
+// expression.
+func jobNameRegexp(pattern string) (*regexp.Regexp, error) {
+	wildcards := strings.NewReplacer(`\*`, ".*", `\?`, ".")
+	return regexp.Compile("^" + wildcards.Replace(regexp.QuoteMeta(pattern)) + "$")
+}
+
+func jobTimeMatch(after, before time.Time, t time.Time) bool {
+	if !after.IsZero() && t.Before(after) {
+		return false
+	}
+	if !before.IsZero() && t.After(before) {
+		return false
+	}
+	return true
+}
+
+// ListJobs returns the summaries of the jobs matching the query.
+func (m *Memory) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]job.Summary, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	res := []types.JobID{}
+	res := []job.Summary{}
 	if err := job.CheckTags(query.Tags, true /* allowInternal */); err != nil {
 		return nil, err
 	}
+	var nameRegexp *regexp.Regexp
+	if len(query.NamePattern) > 0 {
+		var err error
+		if nameRegexp, err = jobNameRegexp(query.NamePattern); err != nil {
+			return nil, fmt.Errorf("invalid job name pattern %q: %w", query.NamePattern, err)
+		}
+	}
+	var jobIDs []types.JobID
 jobLoop:
 	for jobId, jobInfo := range m.jobInfo {
 		if len(query.ServerID) > 0 {
@@ -252,6 +357,22 @@ jobLoop:
 				continue
 			}
 		}
+		if len(query.Requestor) > 0 {
+			if jobInfo.request.Requestor != query.Requestor {
+				continue
+			}
+		}
+		if nameRegexp != nil && !nameRegexp.MatchString(jobInfo.request.JobName) {
+			continue
+		}
+		if !jobTimeMatch(query.RequestedAfter, query.RequestedBefore, jobInfo.request.RequestTime) {
+			continue
+		}
+		if query.AfterJobID != 0 {
+			if (!query.NewestFirst && jobId <= query.AfterJobID) || (query.NewestFirst && jobId >= query.AfterJobID) {
+				continue
+			}
+		}
 		if len(query.Tags) > 0 {
 			for _, qTag := range query.Tags {
 				found := false
@@ -265,31 +386,40 @@ jobLoop:
 				}
 			}
 		}
+		jobIDs = append(jobIDs, jobId)
+	}
+	sort.Slice(jobIDs, func(i, j int) bool {
+		if query.NewestFirst {
+			return jobIDs[i] > jobIDs[j]
+		}
+		return jobIDs[i] < jobIDs[j]
+	})
+	// Summaries scan all the events, only compute them until the page is
+	// full.
+	for _, jobId := range jobIDs {
+		if query.Limit != 0 && uint(len(res)) >= query.Limit {
+			break
+		}
+		summary := m.jobSummary(jobId, m.jobInfo[jobId])
 		if len(query.States) > 0 {
-			var lastEventTime time.Time
-			jobState := job.JobStateUnknown
-			for _, event := range m.frameworkEvents {
-				if eventJobMatch(jobId, event.JobID) &&
-					eventNameMatch(job.JobStateEvents, event.EventName) &&
-					event.EmitTime.After(lastEventTime) {
-					jobState, _ = job.EventNameToJobState(event.EventName)
-					lastEventTime = event.EmitTime
-				}
-			}
 			found := false
 			for _, queryState := range query.States {
-				if jobState == queryState {
+				if summary.State == queryState {
 					found = true
 					break
 				}
 			}
 			if !found {
-				continue jobLoop
+				continue
+			}
+		}
+		if !query.EndedAfter.IsZero() || !query.EndedBefore.IsZero() {
+			if summary.EndTime == nil || !jobTimeMatch(query.EndedAfter, query.EndedBefore, *summary.EndTime) {
+				continue
 			}
 		}
-		res = append(res, jobId)
+		res = append(res, summary)
 	}
-	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
 	return res, nil
 }
 
@@ -315,13 +445,262 @@ func (m *Memory) GetFrameworkEvent(_ xcontext.Context, eventQuery *frameworkeven
 	for _, event := range m.frameworkEvents {
 		if eventJobMatch(eventQuery.JobID, event.JobID) &&
 			eventNameMatch(eventQuery.EventNames, event.EventName) &&
-			eventTimeMatch(eventQuery.EmittedStartTime, eventQuery.EmittedEndTime, event.EmitTime) {
+			eventTimeMatch(eventQuery.EmittedStartTime, eventQuery.EmittedEndTime, event.EmitTime) &&
+			eventSequenceMatch(eventQuery.AfterSequenceID, event.SequenceID) {
 			matchingFrameworkEvents = append(matchingFrameworkEvents, event)
+			if eventLimitReached(eventQuery.Limit, len(matchingFrameworkEvents)) {
+				break
+			}
 		}
 	}
 	return matchingFrameworkEvents, nil
 }
 
+// StoreSchedule stores a new job schedule
+func (m *Memory) StoreSchedule(_ xcontext.Context, schedule *job.Schedule) (types.ScheduleID, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	scheduleID := m.scheduleCounter
+	m.scheduleCounter++
+	schedule.ID = scheduleID
+	s := *schedule
+	m.schedules[scheduleID] = &s
+	return scheduleID, nil
+}
+
+// GetSchedule retrieves a job schedule from storage
+func (m *Memory) GetSchedule(_ xcontext.Context, scheduleID types.ScheduleID) (*job.Schedule, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	schedule, ok := m.schedules[scheduleID]
+	if !ok {
+		return nil, fmt.Errorf("schedule %d not found", scheduleID)
+	}
+	s := *schedule
+	return &s, nil
+}
+
+// DeleteSchedule removes a job schedule from storage
+func (m *Memory) DeleteSchedule(_ xcontext.Context, scheduleID types.ScheduleID) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.schedules[scheduleID]; !ok {
+		return fmt.Errorf("schedule %d not found", scheduleID)
+	}
+	delete(m.schedules, scheduleID)
+	return nil
+}
+
+// ListSchedules returns the job schedules owned by a server, or all of them
+// if serverID is empty
+func (m *Memory) ListSchedules(_ xcontext.Context, serverID string) ([]*job.Schedule, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := []*job.Schedule{}
+	for _, schedule := range m.schedules {
+		if serverID != "" && schedule.ServerID != serverID {
+			continue
+		}
+		s := *schedule
+		res = append(res, &s)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+// UpdateScheduleLastRun records the last job submitted by a schedule
+func (m *Memory) UpdateScheduleLastRun(_ xcontext.Context, scheduleID types.ScheduleID, jobID types.JobID, runTime time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	schedule, ok := m.schedules[scheduleID]
+	if !ok {
+		return fmt.Errorf("schedule %d not found", scheduleID)
+	}
+	schedule.LastJobID = jobID
+	schedule.LastRunTime = runTime
+	return nil
+}
+
+// StoreJobLogs stores log entries captured while running jobs
+func (m *Memory) StoreJobLogs(_ xcontext.Context, entries []job.LogEntry) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, entry := range entries {
+		entry.SequenceID = uint64(len(m.logs)) + 1
+		m.logs = append(m.logs, entry)
+	}
+	return nil
+}
+
+// GetJobLogs returns the log entries of a job sorted by sequence ID
+func (m *Memory) GetJobLogs(_ xcontext.Context, query *storage.LogQuery) ([]job.LogEntry, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := []job.LogEntry{}
+	// Entries are sequentially numbered from 1, skip the ones already seen.
+	start := query.AfterSequenceID
+	if start > uint64(len(m.logs)) {
+		start = uint64(len(m.logs))
+	}
+	for _, entry := range m.logs[start:] {
+		if entry.JobID != query.JobID {
+			continue
+		}
+		res = append(res, entry)
+		if query.Limit > 0 && uint(len(res)) >= query.Limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+// copyInventoryTarget returns a copy of t which shares none of its maps.
+func copyInventoryTarget(t *inventory.Target) *inventory.Target {
+	c := *t
+	c.Labels, c.Attributes = nil, nil
+	if t.Labels != nil {
+		c.Labels = make(map[string]string, len(t.Labels))
+		for k, v := range t.Labels {
+			c.Labels[k] = v
+		}
+	}
+	if t.Attributes != nil {
+		c.Attributes = make(map[string]string, len(t.Attributes))
+		for k, v := range t.Attributes {
+			c.Attributes[k] = v
+		}
+	}
+	return &c
+}
+
+// AddInventoryTarget adds a target to the inventory
+func (m *Memory) AddInventoryTarget(_ xcontext.Context, target *inventory.Target) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.inventory[target.ID]; ok {
+		return fmt.Errorf("%w: %s", inventory.ErrAlreadyExists, target.ID)
+	}
+	m.inventory[target.ID] = copyInventoryTarget(target)
+	return nil
+}
+
+// UpdateInventoryTarget replaces a target of the inventory
+func (m *Memory) UpdateInventoryTarget(_ xcontext.Context, target *inventory.Target) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	existing, ok := m.inventory[target.ID]
+	if !ok {
+		return fmt.Errorf("%w: %s", inventory.ErrNotFound, target.ID)
+	}
+	t := copyInventoryTarget(target)
+	t.CreateTime = existing.CreateTime
+	m.inventory[target.ID] = t
+	return nil
+}
+
+// DeleteInventoryTarget removes a target from the inventory
+func (m *Memory) DeleteInventoryTarget(_ xcontext.Context, targetID string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, ok := m.inventory[targetID]; !ok {
+		return fmt.Errorf("%w: %s", inventory.ErrNotFound, targetID)
+	}
+	delete(m.inventory, targetID)
+	return nil
+}
+
+// ListInventoryTargets returns the targets of the inventory selected by a
+// query sorted by ID
+func (m *Memory) ListInventoryTargets(_ xcontext.Context, query *inventory.Query) ([]inventory.Target, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := []inventory.Target{}
+	for _, t := range m.inventory {
+		if query.Matches(t) {
+			res = append(res, *copyInventoryTarget(t))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+// StoreTargetOutcome records the outcome of a test step on a target
+func (m *Memory) StoreTargetOutcome(_ xcontext.Context, outcome *health.Outcome) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.outcomes[outcome.TargetID] = append(m.outcomes[outcome.TargetID], *outcome)
+	return nil
+}
+
+// GetTargetOutcomes returns up to limit of the latest outcomes of a target,
+// newest first
+func (m *Memory) GetTargetOutcomes(_ xcontext.Context, targetID string, limit uint) ([]health.Outcome, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	outcomes := m.outcomes[targetID]
+	res := []health.Outcome{}
+	for i := len(outcomes) - 1; i >= 0 && (limit == 0 || uint(len(res)) < limit); i-- {
+		res = append(res, outcomes[i])
+	}
+	return res, nil
+}
+
+// QuarantineTarget quarantines a target
+func (m *Memory) QuarantineTarget(_ xcontext.Context, quarantine *health.Quarantine) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if q, ok := m.quarantines[quarantine.TargetID]; ok && q.Active() {
+		return fmt.Errorf("%w: %s", health.ErrQuarantined, quarantine.TargetID)
+	}
+	q := *quarantine
+	q.Release = nil
+	m.quarantines[quarantine.TargetID] = &q
+	return nil
+}
+
+// ReleaseTarget releases a target from quarantine
+func (m *Memory) ReleaseTarget(_ xcontext.Context, targetID string, release *health.Release) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	q, ok := m.quarantines[targetID]
+	if !ok || !q.Active() {
+		return fmt.Errorf("%w: %s", health.ErrNotQuarantined, targetID)
+	}
+	r := *release
+	q.Release = &r
+	return nil
+}
+
+// GetTargetQuarantine returns the latest quarantine of a target
+func (m *Memory) GetTargetQuarantine(_ xcontext.Context, targetID string) (*health.Quarantine, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	q, ok := m.quarantines[targetID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", health.ErrNotFound, targetID)
+	}
+	c := *q
+	if q.Release != nil {
+		r := *q.Release
+		c.Release = &r
+	}
+	return &c, nil
+}
+
+// ListQuarantinedTargets returns the targets in quarantine sorted by ID
+func (m *Memory) ListQuarantinedTargets(_ xcontext.Context) ([]health.Quarantine, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	res := []health.Quarantine{}
+	for _, q := range m.quarantines {
+		if q.Active() {
+			res = append(res, *q)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].TargetID < res[j].TargetID })
+	return res, nil
+}
+
 // Close flushes pending events and closes the database connection.
 func (m *Memory) Close() error {
 	m.lock.Lock()
@@ -330,6 +709,11 @@ func (m *Memory) Close() error {
 	m.testEvents = nil
 	m.frameworkEvents = nil
 	m.jobInfo = nil
+	m.schedules = nil
+	m.logs = nil
+	m.inventory = nil
+	m.outcomes = nil
+	m.quarantines = nil
 	return nil
 }
 
@@ -341,8 +725,13 @@ func (m *Memory) Version() (uint64, error) {
 // New create a new Memory events storage backend
 func New() (storage.ResettableStorage, error) {
 	m := &Memory{
-		jobInfo:      make(map[types.JobID]*jobInfo),
-		jobIDCounter: 1,
+		jobInfo:         make(map[types.JobID]*jobInfo),
+		jobIDCounter:    1,
+		schedules:       make(map[types.ScheduleID]*job.Schedule),
+		scheduleCounter: 1,
+		inventory:       make(map[string]*inventory.Target),
+		outcomes:        make(map[string][]health.Outcome),
+		quarantines:     make(map[string]*health.Quarantine),
 	}
 	return m, nil
 }
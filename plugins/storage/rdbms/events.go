@@ -9,6 +9,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
@@ -17,6 +19,7 @@ import (
 	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/metrics/perf"
 
 	"github.com/google/go-safeweb/safesql"
 )
@@ -73,11 +76,30 @@ func buildEventQuery(baseQuery safesql.TrustedSQLString, eventQuery *event.Query
 	}
 	if eventQuery != nil && !eventQuery.EmittedEndTime.IsZero() {
 		selectClauses = append(selectClauses, safesql.New("emit_time<=?"))
-		fields = append(fields, eventQuery.EmittedStartTime)
+		fields = append(fields, eventQuery.EmittedEndTime)
+	}
+	if eventQuery != nil && eventQuery.AfterSequenceID != 0 {
+		selectClauses = append(selectClauses, safesql.New("event_id>?"))
+		fields = append(fields, eventQuery.AfterSequenceID)
 	}
 	return selectClauses, fields
 }
 
+// paginateEventQuery orders the events by sequence ID, which is what
+// AfterSequenceID pages through, and applies the limit of the query.
+func paginateEventQuery(query safesql.TrustedSQLString, fields []interface{}, eventQuery *event.Query) (safesql.TrustedSQLString, []interface{}) {
+	query = safesql.TrustedSQLStringConcat(query, safesql.New(" order by event_id"))
+	if eventQuery.Limit != 0 {
+		query = safesql.TrustedSQLStringConcat(query, safesql.New(" limit ?"))
+		fields = append(fields, eventQuery.Limit)
+	}
+	return query, fields
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern, so that it matches
+// the string literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func buildFrameworkEventQuery(baseQuery safesql.TrustedSQLString, frameworkEventQuery *frameworkevent.Query) (safesql.TrustedSQLString, []interface{}, error) {
 	selectClauses, fields := buildEventQuery(baseQuery, &frameworkEventQuery.Query)
 	query, err := assembleQuery(baseQuery, selectClauses)
@@ -85,6 +107,7 @@ func buildFrameworkEventQuery(baseQuery safesql.TrustedSQLString, frameworkEvent
 		return safesql.New(""), nil, fmt.Errorf("could not assemble query for framework events: %v", err)
 
 	}
+	query, fields = paginateEventQuery(query, fields, &frameworkEventQuery.Query)
 	return query, fields, nil
 }
 
@@ -108,11 +131,20 @@ func buildTestEventQuery(baseQuery safesql.TrustedSQLString, testEventQuery *tes
 		selectClauses = append(selectClauses, safesql.New("test_step_label=?"))
 		fields = append(fields, testEventQuery.TestStepLabel)
 	}
+	if testEventQuery.TargetID != "" {
+		selectClauses = append(selectClauses, safesql.New("target_id=?"))
+		fields = append(fields, testEventQuery.TargetID)
+	}
+	if testEventQuery.PayloadContains != "" {
+		selectClauses = append(selectClauses, safesql.New("payload like ?"))
+		fields = append(fields, "%"+likeEscaper.Replace(testEventQuery.PayloadContains)+"%")
+	}
 	query, err := assembleQuery(baseQuery, selectClauses)
 	if err != nil {
 		return safesql.New(""), nil, fmt.Errorf("could not assemble query for framework events: %v", err)
 
 	}
+	query, fields = paginateEventQuery(query, fields, &testEventQuery.Query)
 	return query, fields, nil
 }
 
@@ -159,6 +191,14 @@ func TestEventTestStepLabel(ev testevent.Event) interface{} {
 	return ev.Header.TestStepLabel
 }
 
+// TestEventTestStepAttempt returns the test step retry from an events.TestEvent object
+func TestEventTestStepAttempt(ev testevent.Event) interface{} {
+	if ev.Header == nil {
+		return nil
+	}
+	return ev.Header.TestStepAttempt
+}
+
 // TestEventName returns the event name from an events.TestEvent object
 func TestEventName(ev testevent.Event) interface{} {
 	if ev.Data == nil {
@@ -202,6 +242,17 @@ func (r *RDBMS) StoreTestEvent(_ xcontext.Context, event testevent.Event) error
 	return nil
 }
 
+// recordFlush records the flush of a non-empty event buffer which started at
+// start.
+func (r *RDBMS) recordFlush(buffer string, start time.Time) {
+	if r.metrics == nil {
+		return
+	}
+	m := r.metrics.WithTags(nil).WithTag(perf.TAG_STORAGE_BUFFER, buffer)
+	m.Count(perf.STORAGE_FLUSHES).Add(1)
+	m.Gauge(perf.STORAGE_FLUSH_SECONDS).Add(time.Since(start).Seconds())
+}
+
 // flushTestEventsLocked forces a flush of the pending test events to the database.
 // Requires that the caller has already locked the corresponding buffer.
 func (r *RDBMS) flushTestEventsLocked() error {
@@ -209,7 +260,11 @@ func (r *RDBMS) flushTestEventsLocked() error {
 	r.lockTx()
 	defer r.unlockTx()
 
-	insertStatement := safesql.New("insert into test_events (job_id, run_id, test_name, test_attempt, test_step_label, event_name, target_id, payload, emit_time) values (?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	if len(r.buffTestEvents) > 0 {
+		defer r.recordFlush(perf.STORAGE_BUFFER_TEST_EVENTS, time.Now())
+	}
+
+	insertStatement := safesql.New("insert into test_events (job_id, run_id, test_name, test_attempt, test_step_label, test_step_attempt, event_name, target_id, payload, emit_time) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 	for _, event := range r.buffTestEvents {
 		_, err := r.db.Exec(
 			insertStatement,
@@ -218,6 +273,7 @@ func (r *RDBMS) flushTestEventsLocked() error {
 			TestEventTestName(event),
 			TestEventTestAttempt(event),
 			TestEventTestStepLabel(event),
+			TestEventTestStepAttempt(event),
 			TestEventName(event),
 			TestEventTargetID(event),
 			TestEventPayload(event),
@@ -251,7 +307,7 @@ func (r *RDBMS) GetTestEvents(ctx xcontext.Context, eventQuery *testevent.Query)
 	r.lockTx()
 	defer r.unlockTx()
 
-	const baseQuery = "select event_id, job_id, run_id, test_name, test_attempt, test_step_label, event_name, target_id, payload, emit_time from test_events"
+	const baseQuery = "select event_id, job_id, run_id, test_name, test_attempt, test_step_label, test_step_attempt, event_name, target_id, payload, emit_time from test_events"
 	query, fields, err := buildTestEventQuery(safesql.New(baseQuery), eventQuery)
 	if err != nil {
 		return nil, fmt.Errorf("could not execute select query for test events: %v", err)
@@ -288,6 +344,7 @@ func (r *RDBMS) GetTestEvents(ctx xcontext.Context, eventQuery *testevent.Query)
 			&header.TestName,
 			&header.TestAttempt,
 			&header.TestStepLabel,
+			&header.TestStepAttempt,
 			&data.EventName,
 			&targetID,
 			&payload,
@@ -353,6 +410,8 @@ func (r *RDBMS) StoreFrameworkEvent(ctx xcontext.Context, event frameworkevent.E
 const (
 	insertFEStmt       = "INSERT INTO framework_events (job_id, event_name, payload, emit_time) VALUES (?, ?, ?, ?)"
 	updateJobStateStmt = "UPDATE jobs SET state = ? WHERE job_id = ?"
+	updateJobStartStmt = "UPDATE jobs SET start_time = ? WHERE job_id = ?"
+	updateJobEndStmt   = "UPDATE jobs SET end_time = ? WHERE job_id = ?"
 )
 
 // flushFrameworkEventsLocked forces a flush of the pending frameworks events to the database
@@ -361,8 +420,14 @@ func (r *RDBMS) flushFrameworkEventsLocked() error {
 	r.lockTx()
 	defer r.unlockTx()
 
+	if len(r.buffFrameworkEvents) > 0 {
+		defer r.recordFlush(perf.STORAGE_BUFFER_FRAMEWORK_EVENTS, time.Now())
+	}
+
 	// TODO: put this into a transaction.
 	jobStateUpdates := map[types.JobID]job.State{}
+	jobStartUpdates := map[types.JobID]time.Time{}
+	jobEndUpdates := map[types.JobID]time.Time{}
 	for _, event := range r.buffFrameworkEvents {
 		_, err := r.db.Exec(
 			safesql.New(insertFEStmt),
@@ -376,12 +441,27 @@ func (r *RDBMS) flushFrameworkEventsLocked() error {
 		if sn, err := job.EventNameToJobState(event.EventName); err == nil {
 			jobStateUpdates[event.JobID] = sn
 		}
+		if event.EventName == job.EventJobStarted {
+			jobStartUpdates[event.JobID] = event.EmitTime
+		} else if job.IsJobCompletionEvent(event.EventName) {
+			jobEndUpdates[event.JobID] = event.EmitTime
+		}
 	}
 	for jobID, state := range jobStateUpdates {
 		if _, err := r.db.Exec(safesql.New(updateJobStateStmt), state, jobID); err != nil {
 			return fmt.Errorf("could not update state of job %d: %w", jobID, err)
 		}
 	}
+	for jobID, startTime := range jobStartUpdates {
+		if _, err := r.db.Exec(safesql.New(updateJobStartStmt), startTime, jobID); err != nil {
+			return fmt.Errorf("could not update start time of job %d: %w", jobID, err)
+		}
+	}
+	for jobID, endTime := range jobEndUpdates {
+		if _, err := r.db.Exec(safesql.New(updateJobEndStmt), endTime, jobID); err != nil {
+			return fmt.Errorf("could not update end time of job %d: %w", jobID, err)
+		}
+	}
 	r.buffFrameworkEvents = nil
 	return nil
 }
@@ -0,0 +1,227 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package rdbms
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/google/go-safeweb/safesql"
+
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+const (
+	insertTargetOutcomeStmt     = "insert into target_outcomes (target_id, job_id, run_id, test_name, test_step_label, outcome_time, error, infrastructure) values (?, ?, ?, ?, ?, ?, ?, ?)"
+	selectTargetOutcomesStmt    = "select target_id, job_id, run_id, test_name, test_step_label, outcome_time, error, infrastructure from target_outcomes where target_id = ? order by outcome_id desc"
+	insertTargetQuarantineStmt  = "insert into target_quarantines (target_id, reason, requestor, quarantine_time) values (?, ?, ?, ?)"
+	updateTargetQuarantineStmt  = "update target_quarantines set reason = ?, requestor = ?, quarantine_time = ?, release_reason = null, release_requestor = null, release_time = null where target_id = ?"
+	releaseTargetQuarantineStmt = "update target_quarantines set release_reason = ?, release_requestor = ?, release_time = ? where target_id = ? and release_time is null"
+	selectTargetQuarantinesStmt = "select target_id, reason, requestor, quarantine_time, release_reason, release_requestor, release_time from target_quarantines"
+)
+
+// StoreTargetOutcome records the outcome of a test step on a target
+func (r *RDBMS) StoreTargetOutcome(_ xcontext.Context, outcome *health.Outcome) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	_, err := r.db.Exec(
+		safesql.New(insertTargetOutcomeStmt),
+		outcome.TargetID,
+		outcome.JobID,
+		outcome.RunID,
+		outcome.TestName,
+		outcome.TestStepLabel,
+		outcome.Time,
+		outcome.Error,
+		outcome.Infrastructure,
+	)
+	if err != nil {
+		return fmt.Errorf("could not store outcome of target %s: %w", outcome.TargetID, err)
+	}
+	return nil
+}
+
+// GetTargetOutcomes returns up to limit of the latest outcomes of a target,
+// newest first
+func (r *RDBMS) GetTargetOutcomes(ctx xcontext.Context, targetID string, limit uint) ([]health.Outcome, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	stmt := safesql.New(selectTargetOutcomesStmt)
+	args := []interface{}{targetID}
+	if limit > 0 {
+		stmt = safesql.TrustedSQLStringConcat(stmt, safesql.New(" limit ?"))
+		args = append(args, limit)
+	}
+	ctx.Debugf("Executing query: %s", stmt)
+	rows, err := r.db.Query(stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get outcomes of target %s: %w", targetID, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			ctx.Warnf("could not close rows for target outcomes: %v", err)
+		}
+	}()
+
+	res := []health.Outcome{}
+	for rows.Next() {
+		var (
+			outcome                 health.Outcome
+			testName, testStepLabel sql.NullString
+		)
+		err := rows.Scan(
+			&outcome.TargetID,
+			&outcome.JobID,
+			&outcome.RunID,
+			&testName,
+			&testStepLabel,
+			&outcome.Time,
+			&outcome.Error,
+			&outcome.Infrastructure,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not read target outcome: %w", err)
+		}
+		outcome.TestName = testName.String
+		outcome.TestStepLabel = testStepLabel.String
+		res = append(res, outcome)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get outcomes of target %s: %w", targetID, err)
+	}
+	return res, nil
+}
+
+// QuarantineTarget quarantines a target
+func (r *RDBMS) QuarantineTarget(ctx xcontext.Context, quarantine *health.Quarantine) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	existing, err := r.selectTargetQuarantines(ctx, quarantine.TargetID)
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 && existing[0].Active() {
+		return fmt.Errorf("%w: %s", health.ErrQuarantined, quarantine.TargetID)
+	}
+	if len(existing) > 0 {
+		_, err = r.db.Exec(safesql.New(updateTargetQuarantineStmt),
+			quarantine.Reason, quarantine.Requestor, quarantine.Time, quarantine.TargetID)
+	} else {
+		_, err = r.db.Exec(safesql.New(insertTargetQuarantineStmt),
+			quarantine.TargetID, quarantine.Reason, quarantine.Requestor, quarantine.Time)
+	}
+	if err != nil {
+		return fmt.Errorf("could not quarantine target %s: %w", quarantine.TargetID, err)
+	}
+	return nil
+}
+
+// ReleaseTarget releases a target from quarantine
+func (r *RDBMS) ReleaseTarget(_ xcontext.Context, targetID string, release *health.Release) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	result, err := r.db.Exec(safesql.New(releaseTargetQuarantineStmt), release.Reason, release.Requestor, release.Time, targetID)
+	if err != nil {
+		return fmt.Errorf("could not release target %s: %w", targetID, err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %s", health.ErrNotQuarantined, targetID)
+	}
+	return nil
+}
+
+// GetTargetQuarantine returns the latest quarantine of a target
+func (r *RDBMS) GetTargetQuarantine(ctx xcontext.Context, targetID string) (*health.Quarantine, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	quarantines, err := r.selectTargetQuarantines(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if len(quarantines) == 0 {
+		return nil, fmt.Errorf("%w: %s", health.ErrNotFound, targetID)
+	}
+	return &quarantines[0], nil
+}
+
+// ListQuarantinedTargets returns the targets in quarantine sorted by ID
+func (r *RDBMS) ListQuarantinedTargets(ctx xcontext.Context) ([]health.Quarantine, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	quarantines, err := r.selectTargetQuarantines(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	res := []health.Quarantine{}
+	for _, q := range quarantines {
+		if q.Active() {
+			res = append(res, q)
+		}
+	}
+	return res, nil
+}
+
+// selectTargetQuarantines returns the quarantines of the target with the
+// given ID, or of all the targets if it is empty, sorted by target ID.
+func (r *RDBMS) selectTargetQuarantines(ctx xcontext.Context, targetID string) ([]health.Quarantine, error) {
+	stmt := safesql.New(selectTargetQuarantinesStmt)
+	var args []interface{}
+	if targetID != "" {
+		stmt = safesql.TrustedSQLStringConcat(stmt, safesql.New(" where target_id = ?"))
+		args = append(args, targetID)
+	}
+	stmt = safesql.TrustedSQLStringConcat(stmt, safesql.New(" order by target_id"))
+	ctx.Debugf("Executing query: %s", stmt)
+	rows, err := r.db.Query(stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not list target quarantines: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			ctx.Warnf("could not close rows for target quarantines: %v", err)
+		}
+	}()
+
+	res := []health.Quarantine{}
+	for rows.Next() {
+		var (
+			q                               health.Quarantine
+			releaseReason, releaseRequestor sql.NullString
+			releaseTime                     sql.NullTime
+		)
+		err := rows.Scan(
+			&q.TargetID,
+			&q.Reason,
+			&q.Requestor,
+			&q.Time,
+			&releaseReason,
+			&releaseRequestor,
+			&releaseTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not read target quarantine: %w", err)
+		}
+		if releaseTime.Valid {
+			q.Release = &health.Release{
+				Reason:    releaseReason.String,
+				Requestor: releaseRequestor.String,
+				Time:      releaseTime.Time,
+			}
+		}
+		res = append(res, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list target quarantines: %w", err)
+	}
+	return res, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
 	"github.com/linuxboot/contest/pkg/event/testevent"
 	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/xcontext/metrics"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/linuxboot/contest/tools/migration/rdbms/migrationlib"
@@ -74,6 +75,9 @@ type RDBMS struct {
 	testEventsFlushInterval      time.Duration
 	frameworkEventsFlushSize     int
 	frameworkEventsFlushInterval time.Duration
+
+	// metrics records the flushes of the buffers, it may be nil.
+	metrics metrics.Metrics
 }
 
 func (r *RDBMS) lockTx() {
@@ -148,6 +152,10 @@ func (r *RDBMS) Reset() error {
 		safesql.New("final_reports"),
 		safesql.New("test_events"),
 		safesql.New("framework_events"),
+		safesql.New("job_logs"),
+		safesql.New("inventory_targets"),
+		safesql.New("target_outcomes"),
+		safesql.New("target_quarantines"),
 	} {
 		if _, err := r.db.Exec(safesql.TrustedSQLStringConcat(safesql.New("TRUNCATE TABLE "), t)); err != nil {
 			return err
@@ -254,6 +262,14 @@ func FrameworkEventsFlushInterval(flushInterval time.Duration) Opt {
 	}
 }
 
+// Metrics defines where the number and the duration of the flushes of the
+// event buffers are recorded.
+func Metrics(m metrics.Metrics) Opt {
+	return func(rdbms *RDBMS) {
+		rdbms.metrics = m
+	}
+}
+
 // DriverName allows using a mysql-compatible driver (e.g. a wrapper around mysql
 // or a syntax-compatible variant).
 func DriverName(name string) Opt {
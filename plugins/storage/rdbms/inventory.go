@@ -0,0 +1,175 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package rdbms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/google/go-safeweb/safesql"
+
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+const (
+	insertInventoryTargetStmt  = "insert into inventory_targets (target_id, fqdn, primary_ipv4, primary_ipv6, labels, attributes, create_time, update_time) values (?, ?, ?, ?, ?, ?, ?, ?)"
+	updateInventoryTargetStmt  = "update inventory_targets set fqdn = ?, primary_ipv4 = ?, primary_ipv6 = ?, labels = ?, attributes = ?, update_time = ? where target_id = ?"
+	selectInventoryTargetsStmt = "select target_id, fqdn, primary_ipv4, primary_ipv6, labels, attributes, create_time, update_time from inventory_targets"
+)
+
+// inventoryTargetColumns returns the values of the columns of a target which
+// can be updated, in the order of updateInventoryTargetStmt.
+func inventoryTargetColumns(target *inventory.Target) ([]interface{}, error) {
+	labels, err := json.Marshal(target.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("could not serialize labels of target %s: %w", target.ID, err)
+	}
+	attributes, err := json.Marshal(target.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("could not serialize attributes of target %s: %w", target.ID, err)
+	}
+	var ipv4, ipv6 string
+	if target.PrimaryIPv4 != nil {
+		ipv4 = target.PrimaryIPv4.String()
+	}
+	if target.PrimaryIPv6 != nil {
+		ipv6 = target.PrimaryIPv6.String()
+	}
+	return []interface{}{target.FQDN, ipv4, ipv6, string(labels), string(attributes)}, nil
+}
+
+// AddInventoryTarget adds a target to the inventory
+func (r *RDBMS) AddInventoryTarget(ctx xcontext.Context, target *inventory.Target) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	existing, err := r.selectInventoryTargets(ctx, &inventory.Query{IDs: []string{target.ID}})
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 {
+		return fmt.Errorf("%w: %s", inventory.ErrAlreadyExists, target.ID)
+	}
+	columns, err := inventoryTargetColumns(target)
+	if err != nil {
+		return err
+	}
+	args := append([]interface{}{target.ID}, columns...)
+	args = append(args, target.CreateTime, target.UpdateTime)
+	if _, err := r.db.Exec(safesql.New(insertInventoryTargetStmt), args...); err != nil {
+		return fmt.Errorf("could not store target %s in database: %w", target.ID, err)
+	}
+	return nil
+}
+
+// UpdateInventoryTarget replaces a target of the inventory
+func (r *RDBMS) UpdateInventoryTarget(ctx xcontext.Context, target *inventory.Target) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	// MySQL does not count the rows left unchanged as affected, check that
+	// the target exists beforehand.
+	existing, err := r.selectInventoryTargets(ctx, &inventory.Query{IDs: []string{target.ID}})
+	if err != nil {
+		return err
+	}
+	if len(existing) == 0 {
+		return fmt.Errorf("%w: %s", inventory.ErrNotFound, target.ID)
+	}
+	columns, err := inventoryTargetColumns(target)
+	if err != nil {
+		return err
+	}
+	args := append(columns, target.UpdateTime, target.ID)
+	if _, err := r.db.Exec(safesql.New(updateInventoryTargetStmt), args...); err != nil {
+		return fmt.Errorf("could not update target %s: %w", target.ID, err)
+	}
+	return nil
+}
+
+// DeleteInventoryTarget removes a target from the inventory
+func (r *RDBMS) DeleteInventoryTarget(_ xcontext.Context, targetID string) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	result, err := r.db.Exec(safesql.New("delete from inventory_targets where target_id = ?"), targetID)
+	if err != nil {
+		return fmt.Errorf("could not delete target %s: %w", targetID, err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %s", inventory.ErrNotFound, targetID)
+	}
+	return nil
+}
+
+// ListInventoryTargets returns the targets of the inventory selected by a
+// query sorted by ID
+func (r *RDBMS) ListInventoryTargets(ctx xcontext.Context, query *inventory.Query) ([]inventory.Target, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	return r.selectInventoryTargets(ctx, query)
+}
+
+// selectInventoryTargets reads the whole inventory and filters it, label
+// selectors cannot be expressed on the serialized labels, and inventories
+// are small.
+func (r *RDBMS) selectInventoryTargets(ctx xcontext.Context, query *inventory.Query) ([]inventory.Target, error) {
+	stmt := safesql.TrustedSQLStringConcat(safesql.New(selectInventoryTargetsStmt), safesql.New(" order by target_id"))
+	ctx.Debugf("Executing query: %s", stmt)
+	rows, err := r.db.Query(stmt)
+	if err != nil {
+		return nil, fmt.Errorf("could not list inventory targets: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			ctx.Warnf("could not close rows for inventory targets: %v", err)
+		}
+	}()
+
+	res := []inventory.Target{}
+	for rows.Next() {
+		var (
+			target             inventory.Target
+			ipv4, ipv6         string
+			labels, attributes string
+		)
+		err := rows.Scan(
+			&target.ID,
+			&target.FQDN,
+			&ipv4,
+			&ipv6,
+			&labels,
+			&attributes,
+			&target.CreateTime,
+			&target.UpdateTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not read inventory target: %w", err)
+		}
+		if ipv4 != "" {
+			target.PrimaryIPv4 = net.ParseIP(ipv4)
+		}
+		if ipv6 != "" {
+			target.PrimaryIPv6 = net.ParseIP(ipv6)
+		}
+		if err := json.Unmarshal([]byte(labels), &target.Labels); err != nil {
+			return nil, fmt.Errorf("invalid labels for target %s: %w", target.ID, err)
+		}
+		if err := json.Unmarshal([]byte(attributes), &target.Attributes); err != nil {
+			return nil, fmt.Errorf("invalid attributes for target %s: %w", target.ID, err)
+		}
+		if query.Matches(&target) {
+			res = append(res, target)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list inventory targets: %w", err)
+	}
+	return res, nil
+}
@@ -6,16 +6,26 @@
 package rdbms
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/google/go-safeweb/safesql"
+
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
 
-func (r *RDBMS) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]types.JobID, error) {
-	res := []types.JobID{}
+// likeWildcards translates the wildcards of a job name pattern to the ones of
+// LIKE, once the pattern has been escaped with likeEscaper.
+var likeWildcards = strings.NewReplacer("*", "%", "?", "_")
+
+// ListJobs returns the summaries of the jobs matching the query.
+func (r *RDBMS) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]job.Summary, error) {
+	res := []job.Summary{}
 
 	// Quoting SQL strings is hard. https://github.com/golang/go/issues/18478
 	// For now, just disallow anything that is not [a-zA-Z0-9_-]
@@ -30,7 +40,8 @@ func (r *RDBMS) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]types.J
 	}
 
 	// Construct the query.
-	parts := []safesql.TrustedSQLString{safesql.New("SELECT jobs.job_id FROM jobs")}
+	parts := []safesql.TrustedSQLString{safesql.New(
+		"SELECT jobs.job_id, jobs.name, jobs.state, jobs.requestor, jobs.server_id, jobs.request_time, jobs.start_time, jobs.end_time FROM jobs")}
 	qargs := []interface{}{}
 	// Tag filtering uses joins, 1 per tag.
 	for i := range query.Tags {
@@ -42,7 +53,7 @@ func (r *RDBMS) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]types.J
 				safesql.New(" ON jobs.job_id = jt"),
 				safesql.NewFromUint64(uint64(i)),
 				safesql.New(".job_id"),
-				),
+			),
 		)
 	}
 	var conds []safesql.TrustedSQLString
@@ -50,6 +61,36 @@ func (r *RDBMS) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]types.J
 		conds = append(conds, safesql.New("jobs.server_id = ?"))
 		qargs = append(qargs, query.ServerID)
 	}
+	if len(query.Requestor) > 0 {
+		conds = append(conds, safesql.New("jobs.requestor = ?"))
+		qargs = append(qargs, query.Requestor)
+	}
+	if len(query.NamePattern) > 0 {
+		conds = append(conds, safesql.New("jobs.name LIKE ?"))
+		qargs = append(qargs, likeWildcards.Replace(likeEscaper.Replace(query.NamePattern)))
+	}
+	for _, timeCond := range []struct {
+		cond safesql.TrustedSQLString
+		t    time.Time
+	}{
+		{safesql.New("jobs.request_time >= ?"), query.RequestedAfter},
+		{safesql.New("jobs.request_time <= ?"), query.RequestedBefore},
+		{safesql.New("jobs.end_time >= ?"), query.EndedAfter},
+		{safesql.New("jobs.end_time <= ?"), query.EndedBefore},
+	} {
+		if !timeCond.t.IsZero() {
+			conds = append(conds, timeCond.cond)
+			qargs = append(qargs, timeCond.t)
+		}
+	}
+	if query.AfterJobID != 0 {
+		if query.NewestFirst {
+			conds = append(conds, safesql.New("jobs.job_id < ?"))
+		} else {
+			conds = append(conds, safesql.New("jobs.job_id > ?"))
+		}
+		qargs = append(qargs, query.AfterJobID)
+	}
 	if len(query.States) > 0 {
 		stst := make([]safesql.TrustedSQLString, len(query.States))
 		for i, st := range query.States {
@@ -62,7 +103,7 @@ func (r *RDBMS) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]types.J
 				safesql.New("jobs.state IN ("),
 				safesql.TrustedSQLStringJoin(stst, safesql.New(", ")),
 				safesql.New(")")),
-			)
+		)
 	}
 	// Now the corresponding conditions.
 	for i, tag := range query.Tags {
@@ -75,30 +116,94 @@ func (r *RDBMS) ListJobs(_ xcontext.Context, query *storage.JobQuery) ([]types.J
 	if len(conds) > 0 {
 		parts = append(parts, safesql.New("WHERE"), safesql.TrustedSQLStringJoin(conds, safesql.New(" AND ")))
 	}
-	/* Examples of the resulting queries:
-	SELECT jobs.job_id FROM jobs
-	SELECT jobs.job_id FROM jobs WHERE jobs.state IN (0)
-	SELECT jobs.job_id FROM jobs WHERE jobs.state IN (2, 3)
-	SELECT jobs.job_id FROM jobs INNER JOIN job_tags jt0 ON jobs.job_id = jt0.job_id WHERE jt0.tag = "tests"
-	SELECT jobs.job_id FROM jobs INNER JOIN job_tags jt0 ON jobs.job_id = jt0.job_id INNER JOIN job_tags jt1 ON jobs.job_id = jt1.job_id WHERE jobs.state IN (2, 3, 4) AND jt0.tag = "tests" AND jt1.tag = "foo"
+	/* Examples of the resulting queries (selected columns omitted):
+	SELECT ... FROM jobs ORDER BY jobs.job_id
+	SELECT ... FROM jobs WHERE jobs.state IN (0) ORDER BY jobs.job_id
+	SELECT ... FROM jobs WHERE jobs.requestor = "someone" AND jobs.state IN (2, 3) ORDER BY jobs.job_id DESC LIMIT 10
+	SELECT ... FROM jobs INNER JOIN job_tags jt0 ON jobs.job_id = jt0.job_id WHERE jt0.tag = "tests" ORDER BY jobs.job_id
+	SELECT ... FROM jobs INNER JOIN job_tags jt0 ON jobs.job_id = jt0.job_id INNER JOIN job_tags jt1 ON jobs.job_id = jt1.job_id WHERE jobs.state IN (2, 3, 4) AND jt0.tag = "tests" AND jt1.tag = "foo" ORDER BY jobs.job_id
 	*/
-	parts = append(parts, safesql.New("ORDER BY jobs.job_id"))
+	if query.NewestFirst {
+		parts = append(parts, safesql.New("ORDER BY jobs.job_id DESC"))
+	} else {
+		parts = append(parts, safesql.New("ORDER BY jobs.job_id"))
+	}
+	if query.Limit != 0 {
+		parts = append(parts, safesql.New("LIMIT ?"))
+		qargs = append(qargs, query.Limit)
+	}
 	stmt := safesql.TrustedSQLStringJoin(parts, safesql.New(" "))
 
 	rows, err := r.db.Query(stmt, qargs...)
 	if err != nil {
-		return nil, fmt.Errorf("could not list jobs in states (sql: %q): %w", stmt, err)
+		return nil, fmt.Errorf("could not list jobs (sql: %q): %w", stmt, err)
 	}
 	defer func() {
 		_ = rows.Close()
 	}()
+	byID := map[types.JobID]*job.Summary{}
 	for rows.Next() {
-		var jobID types.JobID
-		if err := rows.Scan(&jobID); err != nil {
-			return nil, fmt.Errorf("could not list jobs in states (sql: %q): %w", stmt, err)
+		var (
+			summary            job.Summary
+			startTime, endTime sql.NullTime
+		)
+		if err := rows.Scan(
+			&summary.JobID,
+			&summary.Name,
+			&summary.State,
+			&summary.Requestor,
+			&summary.ServerID,
+			&summary.RequestTime,
+			&startTime,
+			&endTime,
+		); err != nil {
+			return nil, fmt.Errorf("could not list jobs (sql: %q): %w", stmt, err)
 		}
-		res = append(res, jobID)
+		if startTime.Valid {
+			summary.StartTime = startTime.Time
+		}
+		if endTime.Valid {
+			summary.EndTime = &endTime.Time
+		}
+		res = append(res, summary)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list jobs (sql: %q): %w", stmt, err)
+	}
+	if len(res) == 0 {
+		return res, nil
 	}
 
-	return res, nil
+	// Fetch the tags of the listed jobs.
+	ids := make([]safesql.TrustedSQLString, len(res))
+	idArgs := make([]interface{}, len(res))
+	for i := range res {
+		ids[i] = safesql.New("?")
+		idArgs[i] = res[i].JobID
+		byID[res[i].JobID] = &res[i]
+	}
+	tagsStmt := safesql.TrustedSQLStringConcat(
+		safesql.New("SELECT job_id, tag FROM job_tags WHERE job_id IN ("),
+		safesql.TrustedSQLStringJoin(ids, safesql.New(", ")),
+		safesql.New(") ORDER BY job_id, tag"))
+	tagRows, err := r.db.Query(tagsStmt, idArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("could not list job tags (sql: %q): %w", tagsStmt, err)
+	}
+	defer func() {
+		_ = tagRows.Close()
+	}()
+	for tagRows.Next() {
+		var (
+			jobID types.JobID
+			tag   string
+		)
+		if err := tagRows.Scan(&jobID, &tag); err != nil {
+			return nil, fmt.Errorf("could not list job tags (sql: %q): %w", tagsStmt, err)
+		}
+		if summary := byID[jobID]; summary != nil {
+			summary.Tags = append(summary.Tags, tag)
+		}
+	}
+	return res, tagRows.Err()
 }
@@ -0,0 +1,70 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package rdbms
+
+import (
+	"fmt"
+
+	"github.com/google/go-safeweb/safesql"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+const (
+	insertJobLogStmt  = "insert into job_logs (job_id, log_time, level, message) values (?, ?, ?, ?)"
+	selectJobLogsStmt = "select sequence_id, job_id, log_time, level, message from job_logs where job_id = ? and sequence_id > ? order by sequence_id"
+)
+
+// StoreJobLogs stores log entries captured while running jobs
+func (r *RDBMS) StoreJobLogs(_ xcontext.Context, entries []job.LogEntry) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	for _, entry := range entries {
+		if _, err := r.db.Exec(safesql.New(insertJobLogStmt), entry.JobID, entry.Time, entry.Level, entry.Message); err != nil {
+			return fmt.Errorf("could not store log entry of job %d: %w", entry.JobID, err)
+		}
+	}
+	return nil
+}
+
+// GetJobLogs returns the log entries of a job sorted by sequence ID
+func (r *RDBMS) GetJobLogs(ctx xcontext.Context, query *storage.LogQuery) ([]job.LogEntry, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	stmt := safesql.New(selectJobLogsStmt)
+	args := []interface{}{query.JobID, query.AfterSequenceID}
+	if query.Limit > 0 {
+		stmt = safesql.TrustedSQLStringConcat(stmt, safesql.New(" limit ?"))
+		args = append(args, query.Limit)
+	}
+	ctx.Debugf("Executing query: %s", stmt)
+	rows, err := r.db.Query(stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not get logs of job %d: %w", query.JobID, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			ctx.Warnf("could not close rows for job logs: %v", err)
+		}
+	}()
+
+	res := []job.LogEntry{}
+	for rows.Next() {
+		var entry job.LogEntry
+		if err := rows.Scan(&entry.SequenceID, &entry.JobID, &entry.Time, &entry.Level, &entry.Message); err != nil {
+			return nil, fmt.Errorf("could not read log entry: %w", err)
+		}
+		res = append(res, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not get logs of job %d: %w", query.JobID, err)
+	}
+	return res, nil
+}
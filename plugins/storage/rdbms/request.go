@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-safeweb/safesql"
 
 	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/types"
 	"github.com/linuxboot/contest/pkg/xcontext"
 )
@@ -108,7 +109,7 @@ func (r *RDBMS) GetJobRequest(ctx xcontext.Context, jobID types.JobID) (*job.Req
 	}
 
 	if req == nil {
-		return nil, fmt.Errorf("could not find request with JobID %d", jobID)
+		return nil, fmt.Errorf("could not find request with JobID %d: %w", jobID, storage.ErrJobNotFound)
 	}
 
 	extendedDescriptor := job.ExtendedDescriptor{}
@@ -0,0 +1,143 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package rdbms
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/go-safeweb/safesql"
+
+	"github.com/linuxboot/contest/pkg/job"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+const (
+	insertScheduleStmt = "insert into schedules (cron_expression, descriptor, requestor, server_id, skip_if_running, create_time) values (?, ?, ?, ?, ?, ?)"
+	selectScheduleStmt = "select schedule_id, cron_expression, descriptor, requestor, server_id, skip_if_running, create_time, last_job_id, last_run_time from schedules"
+)
+
+// StoreSchedule stores a new job schedule in the database
+func (r *RDBMS) StoreSchedule(_ xcontext.Context, schedule *job.Schedule) (types.ScheduleID, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	result, err := r.db.Exec(safesql.New(insertScheduleStmt),
+		schedule.CronExpression, schedule.JobDescriptor, schedule.Requestor,
+		schedule.ServerID, schedule.SkipIfRunning, schedule.CreateTime)
+	if err != nil {
+		return 0, fmt.Errorf("could not store schedule in database: %w", err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("could not extract id of last schedule inserted into db")
+	}
+	schedule.ID = types.ScheduleID(lastID)
+	return schedule.ID, nil
+}
+
+// GetSchedule retrieves a job schedule from the database
+func (r *RDBMS) GetSchedule(ctx xcontext.Context, scheduleID types.ScheduleID) (*job.Schedule, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	schedules, err := r.selectSchedules(ctx,
+		safesql.TrustedSQLStringConcat(safesql.New(selectScheduleStmt), safesql.New(" where schedule_id = ?")),
+		scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf("could not find schedule with id %d", scheduleID)
+	}
+	return schedules[0], nil
+}
+
+// DeleteSchedule removes a job schedule from the database
+func (r *RDBMS) DeleteSchedule(_ xcontext.Context, scheduleID types.ScheduleID) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	result, err := r.db.Exec(safesql.New("delete from schedules where schedule_id = ?"), scheduleID)
+	if err != nil {
+		return fmt.Errorf("could not delete schedule %d: %w", scheduleID, err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("could not find schedule with id %d", scheduleID)
+	}
+	return nil
+}
+
+// ListSchedules returns the job schedules owned by a server, or all of them
+// if serverID is empty
+func (r *RDBMS) ListSchedules(ctx xcontext.Context, serverID string) ([]*job.Schedule, error) {
+	r.lockTx()
+	defer r.unlockTx()
+
+	if serverID == "" {
+		return r.selectSchedules(ctx,
+			safesql.TrustedSQLStringConcat(safesql.New(selectScheduleStmt), safesql.New(" order by schedule_id")))
+	}
+	return r.selectSchedules(ctx,
+		safesql.TrustedSQLStringConcat(safesql.New(selectScheduleStmt), safesql.New(" where server_id = ? order by schedule_id")),
+		serverID)
+}
+
+// UpdateScheduleLastRun records the last job submitted by a schedule
+func (r *RDBMS) UpdateScheduleLastRun(_ xcontext.Context, scheduleID types.ScheduleID, jobID types.JobID, runTime time.Time) error {
+	r.lockTx()
+	defer r.unlockTx()
+
+	if _, err := r.db.Exec(safesql.New("update schedules set last_job_id = ?, last_run_time = ? where schedule_id = ?"), jobID, runTime, scheduleID); err != nil {
+		return fmt.Errorf("could not update schedule %d: %w", scheduleID, err)
+	}
+	return nil
+}
+
+func (r *RDBMS) selectSchedules(ctx xcontext.Context, stmt safesql.TrustedSQLString, args ...interface{}) ([]*job.Schedule, error) {
+	ctx.Debugf("Executing query: %s", stmt)
+	rows, err := r.db.Query(stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not list schedules: %w", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			ctx.Warnf("could not close rows for schedules: %v", err)
+		}
+	}()
+
+	res := []*job.Schedule{}
+	for rows.Next() {
+		var (
+			schedule    job.Schedule
+			lastRunTime sql.NullTime
+		)
+		err := rows.Scan(
+			&schedule.ID,
+			&schedule.CronExpression,
+			&schedule.JobDescriptor,
+			&schedule.Requestor,
+			&schedule.ServerID,
+			&schedule.SkipIfRunning,
+			&schedule.CreateTime,
+			&schedule.LastJobID,
+			&lastRunTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not read schedule: %w", err)
+		}
+		if lastRunTime.Valid {
+			schedule.LastRunTime = lastRunTime.Time
+		}
+		res = append(res, &schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not list schedules: %w", err)
+	}
+	return res, nil
+}
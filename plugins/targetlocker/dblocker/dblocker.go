@@ -82,6 +82,8 @@ type DBLocker struct {
 	maxBatchSize int
 	// clock is used for measuring time
 	clock clock.Clock
+	// queue holds the jobs waiting for targets on this server
+	queue *target.WaitQueue
 }
 
 // queryLocks returns a map of ID -> dblock for a given list of targets
@@ -348,9 +350,89 @@ func (d *DBLocker) Unlock(ctx xcontext.Context, jobID types.JobID, targets []*ta
 	}
 	err := d.handleUnlock(ctx, int64(jobID), targetIDList(targets))
 	ctx.Debugf("Unlock %d targets: %v", len(targets), err)
+	if err == nil {
+		d.queue.Notify()
+	}
 	return err
 }
 
+// ListLocks lists the locks on the given targets, or all the locks.
+// See target.LockLister for API details
+func (d *DBLocker) ListLocks(ctx xcontext.Context, targetIDs []string) ([]target.Lock, error) {
+	q := "SELECT target_id, job_id, created_at, expires_at FROM locks"
+	args := make([]interface{}, 0, len(targetIDs))
+	if len(targetIDs) > 0 {
+		q += " WHERE target_id IN " + listQueryString(uint(len(targetIDs)))
+		for _, targetID := range targetIDs {
+			args = append(args, targetID)
+		}
+	}
+	q += " ORDER BY target_id"
+
+	rows, err := d.db.Query(q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read existing locks: %w", err)
+	}
+	defer rows.Close()
+
+	var res []target.Lock
+	for rows.Next() {
+		var row dblock
+		if err := rows.Scan(&row.targetID, &row.jobID, &row.createdAt, &row.expiresAt); err != nil {
+			return nil, fmt.Errorf("unexpected read from database: %w", err)
+		}
+		res = append(res, target.Lock{
+			TargetID:  row.targetID,
+			JobID:     types.JobID(row.jobID),
+			CreatedAt: row.createdAt,
+			ExpiresAt: row.expiresAt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("unexpected error iterating db read results: %w", err)
+	}
+	return res, nil
+}
+
+// WaitLock locks up to limit of the given targets, waiting for at least min
+// of them to be available.
+// The jobs are queued on this server only, jobs waiting on other servers
+// compete for the targets as if they were not queued.
+// See target.WaitLocker for API details
+func (d *DBLocker) WaitLock(ctx xcontext.Context, jobID types.JobID, priority int, duration time.Duration, targets []*target.Target, min, limit uint) ([]string, error) {
+	if err := validateTargets(targets); err != nil {
+		return nil, fmt.Errorf("invalid waitLock request: %w", err)
+	}
+	return d.queue.WaitLock(ctx, jobID, priority, duration, targets, min, limit)
+}
+
+// Waiters returns the jobs waiting for targets on this server.
+// See target.WaitLocker for API details
+func (d *DBLocker) Waiters() []target.Waiter {
+	return d.queue.Waiters()
+}
+
+// available returns the targets which are not locked by another job, or
+// whose lock expired.
+func (d *DBLocker) available(ctx xcontext.Context, jobID types.JobID, targets []*target.Target) ([]*target.Target, error) {
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	locks, err := d.queryLocks(d.db, targetIDList(targets))
+	if err != nil {
+		return nil, err
+	}
+	now := d.clock.Now()
+	res := make([]*target.Target, 0, len(targets))
+	for _, t := range targets {
+		l, ok := locks[t.ID]
+		if !ok || l.jobID == int64(jobID) || l.expiresAt.Before(now) {
+			res = append(res, t)
+		}
+	}
+	return res, nil
+}
+
 // RefreshLocks refreshes the locks on the given targets.
 // See target.Locker for API details
 func (d *DBLocker) RefreshLocks(ctx xcontext.Context, jobID types.JobID, duration time.Duration, targets []*target.Target) error {
@@ -427,5 +509,6 @@ func New(dbURI string, opts ...Opt) (*DBLocker, error) {
 		return nil, fmt.Errorf("unable to contact database: %w", err)
 	}
 	res.db = db
+	res.queue = target.NewWaitQueue(res, res.available, res.clock, target.DefaultWaitInterval)
 	return res, nil
 }
@@ -10,6 +10,7 @@ package inmemory
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/benbjohnson/clock"
@@ -30,6 +31,8 @@ type request struct {
 	// allowConflicts enabled tryLock semantics: Return what can be locked, but
 	// don't error on conflicts
 	allowConflicts bool
+	// dryRun only reports what the request would lock, without locking it.
+	dryRun bool
 	// owner is the owner of the lock, relative to the lock operation request,
 	// represented by a job ID.
 	// The in-memory locker enforces that the requests are validated against the
@@ -43,6 +46,8 @@ type request struct {
 	timeout time.Duration
 	// locked is list of target IDs that were locked in this transaction (if any)
 	locked []string
+	// locks is the list of locks returned by a list request
+	locks []target.Lock
 	// err reports whether there were errors in any lock-related operation.
 	err chan error
 }
@@ -71,7 +76,7 @@ func validateRequest(req *request) error {
 // broker is the broker of locking requests, and it's the only goroutine with
 // access to the locks map, in accordance with Go's "share memory by
 // communicating" principle.
-func broker(clk clock.Clock, lockRequests, unlockRequests <-chan *request, done <-chan struct{}) {
+func broker(clk clock.Clock, lockRequests, unlockRequests, listRequests <-chan *request, done <-chan struct{}) {
 	locks := make(map[string]lock)
 	for {
 		select {
@@ -138,7 +143,13 @@ func broker(clk clock.Clock, lockRequests, unlockRequests <-chan *request, done
 				}
 			}
 			req.locked = make([]string, 0, len(newLocks))
-			if lockErr == nil {
+			if lockErr == nil && req.dryRun {
+				for _, t := range req.targets {
+					if _, ok := newLocks[t.ID]; ok {
+						req.locked = append(req.locked, t.ID)
+					}
+				}
+			} else if lockErr == nil {
 				// everything in this transaction was OK - update the locks
 				for id, l := range newLocks {
 					locks[id] = l
@@ -172,14 +183,36 @@ func broker(clk clock.Clock, lockRequests, unlockRequests <-chan *request, done
 				}
 			}
 			req.err <- unlockErr
+		case req := <-listRequests:
+			wanted := make(map[string]bool, len(req.targets))
+			for _, t := range req.targets {
+				wanted[t.ID] = true
+			}
+			req.locks = make([]target.Lock, 0, len(locks))
+			for id, l := range locks {
+				if len(wanted) > 0 && !wanted[id] {
+					continue
+				}
+				req.locks = append(req.locks, target.Lock{
+					TargetID:  id,
+					JobID:     l.owner,
+					CreatedAt: l.createdAt,
+					ExpiresAt: l.expiresAt,
+				})
+			}
+			sort.Slice(req.locks, func(i, j int) bool {
+				return req.locks[i].TargetID < req.locks[j].TargetID
+			})
+			req.err <- nil
 		}
 	}
 }
 
 // InMemory locks targets in an in-memory map.
 type InMemory struct {
-	lockRequests, unlockRequests chan *request
-	done                         chan struct{}
+	lockRequests, unlockRequests, listRequests chan *request
+	done                                       chan struct{}
+	queue                                      *target.WaitQueue
 }
 
 func newReq(ctx xcontext.Context, jobID types.JobID, targets []*target.Target) request {
@@ -224,9 +257,48 @@ func (tl *InMemory) Unlock(ctx xcontext.Context, jobID types.JobID, targets []*t
 	tl.unlockRequests <- &req
 	err := <-req.err
 	ctx.Debugf("Unlock %d targets: %v", len(targets), err)
+	if err == nil {
+		tl.queue.Notify()
+	}
 	return err
 }
 
+// ListLocks lists the locks on the specified targets, or all the locks.
+func (tl *InMemory) ListLocks(ctx xcontext.Context, targetIDs []string) ([]target.Lock, error) {
+	targets := make([]*target.Target, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		targets = append(targets, &target.Target{ID: id})
+	}
+	req := newReq(ctx, 0, targets)
+	tl.listRequests <- &req
+	err := <-req.err
+	return req.locks, err
+}
+
+// WaitLock locks up to limit of the specified targets, waiting for at least
+// min of them to be available.
+func (tl *InMemory) WaitLock(ctx xcontext.Context, jobID types.JobID, priority int, duration time.Duration, targets []*target.Target, min, limit uint) ([]string, error) {
+	return tl.queue.WaitLock(ctx, jobID, priority, duration, targets, min, limit)
+}
+
+// Waiters returns the jobs waiting for targets.
+func (tl *InMemory) Waiters() []target.Waiter {
+	return tl.queue.Waiters()
+}
+
+// available returns the targets which are not locked by another job.
+func (tl *InMemory) available(ctx xcontext.Context, jobID types.JobID, targets []*target.Target) ([]*target.Target, error) {
+	req := newReq(ctx, jobID, targets)
+	req.allowConflicts = true
+	req.dryRun = true
+	req.limit = uint(len(targets))
+	tl.lockRequests <- &req
+	if err := <-req.err; err != nil {
+		return nil, err
+	}
+	return target.FilterTargets(req.locked, targets)
+}
+
 // RefreshLocks extends the lock duration by the internally configured timeout. If
 // the owner is different, the request is rejected.
 func (tl *InMemory) RefreshLocks(ctx xcontext.Context, jobID types.JobID, duration time.Duration, targets []*target.Target) error {
@@ -253,11 +325,15 @@ func (tl *InMemory) Close() error {
 func New(clk clock.Clock) target.Locker {
 	lockRequests := make(chan *request)
 	unlockRequests := make(chan *request)
+	listRequests := make(chan *request)
 	done := make(chan struct{})
-	go broker(clk, lockRequests, unlockRequests, done)
-	return &InMemory{
+	go broker(clk, lockRequests, unlockRequests, listRequests, done)
+	tl := &InMemory{
 		lockRequests:   lockRequests,
 		unlockRequests: unlockRequests,
+		listRequests:   listRequests,
 		done:           done,
 	}
+	tl.queue = target.NewWaitQueue(tl, tl.available, clk, target.DefaultWaitInterval)
+	return tl
 }
@@ -14,6 +14,11 @@
 // and then IPv4 and IPv6.
 // All fields except ID are optional, but many plugins require FQDN or IP fields to
 // reach the targets over the network.
+//
+// Two more columns can hold the labels and the attributes of the targets, as
+// semicolon-separated key=value pairs:
+//
+// 123,hostname1.example.com,1.2.3.4,,board=tioga;pool=nightly,bmc_host=bmc1
 package csvtargetmanager
 
 import (
@@ -47,6 +52,10 @@ type AcquireParameters struct {
 	MaxNumberDevices uint32
 	HostPrefixes     []string
 	Shuffle          bool
+	// Wait makes Acquire wait, up to the TargetManagerAcquireTimeout of the
+	// job, for MinNumberDevices targets to be unlocked instead of failing
+	// right away, see target.WaitLock.
+	Wait bool
 }
 
 // ReleaseParameters contains the parameters necessary to release targets.
@@ -96,8 +105,31 @@ func (tf CSVFileTargetManager) ValidateReleaseParameters(params []byte) (interfa
 	return rp, nil
 }
 
+// parseKeyValues parses the semicolon-separated key=value pairs of a column.
+func parseKeyValues(column string) (map[string]string, error) {
+	column = strings.TrimSpace(column)
+	if column == "" {
+		return nil, nil
+	}
+	res := make(map[string]string)
+	for _, pair := range strings.Split(column, ";") {
+		kv := strings.SplitN(pair, "=", 2)
+		key := strings.TrimSpace(kv[0])
+		if key == "" {
+			return nil, fmt.Errorf("empty key in \"%s\"", pair)
+		}
+		if len(kv) == 1 {
+			res[key] = ""
+			continue
+		}
+		res[key] = strings.TrimSpace(kv[1])
+	}
+	return res, nil
+}
+
 // Acquire implements contest.TargetManager.Acquire, reading one entry per line
-// from a text file. Each input record looks like this: ID,FQDN,IPv4,IPv6. Only ID is required
+// from a text file. Each input record looks like this: ID,FQDN,IPv4,IPv6, with
+// optional labels and attributes columns. Only ID is required
 func (tf *CSVFileTargetManager) Acquire(ctx xcontext.Context, jobID types.JobID, jobTargetManagerAcquireTimeout time.Duration, parameters interface{}, tl target.Locker) ([]*target.Target, error) {
 	acquireParameters, ok := parameters.(AcquireParameters)
 	if !ok {
@@ -111,6 +143,8 @@ func (tf *CSVFileTargetManager) Acquire(ctx xcontext.Context, jobID types.JobID,
 
 	hosts := make([]*target.Target, 0)
 	r := csv.NewReader(fd)
+	// the labels and attributes columns are optional
+	r.FieldsPerRecord = -1
 	for {
 		record, err := r.Read()
 		if err == io.EOF {
@@ -123,8 +157,8 @@ func (tf *CSVFileTargetManager) Acquire(ctx xcontext.Context, jobID types.JobID,
 			// skip blank lines
 			continue
 		}
-		if len(record) != 4 {
-			return nil, errors.New("malformed input file, need 4 entries per record (ID, FQDN, IPv4, IPv6)")
+		if len(record) < 4 || len(record) > 6 {
+			return nil, errors.New("malformed input file, need 4 to 6 entries per record (ID, FQDN, IPv4, IPv6, labels, attributes)")
 		}
 		t := &target.Target{ID: strings.TrimSpace(record[0])}
 		if t.ID == "" {
@@ -149,6 +183,16 @@ func (tf *CSVFileTargetManager) Acquire(ctx xcontext.Context, jobID types.JobID,
 				return nil, fmt.Errorf("invalid non-empty IPv6 address \"%s\"", ipv6)
 			}
 		}
+		if len(record) > 4 {
+			if t.Labels, err = parseKeyValues(record[4]); err != nil {
+				return nil, fmt.Errorf("invalid labels for host %s: %w", t.ID, err)
+			}
+		}
+		if len(record) > 5 {
+			if t.Attributes, err = parseKeyValues(record[5]); err != nil {
+				return nil, fmt.Errorf("invalid attributes for host %s: %w", t.ID, err)
+			}
+		}
 		if len(acquireParameters.HostPrefixes) == 0 {
 			hosts = append(hosts, t)
 		} else if t.FQDN != "" {
@@ -162,6 +206,14 @@ func (tf *CSVFileTargetManager) Acquire(ctx xcontext.Context, jobID types.JobID,
 		}
 	}
 
+	// When only some targets are wanted, e.g. the ones which failed, there
+	// may be fewer of them than MinNumberDevices.
+	if selected, ok := target.SelectTargets(ctx, hosts); ok {
+		hosts = selected
+		if uint32(len(hosts)) < acquireParameters.MinNumberDevices {
+			acquireParameters.MinNumberDevices = uint32(len(hosts))
+		}
+	}
 	if uint32(len(hosts)) < acquireParameters.MinNumberDevices {
 		return nil, fmt.Errorf("not enough hosts found in CSV file '%s', want %d, got %d",
 			acquireParameters.FileURI.Path,
@@ -177,6 +229,19 @@ func (tf *CSVFileTargetManager) Acquire(ctx xcontext.Context, jobID types.JobID,
 		})
 	}
 
+	if acquireParameters.Wait {
+		lockedString, err := target.WaitLock(ctx, tl, jobID, jobTargetManagerAcquireTimeout, hosts, uint(acquireParameters.MinNumberDevices), uint(acquireParameters.MaxNumberDevices))
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock targets: %w", err)
+		}
+		locked, err := target.FilterTargets(lockedString, hosts)
+		if err != nil {
+			return nil, fmt.Errorf("can not find locked targets in hosts")
+		}
+		tf.hosts = locked
+		return locked, nil
+	}
+
 	// feed all devices into new API call `TryLock`, with desired limit
 	lockedString, err := tl.TryLock(ctx, jobID, jobTargetManagerAcquireTimeout, hosts, uint(acquireParameters.MaxNumberDevices))
 	if err != nil {
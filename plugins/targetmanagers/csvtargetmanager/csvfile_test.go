@@ -0,0 +1,92 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package csvtargetmanager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/plugins/targetlocker/inmemory"
+)
+
+var ctx = logrusctx.NewContext(logger.LevelDebug)
+
+func acquire(t *testing.T, content string) error {
+	path := filepath.Join(t.TempDir(), "hosts.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	ap, err := CSVFileTargetManager{}.ValidateAcquireParameters([]byte(fmt.Sprintf(`{"FileURI": "file://%s", "MinNumberDevices": 1, "MaxNumberDevices": 1}`, path)))
+	require.NoError(t, err)
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+	_, err = New().Acquire(ctx, 1, time.Minute, ap, tl)
+	return err
+}
+
+func TestAcquireLabels(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts.csv")
+	require.NoError(t, os.WriteFile(path, []byte(
+		"1,host1.example.com,1.2.3.4,,board=tioga; pool=nightly;broken,bmc_host=bmc1\n"+
+			"2,host2.example.com,,2001:db8::1\n"+
+			"3,host3.example.com,,,board=yosemite\n",
+	), 0644))
+	ap, err := CSVFileTargetManager{}.ValidateAcquireParameters([]byte(fmt.Sprintf(`{"FileURI": "file://%s", "MinNumberDevices": 3, "MaxNumberDevices": 3}`, path)))
+	require.NoError(t, err)
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+
+	targets, err := New().Acquire(ctx, 1, time.Minute, ap, tl)
+	require.NoError(t, err)
+	require.Len(t, targets, 3)
+	byID := make(map[string]*target.Target)
+	for _, tgt := range targets {
+		byID[tgt.ID] = tgt
+	}
+	require.Equal(t, map[string]string{"board": "tioga", "pool": "nightly", "broken": ""}, byID["1"].Labels)
+	require.Equal(t, map[string]string{"bmc_host": "bmc1"}, byID["1"].Attributes)
+	require.Nil(t, byID["2"].Labels)
+	require.Nil(t, byID["2"].Attributes)
+	require.Equal(t, map[string]string{"board": "yosemite"}, byID["3"].Labels)
+	require.Nil(t, byID["3"].Attributes)
+}
+
+func TestAcquireMalformed(t *testing.T) {
+	require.NoError(t, acquire(t, "1,host1,,\n"))
+	require.Error(t, acquire(t, "1,host1\n"))
+	require.Error(t, acquire(t, "1,host1,,,a=b,c=d,extra\n"))
+	require.Error(t, acquire(t, "1,host1,,,=tioga\n"))
+	require.Error(t, acquire(t, "1,host1,,,,=bmc1\n"))
+	require.Error(t, acquire(t, "1,host1,not-an-ip,\n"))
+}
+
+func TestAcquireSelectedTargets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts.csv")
+	require.NoError(t, os.WriteFile(path, []byte(
+		"1,host1.example.com,,\n2,host2.example.com,,\n3,host3.example.com,,\n",
+	), 0644))
+	ap, err := CSVFileTargetManager{}.ValidateAcquireParameters([]byte(fmt.Sprintf(`{"FileURI": "file://%s", "MinNumberDevices": 2, "MaxNumberDevices": 2, "Shuffle": true}`, path)))
+	require.NoError(t, err)
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+
+	// Only the selected target is locked, even if fewer than
+	// MinNumberDevices are selected.
+	targets, err := New().Acquire(target.WithTargetIDs(ctx, []string{"3", "4"}), 1, time.Minute, ap, tl)
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	require.Equal(t, "3", targets[0].ID)
+	locked, err := tl.TryLock(ctx, 2, time.Minute, []*target.Target{{ID: "1"}, {ID: "2"}, {ID: "3"}}, 3)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"1", "2"}, locked)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package inventorytargetmanager implements a target manager which acquires
+// the targets of the server's inventory matching a label selector, e.g.:
+//
+//	{
+//	    "Selector": "board=tioga,pool=nightly,!broken",
+//	    "MinNumberDevices": 2,
+//	    "MaxNumberDevices": 4
+//	}
+//
+// The targets are managed with the inventory API verbs (addtarget,
+// updatetarget, removetarget and listtargets).
+package inventorytargetmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
+)
+
+// Name defined the name of the plugin
+var (
+	Name = "InventoryTargetManager"
+)
+
+// AcquireParameters contains the parameters necessary to acquire targets.
+type AcquireParameters struct {
+	// Selector selects the targets by label, see inventory.ParseSelector.
+	// All the targets of the inventory are candidates if it is empty.
+	Selector         string
+	MinNumberDevices uint32
+	// MaxNumberDevices is the maximum number of targets acquired, zero
+	// means all the matching targets which are not locked.
+	MaxNumberDevices uint32
+	Shuffle          bool
+	// Wait makes Acquire wait, up to the TargetManagerAcquireTimeout of the
+	// job, for MinNumberDevices targets to be unlocked instead of failing
+	// right away, see target.WaitLock.
+	Wait bool
+
+	selector inventory.Selector
+}
+
+// ReleaseParameters contains the parameters necessary to release targets.
+type ReleaseParameters struct {
+}
+
+// InventoryTargetManager implements the contest.TargetManager interface,
+// acquiring targets of the inventory.
+type InventoryTargetManager struct {
+}
+
+// ValidateAcquireParameters performs sanity checks on the fields of the
+// parameters that will be passed to Acquire.
+func (tm InventoryTargetManager) ValidateAcquireParameters(params []byte) (interface{}, error) {
+	var ap AcquireParameters
+	if err := json.Unmarshal(params, &ap); err != nil {
+		return nil, err
+	}
+	selector, err := inventory.ParseSelector(ap.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+	ap.selector = selector
+	if ap.MaxNumberDevices != 0 && ap.MaxNumberDevices < ap.MinNumberDevices {
+		return nil, fmt.Errorf("MaxNumberDevices (%d) cannot be less than MinNumberDevices (%d)", ap.MaxNumberDevices, ap.MinNumberDevices)
+	}
+	return ap, nil
+}
+
+// ValidateReleaseParameters performs sanity checks on the fields of the
+// parameters that will be passed to Release.
+func (tm InventoryTargetManager) ValidateReleaseParameters(params []byte) (interface{}, error) {
+	var rp ReleaseParameters
+	if err := json.Unmarshal(params, &rp); err != nil {
+		return nil, err
+	}
+	return rp, nil
+}
+
+// Acquire implements contest.TargetManager.Acquire, locking up to
+// MaxNumberDevices targets of the inventory which match the selector. It
+// fails if fewer than MinNumberDevices of them could be locked, unless Wait
+// is set.
+func (tm *InventoryTargetManager) Acquire(ctx xcontext.Context, jobID types.JobID, jobTargetManagerAcquireTimeout time.Duration, parameters interface{}, tl target.Locker) ([]*target.Target, error) {
+	acquireParameters, ok := parameters.(AcquireParameters)
+	if !ok {
+		return nil, fmt.Errorf("Acquire expects %T object, got %T", acquireParameters, parameters)
+	}
+	store, ok := inventory.StoreFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no target inventory available")
+	}
+	entries, err := store.ListInventoryTargets(ctx, &inventory.Query{Selector: acquireParameters.selector})
+	if err != nil {
+		return nil, fmt.Errorf("could not list inventory targets: %w", err)
+	}
+	if uint32(len(entries)) < acquireParameters.MinNumberDevices {
+		return nil, fmt.Errorf("not enough targets matching %q in the inventory, want %d, got %d",
+			acquireParameters.selector,
+			acquireParameters.MinNumberDevices,
+			len(entries),
+		)
+	}
+	ctx.Debugf("Found %d targets matching %q", len(entries), acquireParameters.selector)
+	targets := make([]*target.Target, 0, len(entries))
+	for i := range entries {
+		targets = append(targets, entries[i].ToTarget())
+	}
+	// When only some targets are wanted, e.g. the ones which failed, there
+	// may be fewer of them than MinNumberDevices.
+	if selected, ok := target.SelectTargets(ctx, targets); ok {
+		targets = selected
+		if uint32(len(targets)) < acquireParameters.MinNumberDevices {
+			acquireParameters.MinNumberDevices = uint32(len(targets))
+		}
+	}
+	if acquireParameters.Shuffle {
+		ctx.Infof("Shuffling targets")
+		rand.Shuffle(len(targets), func(i, j int) {
+			targets[i], targets[j] = targets[j], targets[i]
+		})
+	}
+
+	limit := uint(acquireParameters.MaxNumberDevices)
+	if limit == 0 {
+		limit = uint(len(targets))
+	}
+	if acquireParameters.Wait {
+		lockedIDs, err := target.WaitLock(ctx, tl, jobID, jobTargetManagerAcquireTimeout, targets, uint(acquireParameters.MinNumberDevices), limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to lock targets: %w", err)
+		}
+		return target.FilterTargets(lockedIDs, targets)
+	}
+	lockedIDs, err := tl.TryLock(ctx, jobID, jobTargetManagerAcquireTimeout, targets, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock targets: %w", err)
+	}
+	locked, err := target.FilterTargets(lockedIDs, targets)
+	if err != nil {
+		return nil, fmt.Errorf("can not find locked targets in inventory targets")
+	}
+	if len(locked) < int(acquireParameters.MinNumberDevices) {
+		// not enough, unlock what we got and fail
+		if len(locked) > 0 {
+			if err := tl.Unlock(ctx, jobID, locked); err != nil {
+				return nil, fmt.Errorf("can't unlock targets")
+			}
+		}
+		return nil, fmt.Errorf("can't lock enough targets, want %d, got %d", acquireParameters.MinNumberDevices, len(locked))
+	}
+	return locked, nil
+}
+
+// Release releases the acquired resources.
+func (tm *InventoryTargetManager) Release(ctx xcontext.Context, jobID types.JobID, targets []*target.Target, params interface{}) error {
+	return nil
+}
+
+// New builds an InventoryTargetManager
+func New() target.TargetManager {
+	return &InventoryTargetManager{}
+}
+
+// Load returns the name and factory which are needed to register the
+// TargetManager.
+func Load() (string, target.TargetManagerFactory) {
+	return Name, New
+}
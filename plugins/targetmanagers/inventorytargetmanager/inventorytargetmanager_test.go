@@ -0,0 +1,138 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package inventorytargetmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/linuxboot/contest/pkg/inventory"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
+	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/plugins/storage/memory"
+	"github.com/linuxboot/contest/plugins/targetlocker/inmemory"
+)
+
+func newContext(t *testing.T) xcontext.Context {
+	store, err := memory.New()
+	require.NoError(t, err)
+	ctx := logrusctx.NewContext(logger.LevelDebug)
+	for _, tgt := range []inventory.Target{
+		{ID: "t1", Labels: map[string]string{"board": "tioga"}, Attributes: map[string]string{"bmc": "bmc1"}},
+		{ID: "t2", Labels: map[string]string{"board": "tioga"}},
+		{ID: "t3", Labels: map[string]string{"board": "yosemite"}},
+	} {
+		tgt := tgt
+		require.NoError(t, store.AddInventoryTarget(ctx, &tgt))
+	}
+	return inventory.WithStore(ctx, store)
+}
+
+func acquireParameters(t *testing.T, params string) AcquireParameters {
+	ap, err := InventoryTargetManager{}.ValidateAcquireParameters([]byte(params))
+	require.NoError(t, err)
+	return ap.(AcquireParameters)
+}
+
+func TestValidateAcquireParameters(t *testing.T) {
+	tm := InventoryTargetManager{}
+	_, err := tm.ValidateAcquireParameters([]byte(`{"Selector": "board=tioga,pool=nightly", "MinNumberDevices": 2, "MaxNumberDevices": 4}`))
+	require.NoError(t, err)
+	_, err = tm.ValidateAcquireParameters([]byte(`{"Selector": "board=tio ga"}`))
+	require.Error(t, err)
+	_, err = tm.ValidateAcquireParameters([]byte(`{"MinNumberDevices": 4, "MaxNumberDevices": 2}`))
+	require.Error(t, err)
+	_, err = tm.ValidateAcquireParameters([]byte(`{"MinNumberDevices": "2"}`))
+	require.Error(t, err)
+}
+
+func TestAcquireRelease(t *testing.T) {
+	ctx := newContext(t)
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+	tm := New()
+
+	targets, err := tm.Acquire(ctx, 1, time.Minute, acquireParameters(t, `{"Selector": "board=tioga", "MinNumberDevices": 2}`), tl)
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	require.ElementsMatch(t, []string{"t1", "t2"}, []string{targets[0].ID, targets[1].ID})
+	for _, tgt := range targets {
+		require.Equal(t, "tioga", tgt.Labels["board"])
+		if tgt.ID == "t1" {
+			require.Equal(t, "bmc1", tgt.Attributes["bmc"])
+		}
+	}
+
+	// The targets are locked by the first job.
+	_, err = tm.Acquire(ctx, 2, time.Minute, acquireParameters(t, `{"MinNumberDevices": 2}`), tl)
+	require.Error(t, err)
+	targets2, err := tm.Acquire(ctx, 2, time.Minute, acquireParameters(t, `{"MinNumberDevices": 1}`), tl)
+	require.NoError(t, err)
+	require.Len(t, targets2, 1)
+	require.Equal(t, "t3", targets2[0].ID)
+
+	require.NoError(t, tm.Release(ctx, 1, targets, ReleaseParameters{}))
+	require.NoError(t, tl.Unlock(ctx, 1, targets))
+	require.NoError(t, tl.Unlock(ctx, 2, targets2))
+}
+
+func TestAcquireMaxNumberDevices(t *testing.T) {
+	ctx := newContext(t)
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+
+	targets, err := New().Acquire(ctx, 1, time.Minute, acquireParameters(t, `{"MinNumberDevices": 1, "MaxNumberDevices": 2}`), tl)
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+}
+
+func TestAcquireNotEnoughTargets(t *testing.T) {
+	ctx := newContext(t)
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+	tm := New()
+
+	// Not enough targets match the selector.
+	_, err := tm.Acquire(ctx, 1, time.Minute, acquireParameters(t, `{"Selector": "board=tioga", "MinNumberDevices": 3}`), tl)
+	require.Error(t, err)
+
+	// Not enough of the matching targets are free, the ones which were
+	// locked are given back.
+	require.NoError(t, tl.Lock(ctx, 2, time.Minute, []*target.Target{{ID: "t1"}}))
+	_, err = tm.Acquire(ctx, 1, time.Minute, acquireParameters(t, `{"Selector": "board=tioga", "MinNumberDevices": 2}`), tl)
+	require.Error(t, err)
+	locked, err := tl.TryLock(ctx, 3, time.Minute, []*target.Target{{ID: "t2"}}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"t2"}, locked)
+}
+
+func TestAcquireNoInventory(t *testing.T) {
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+	_, err := New().Acquire(logrusctx.NewContext(logger.LevelDebug), 1, time.Minute, acquireParameters(t, `{}`), tl)
+	require.Error(t, err)
+}
+
+func TestAcquireSelectedTargets(t *testing.T) {
+	ctx := newContext(t)
+	tl := inmemory.New(clock.New())
+	defer tl.Close()
+
+	// Only the selected target is locked, even if fewer than
+	// MinNumberDevices are selected.
+	targets, err := New().Acquire(target.WithTargetIDs(ctx, []string{"t2"}), 1, time.Minute, acquireParameters(t, `{"Selector": "board=tioga", "MinNumberDevices": 2}`), tl)
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	require.Equal(t, "t2", targets[0].ID)
+	locked, err := tl.TryLock(ctx, 2, time.Minute, []*target.Target{{ID: "t1"}, {ID: "t2"}}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"t1"}, locked)
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/insomniacslk/xjson"
+	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/xcontext"
 	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
@@ -81,7 +82,7 @@ func (st *SSHTransport) NewProcess(ctx xcontext.Context, bin string, args []stri
 
 	client, err := ssh.Dial("tcp", addr, clientConfig)
 	if err != nil {
-		return nil, fmt.Errorf("cannot connect to SSH server %s: %v", addr, err)
+		return nil, &target.ErrInfrastructure{Err: fmt.Errorf("cannot connect to SSH server %s: %v", addr, err)}
 	}
 
 	// cleanup the ssh client after the operations have ended
@@ -277,7 +278,7 @@ func (st *SSHTransport) NewCopy(ctx xcontext.Context, src, dst string, recursive
 
 	client, err := ssh.Dial("tcp", addr, clientConfig)
 	if err != nil {
-		return nil, fmt.Errorf("cannot connect to SSH server %s: %v", addr, err)
+		return nil, &target.ErrInfrastructure{Err: fmt.Errorf("cannot connect to SSH server %s: %v", addr, err)}
 	}
 
 	SFTPClient, err := sftp.NewClient(client)
@@ -1,14 +1,17 @@
 package qemu
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
 
 	expect "github.com/google/goexpect"
+	"github.com/linuxboot/contest/pkg/artifact"
 	"github.com/linuxboot/contest/pkg/event/testevent"
 	"github.com/linuxboot/contest/pkg/events"
 	"github.com/linuxboot/contest/pkg/multiwriter"
@@ -37,7 +40,9 @@ func (r *TargetRunner) Run(ctx xcontext.Context, target *target.Target) error {
 
 	r.ts.writeTestStep(&outputBuf)
 
-	if err := r.ts.runQemu(ctx, &outputBuf); err != nil {
+	err := r.ts.runQemu(ctx, &outputBuf)
+	r.ts.uploadLogfile(ctx, target, &outputBuf)
+	if err != nil {
 		outputBuf.WriteString(fmt.Sprintf("%v\n", err))
 
 		return events.EmitError(ctx, outputBuf.String(), target, r.ev, err)
@@ -46,6 +51,17 @@ func (r *TargetRunner) Run(ctx xcontext.Context, target *target.Target) error {
 	return events.EmitLog(ctx, outputBuf.String(), target, r.ev)
 }
 
+// uploadLogfile stores the logfile as an artifact of the target, if the
+// server stores artifacts.
+func (ts *TestStep) uploadLogfile(ctx xcontext.Context, target *target.Target, outputBuf *strings.Builder) {
+	if ts.Logfile == "" {
+		return
+	}
+	if _, err := artifact.UploadFile(ctx, target, filepath.Base(ts.Logfile), ts.Logfile); err != nil && !errors.Is(err, artifact.ErrNoStore) {
+		outputBuf.WriteString(fmt.Sprintf("Could not upload Logfile: %v\n", err))
+	}
+}
+
 func (ts *TestStep) runQemu(ctx xcontext.Context, outputBuf *strings.Builder) error {
 	// no graphical output and no network access
 	command := []string{ts.Executable, "-nographic", "-nic", "none", "-bios", ts.Firmware}
@@ -56,16 +72,19 @@ func (ts *TestStep) runQemu(ctx xcontext.Context, outputBuf *strings.Builder) er
 		command = append(command, ts.Image)
 	}
 
-	var logfile *os.File
+	var (
+		logfile *os.File
+		err     error
+	)
 	if ts.Logfile != "" {
-		logfile, err := os.Create(ts.Logfile)
+		logfile, err = os.Create(ts.Logfile)
 		if err != nil {
 			return fmt.Errorf("Could not create Logfile: %w", err)
 		}
 
 		defer logfile.Close()
 	} else {
-		logfile, err := os.OpenFile("/dev/null", os.O_WRONLY, fs.ModeDevice)
+		logfile, err = os.OpenFile("/dev/null", os.O_WRONLY, fs.ModeDevice)
 		if err != nil {
 			return fmt.Errorf("Could not redirect output to '/dev/null': %w", err)
 		}
@@ -64,7 +64,15 @@ func (r *TargetRunner) Run(ctx xcontext.Context, target *target.Target) error {
 		return events.EmitError(ctx, outputBuf.String(), target, r.ev, err)
 	}
 
-	if err := r.ts.runPerformance(ctx, &outputBuf, transportProto); err != nil {
+	output, err := r.ts.runPerformance(ctx, &outputBuf, transportProto)
+	if output != nil {
+		if err := r.emitMetrics(ctx, output, target); err != nil {
+			outputBuf.WriteString(fmt.Sprintf("%v\n", err))
+
+			return events.EmitError(ctx, outputBuf.String(), target, r.ev, err)
+		}
+	}
+	if err != nil {
 		outputBuf.WriteString(fmt.Sprintf("%v\n", err))
 
 		return events.EmitError(ctx, outputBuf.String(), target, r.ev, err)
@@ -73,23 +81,57 @@ func (r *TargetRunner) Run(ctx xcontext.Context, target *target.Target) error {
 	return events.EmitLog(ctx, outputBuf.String(), target, r.ev)
 }
 
+// emitMetrics emits the measurements of sysbench as Metric events, so that
+// they can be evaluated by reporters. The metrics which sysbench did not
+// report are not emitted.
+func (r *TargetRunner) emitMetrics(ctx xcontext.Context, output *SysbenchOutput, target *target.Target) error {
+	metrics := []struct {
+		name  string
+		value float64
+		unit  string
+	}{
+		{"EventsPerSecond", output.CpuSpeed.EventsPerSecond, "events/s"},
+		{"TotalTime", output.GeneralStatistics.TotalTime, "s"},
+		{"TotalEvents", float64(output.GeneralStatistics.TotalEvents), ""},
+		{"LatencyMin", output.Latency.Min, "ms"},
+		{"LatencyAvg", output.Latency.Average, "ms"},
+		{"LatencyMax", output.Latency.Max, "ms"},
+		{"LatencyP95", output.Latency.Percentile95th, "ms"},
+		{"LatencySum", output.Latency.Sum, "ms"},
+		{"AverageEventsPerThread", output.ThreadsFairness.AverageEventsPerThread, ""},
+		{"AverageExecutionTimePerThread", output.ThreadsFairness.AverageExecutionTimePerThread, "s"},
+	}
+	for _, m := range metrics {
+		if !output.parsed[m.name] {
+			continue
+		}
+		if err := events.EmitMetric(ctx, m.name, m.value, m.unit, target, r.ev); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runPerformance runs sysbench and checks its output against the expectations.
+// The parsed output is returned even if the expectations are not met.
 func (ts *TestStep) runPerformance(ctx xcontext.Context, outputBuf *strings.Builder, transport transport.Transport,
-) error {
+) (*SysbenchOutput, error) {
 	proc, err := transport.NewProcess(ctx, sysbench, ts.Args, "")
 	if err != nil {
-		return fmt.Errorf("Failed to create proc: %w", err)
+		return nil, fmt.Errorf("Failed to create proc: %w", err)
 	}
 
 	writeCommand(proc.String(), outputBuf)
 
 	stdoutPipe, err := proc.StdoutPipe()
 	if err != nil {
-		return fmt.Errorf("Failed to pipe stdout: %v", err)
+		return nil, fmt.Errorf("Failed to pipe stdout: %v", err)
 	}
 
 	stderrPipe, err := proc.StderrPipe()
 	if err != nil {
-		return fmt.Errorf("Failed to pipe stderr: %v", err)
+		return nil, fmt.Errorf("Failed to pipe stderr: %v", err)
 	}
 
 	// try to start the process, if that succeeds then the outcome is the result of
@@ -109,14 +151,15 @@ func (ts *TestStep) runPerformance(ctx xcontext.Context, outputBuf *strings.Buil
 	}
 
 	if outcome != nil {
-		return fmt.Errorf("Failed to get CPU performance data: %v.", outcome)
+		return nil, fmt.Errorf("Failed to get CPU performance data: %v.", outcome)
 	}
 
-	if err := ts.parseOutput(outputBuf, stdout); err != nil {
-		return fmt.Errorf("failed to parse sysbench output: %v", err)
+	output, err := ts.parseOutput(outputBuf, stdout)
+	if err != nil {
+		return output, fmt.Errorf("failed to parse sysbench output: %v", err)
 	}
 
-	return nil
+	return output, nil
 }
 
 // getOutputFromReader reads data from the provided io.Reader instances
@@ -169,10 +212,14 @@ type SysbenchOutput struct {
 		AverageEventsPerThread        float64 `json:"average_events_per_thread"`
 		AverageExecutionTimePerThread float64 `json:"average_execution_time_per_thread"`
 	} `json:"threads_fairness"`
+
+	// parsed holds the names of the metrics found in the output, e.g. memory
+	// and fileio tests do not report EventsPerSecond.
+	parsed map[string]bool
 }
 
-func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
-	output := SysbenchOutput{}
+func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) (*SysbenchOutput, error) {
+	output := SysbenchOutput{parsed: make(map[string]bool)}
 
 	// Parse CPU Speed
 	reCPUSpeed := regexp.MustCompile(`events per second:\s+([\d.]+)`)
@@ -180,10 +227,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(cpuSpeedMatches) > 1 {
 		cpuSpeed, err := strconv.ParseFloat(string(cpuSpeedMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.CpuSpeed.EventsPerSecond = cpuSpeed
+		output.parsed["EventsPerSecond"] = true
 	}
 
 	// Parse General Statistics
@@ -192,10 +240,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(totalTimeMatches) > 1 {
 		totalTime, err := strconv.ParseFloat(string(totalTimeMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.GeneralStatistics.TotalTime = totalTime
+		output.parsed["TotalTime"] = true
 	}
 
 	reTotalEvents := regexp.MustCompile(`total number of events:\s+(\d+)`)
@@ -203,10 +252,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(totalEventsMatches) > 1 {
 		totalEvents, err := strconv.Atoi(string(totalEventsMatches[1]))
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.GeneralStatistics.TotalEvents = totalEvents
+		output.parsed["TotalEvents"] = true
 	}
 
 	// Parse Latency
@@ -215,10 +265,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(latencyMinMatches) > 1 {
 		latencyMin, err := strconv.ParseFloat(string(latencyMinMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.Latency.Min = latencyMin
+		output.parsed["LatencyMin"] = true
 	}
 
 	reLatencyAvg := regexp.MustCompile(`avg:\s+([\d.]+)`)
@@ -226,10 +277,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(latencyAvgMatches) > 1 {
 		latencyAvg, err := strconv.ParseFloat(string(latencyAvgMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.Latency.Average = latencyAvg
+		output.parsed["LatencyAvg"] = true
 	}
 
 	reLatencyMax := regexp.MustCompile(`max:\s+([\d.]+)`)
@@ -237,10 +289,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(latencyMaxMatches) > 1 {
 		latencyMax, err := strconv.ParseFloat(string(latencyMaxMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.Latency.Max = latencyMax
+		output.parsed["LatencyMax"] = true
 	}
 
 	reLatencyP95 := regexp.MustCompile(`95th percentile:\s+([\d.]+)`)
@@ -248,10 +301,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(latencyP95Matches) > 1 {
 		latencyP95, err := strconv.ParseFloat(string(latencyP95Matches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.Latency.Percentile95th = latencyP95
+		output.parsed["LatencyP95"] = true
 	}
 
 	reLatencySum := regexp.MustCompile(`sum:\s+([\d.]+)`)
@@ -259,10 +313,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(latencySumMatches) > 1 {
 		latencySum, err := strconv.ParseFloat(string(latencySumMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.Latency.Sum = latencySum
+		output.parsed["LatencySum"] = true
 	}
 
 	// Parse Threads Fairness
@@ -271,10 +326,11 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(avgEventsPerThreadMatches) > 2 {
 		avgEventsPerThread, err := strconv.ParseFloat(string(avgEventsPerThreadMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.ThreadsFairness.AverageEventsPerThread = avgEventsPerThread
+		output.parsed["AverageEventsPerThread"] = true
 	}
 
 	reAvgExecutionTimePerThread := regexp.MustCompile(`execution time \(avg/stddev\):\s+([\d.]+)/([\d.]+)`)
@@ -282,17 +338,18 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 	if len(avgExecutionTimePerThreadMatches) > 2 {
 		avgExecutionTimePerThread, err := strconv.ParseFloat(string(avgExecutionTimePerThreadMatches[1]), 64)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		output.ThreadsFairness.AverageExecutionTimePerThread = avgExecutionTimePerThread
+		output.parsed["AverageExecutionTimePerThread"] = true
 	}
 
 	for _, option := range ts.Expect {
 		switch option.Option {
 		case "EventsPerSecond":
 			if err := parseValue(int(output.CpuSpeed.EventsPerSecond), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Events per second: '%d'.",
@@ -300,7 +357,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "TotalTime":
 			if err := parseValue(int(output.GeneralStatistics.TotalTime), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Total time: '%d'.",
@@ -308,7 +365,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "TotalEvents":
 			if err := parseValue(int(output.GeneralStatistics.TotalEvents), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Total events: '%d'.",
@@ -316,7 +373,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "LatencyMin":
 			if err := parseValue(int(output.Latency.Min), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Minimum Latency: '%d'.",
@@ -324,7 +381,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "LatencyAvg":
 			if err := parseValue(int(output.Latency.Average), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Average Latency: '%d'.",
@@ -332,7 +389,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "LatencyMax":
 			if err := parseValue(int(output.Latency.Max), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Maximum Latency: '%d'.",
@@ -340,7 +397,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "LatencyP95":
 			if err := parseValue(int(output.Latency.Percentile95th), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. P95 Latency: '%d'.",
@@ -348,7 +405,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "LatencySum":
 			if err := parseValue(int(output.Latency.Sum), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Latency sum: '%d'.",
@@ -356,7 +413,7 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "AverageEventsPerThread":
 			if err := parseValue(int(output.ThreadsFairness.AverageEventsPerThread), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Average Events per thread: '%d'.",
@@ -364,18 +421,18 @@ func (ts *TestStep) parseOutput(outputBuf *strings.Builder, data []byte) error {
 
 		case "AverageExecutionTimePerThread":
 			if err := parseValue(int(output.ThreadsFairness.AverageExecutionTimePerThread), option.Value); err != nil {
-				return err
+				return &output, err
 			}
 
 			outputBuf.WriteString(fmt.Sprintf("Result for option '%s' is as expected. Average execution time per thread: '%d'.",
 				option.Option, int(output.ThreadsFairness.AverageExecutionTimePerThread)))
 
 		default:
-			return fmt.Errorf("option '%s' is not supported", option.Option)
+			return &output, fmt.Errorf("option '%s' is not supported", option.Option)
 		}
 	}
 
-	return nil
+	return &output, nil
 }
 
 const (
@@ -154,3 +154,37 @@ func (suite *FrameworkEventsSuite) TestRetrieveSingleFrameworkEventByEmitTime()
 	require.NoError(suite.T(), err)
 	assert.Equal(suite.T(), 4, len(results))
 }
+
+func (suite *FrameworkEventsSuite) TestRetrieveFrameworkEventsByEmitTimeRange() {
+
+	delta := 10 * time.Second
+	emitTime := time.Now().Truncate(2 * time.Second)
+	err := populateFrameworkEvents(suite.txStorage, emitTime)
+	require.NoError(suite.T(), err)
+	err = populateFrameworkEvents(suite.txStorage, emitTime.Add(delta))
+	require.NoError(suite.T(), err)
+
+	eventQuery := mustBuildQuery(suite.T(),
+		frameworkevent.QueryEmittedStartTime(emitTime.Add(-delta)),
+		frameworkevent.QueryEmittedEndTime(emitTime),
+	)
+	results, err := suite.txStorage.GetFrameworkEvent(ctx, eventQuery)
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(results))
+	assertFrameworkEvents(suite.T(), results, emitTime)
+}
+
+func (suite *FrameworkEventsSuite) TestRetrieveFrameworkEventsWithLimit() {
+
+	emitTime := time.Now().Truncate(2 * time.Second)
+	err := populateFrameworkEvents(suite.txStorage, emitTime)
+	require.NoError(suite.T(), err)
+
+	eventQuery := mustBuildQuery(suite.T(), frameworkevent.QueryJobID(1), frameworkevent.QueryLimit(1))
+	results, err := suite.txStorage.GetFrameworkEvent(ctx, eventQuery)
+
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(results))
+	assertFrameworkEvents(suite.T(), results, emitTime)
+}
@@ -212,6 +212,80 @@ func (suite *TestEventsSuite) TestRetrieveSingleTestEventsByNameAndJobID() {
 	assertTestEvents(suite.T(), results, emitTime)
 }
 
+func (suite *TestEventsSuite) TestRetrieveTestEventsAfterSequenceID() {
+
+	emitTime := time.Now().Truncate(2 * time.Second)
+	err := populateTestEvents(suite.txStorage, emitTime)
+	require.NoError(suite.T(), err)
+
+	results, err := suite.txStorage.GetTestEvents(ctx, mustBuildQuery(suite.T(), testevent.QueryTestStepLabel("TestStepLabel")))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 2, len(results))
+
+	testEventQuery := mustBuildQuery(suite.T(),
+		testevent.QueryTestStepLabel("TestStepLabel"),
+		testevent.QueryAfterSequenceID(results[0].SequenceID),
+	)
+	results, err = suite.txStorage.GetTestEvents(ctx, testEventQuery)
+
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(results))
+	assert.Equal(suite.T(), "BTestName", results[0].Header.TestName)
+}
+
+func (suite *TestEventsSuite) TestRetrieveTestEventsByTargetID() {
+
+	emitTime := time.Now().Truncate(2 * time.Second)
+	err := populateTestEvents(suite.txStorage, emitTime)
+	require.NoError(suite.T(), err)
+
+	results, err := suite.txStorage.GetTestEvents(ctx, mustBuildQuery(suite.T(), testevent.QueryTargetID("ATargetID")))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(results))
+	assertTestEvents(suite.T(), results, emitTime)
+}
+
+func (suite *TestEventsSuite) TestRetrieveTestEventsByPayload() {
+
+	emitTime := time.Now().Truncate(2 * time.Second)
+	err := populateTestEvents(suite.txStorage, emitTime)
+	require.NoError(suite.T(), err)
+
+	results, err := suite.txStorage.GetTestEvents(ctx, mustBuildQuery(suite.T(), testevent.QueryPayloadContains("'test_value'")))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 2, len(results))
+	assertTestEvents(suite.T(), results, emitTime)
+
+	// The substring is matched literally, not as a pattern.
+	results, err = suite.txStorage.GetTestEvents(ctx, mustBuildQuery(suite.T(), testevent.QueryPayloadContains("test%value")))
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), results)
+}
+
+func (suite *TestEventsSuite) TestRetrieveTestEventsWithLimit() {
+
+	emitTime := time.Now().Truncate(2 * time.Second)
+	err := populateTestEvents(suite.txStorage, emitTime)
+	require.NoError(suite.T(), err)
+
+	results, err := suite.txStorage.GetTestEvents(ctx, mustBuildQuery(suite.T(),
+		testevent.QueryTestStepLabel("TestStepLabel"),
+		testevent.QueryLimit(1),
+	))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(results))
+	assertTestEvents(suite.T(), results, emitTime)
+
+	results, err = suite.txStorage.GetTestEvents(ctx, mustBuildQuery(suite.T(),
+		testevent.QueryTestStepLabel("TestStepLabel"),
+		testevent.QueryAfterSequenceID(results[0].SequenceID),
+		testevent.QueryLimit(1),
+	))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(results))
+	assert.Equal(suite.T(), "BTestName", results[0].Header.TestName)
+}
+
 func (suite TestEventsSuite) GetStorageEngineVault() storage.EngineVault {
 	return suite.storageEngineVault
 }
@@ -8,6 +8,8 @@
 package test
 
 import (
+	"errors"
+	"net"
 	"time"
 
 	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
@@ -16,6 +18,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/storage"
 	"github.com/linuxboot/contest/pkg/types"
@@ -126,6 +130,8 @@ func (suite *JobSuite) TestGetJobRequest() {
 	require.True(t, request.RequestTime.After(time.Now().Add(-2*time.Second)))
 	require.True(t, request.RequestTime.Before(time.Now().Add(2*time.Second)))
 
+	_, err = suite.txStorage.GetJobRequest(ctx, jobIDb+1)
+	require.ErrorIs(t, err, storage.ErrJobNotFound)
 }
 
 func mustBuildQuery(t require.TestingT, queryFields ...storage.JobQueryField) *storage.JobQuery {
@@ -155,12 +161,12 @@ func (suite *JobSuite) TestListJobs() {
 	jobIDb, err := suite.txStorage.StoreJobRequest(ctx, &jobRequestSecond)
 	require.NoError(t, err)
 
-	var res []types.JobID
+	var res []job.Summary
 
 	// No match criteria - returns all jobs.
 	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t))
 	require.NoError(t, err)
-	require.Equal(t, []types.JobID{jobIDa, jobIDb}, res)
+	require.Equal(t, []types.JobID{jobIDa, jobIDb}, summaryIDs(res))
 
 	// List by states - matching any state is enough.
 	// Both jobs are currently in Unknown state.
@@ -174,19 +180,19 @@ func (suite *JobSuite) TestListJobs() {
 		storage.QueryJobStates(job.JobStateCompleted, job.JobStateFailed, job.JobStateUnknown),
 	))
 	require.NoError(t, err)
-	require.Equal(t, []types.JobID{jobIDa, jobIDb}, res)
+	require.Equal(t, []types.JobID{jobIDa, jobIDb}, summaryIDs(res))
 
 	// Tag matching - single tag.
 	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
 		storage.QueryJobTags("tests")))
 	require.NoError(t, err)
-	require.Equal(t, []types.JobID{jobIDa}, res)
+	require.Equal(t, []types.JobID{jobIDa}, summaryIDs(res))
 
 	// Tag matching - must match all tags.
 	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
 		storage.QueryJobTags("integ", "tests")))
 	require.NoError(t, err)
-	require.Equal(t, []types.JobID{jobIDa}, res)
+	require.Equal(t, []types.JobID{jobIDa}, summaryIDs(res))
 	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
 		storage.QueryJobTags("integ", "tests", "no_such_tag")))
 	require.NoError(t, err)
@@ -197,7 +203,7 @@ func (suite *JobSuite) TestListJobs() {
 	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
 		storage.QueryJobStates(job.JobStateUnknown)))
 	require.NoError(t, err)
-	require.Equal(t, []types.JobID{jobIDa, jobIDb}, res)
+	require.Equal(t, []types.JobID{jobIDa, jobIDb}, summaryIDs(res))
 
 	// Inject state events
 	require.NoError(t, suite.txStorage.StoreFrameworkEvent(ctx, frameworkevent.Event{
@@ -217,7 +223,7 @@ func (suite *JobSuite) TestListJobs() {
 	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
 		storage.QueryJobStates(job.JobStateCompleted, job.JobStateFailed, job.JobStatePaused)))
 	require.NoError(t, err)
-	require.Equal(t, []types.JobID{jobIDa, jobIDb}, res)
+	require.Equal(t, []types.JobID{jobIDa, jobIDb}, summaryIDs(res))
 
 	// State and tag match - must match both
 	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
@@ -225,5 +231,355 @@ func (suite *JobSuite) TestListJobs() {
 		storage.QueryJobTags("tests", "foo"),
 	))
 	require.NoError(t, err)
-	require.Equal(t, []types.JobID{jobIDa}, res)
+	require.Equal(t, []types.JobID{jobIDa}, summaryIDs(res))
+}
+
+func summaryIDs(jobs []job.Summary) []types.JobID {
+	jobIDs := []types.JobID{}
+	for _, summary := range jobs {
+		jobIDs = append(jobIDs, summary.JobID)
+	}
+	return jobIDs
+}
+
+func (suite *JobSuite) TestListJobsSummaries() {
+	t := suite.T()
+
+	requestTime := time.Now().Truncate(time.Second)
+	var jobIDs []types.JobID
+	for i, request := range []job.Request{
+		{JobName: "nightly-a", Requestor: "alice", JobDescriptor: jobDescriptorFirst},
+		{JobName: "nightly-b", Requestor: "bob", JobDescriptor: jobDescriptorSecond},
+		{JobName: "weekly_100%", Requestor: "alice", JobDescriptor: jobDescriptorSecond},
+	} {
+		request := request
+		request.ServerID = "server1"
+		request.RequestTime = requestTime.Add(time.Duration(i) * time.Hour)
+		jobID, err := suite.txStorage.StoreJobRequest(ctx, &request)
+		require.NoError(t, err)
+		jobIDs = append(jobIDs, jobID)
+	}
+	for _, ev := range []frameworkevent.Event{
+		{JobID: jobIDs[0], EventName: job.EventJobStarted, EmitTime: time.Unix(10, 0)},
+		{JobID: jobIDs[0], EventName: job.EventJobCompleted, EmitTime: time.Unix(20, 0)},
+		{JobID: jobIDs[1], EventName: job.EventJobStarted, EmitTime: time.Unix(30, 0)},
+		{JobID: jobIDs[1], EventName: job.EventJobFailed, EmitTime: time.Unix(40, 0)},
+		{JobID: jobIDs[2], EventName: job.EventJobStarted, EmitTime: time.Unix(50, 0)},
+	} {
+		require.NoError(t, suite.txStorage.StoreFrameworkEvent(ctx, ev))
+	}
+
+	// Summaries carry the request and the state of the jobs.
+	res, err := suite.txStorage.ListJobs(ctx, mustBuildQuery(t))
+	require.NoError(t, err)
+	require.Equal(t, jobIDs, summaryIDs(res))
+	require.Equal(t, "nightly-a", res[0].Name)
+	require.Equal(t, "alice", res[0].Requestor)
+	require.Equal(t, "server1", res[0].ServerID)
+	require.Equal(t, []string{"foo", "integ", "tests"}, res[0].Tags)
+	require.Equal(t, job.JobStateCompleted, res[0].State)
+	require.True(t, requestTime.Equal(res[0].RequestTime))
+	require.Equal(t, int64(10), res[0].StartTime.Unix())
+	require.NotNil(t, res[0].EndTime)
+	require.Equal(t, int64(20), res[0].EndTime.Unix())
+	require.Equal(t, job.JobStateFailed, res[1].State)
+	require.Equal(t, job.JobStateStarted, res[2].State)
+	require.Equal(t, int64(50), res[2].StartTime.Unix())
+	require.Nil(t, res[2].EndTime)
+
+	// Requestor.
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobRequestor("alice")))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[0], jobIDs[2]}, summaryIDs(res))
+
+	// Name pattern, other characters match literally.
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobNamePattern("nightly-*")))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[0], jobIDs[1]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobNamePattern("nightly-?")))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[0], jobIDs[1]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobNamePattern("weekly_100%")))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[2]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobNamePattern("weekly%")))
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// Request time range, bounds are inclusive.
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
+		storage.QueryJobRequestedAfter(requestTime.Add(time.Hour)),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[1], jobIDs[2]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
+		storage.QueryJobRequestedAfter(requestTime.Add(time.Minute)),
+		storage.QueryJobRequestedBefore(requestTime.Add(time.Hour)),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[1]}, summaryIDs(res))
+
+	// End time range, jobs which have not ended never match.
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobEndedAfter(time.Unix(25, 0))))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[1]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobEndedBefore(time.Unix(40, 0))))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[0], jobIDs[1]}, summaryIDs(res))
+
+	// Sorting and pagination.
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobsNewestFirst()))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[2], jobIDs[1], jobIDs[0]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t, storage.QueryJobLimit(2)))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[0], jobIDs[1]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
+		storage.QueryJobLimit(2),
+		storage.QueryJobAfterJobID(jobIDs[1]),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[2]}, summaryIDs(res))
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
+		storage.QueryJobsNewestFirst(),
+		storage.QueryJobLimit(1),
+		storage.QueryJobAfterJobID(jobIDs[2]),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[1]}, summaryIDs(res))
+	// Jobs filtered out by state do not count towards the limit.
+	res, err = suite.txStorage.ListJobs(ctx, mustBuildQuery(t,
+		storage.QueryJobLimit(1),
+		storage.QueryJobStates(job.JobStateStarted),
+	))
+	require.NoError(t, err)
+	require.Equal(t, []types.JobID{jobIDs[2]}, summaryIDs(res))
+}
+
+func (suite *JobSuite) TestSchedules() {
+	t := suite.T()
+
+	scheduleA := job.Schedule{
+		CronExpression: "0 2 * * *",
+		JobDescriptor:  jobDescriptorFirst,
+		Requestor:      "AIntegrationTest",
+		ServerID:       "server1",
+		CreateTime:     time.Now().Truncate(time.Second),
+		SkipIfRunning:  true,
+	}
+	scheduleIDa, err := suite.txStorage.StoreSchedule(ctx, &scheduleA)
+	require.NoError(t, err)
+	scheduleB := job.Schedule{
+		CronExpression: "@hourly",
+		JobDescriptor:  jobDescriptorSecond,
+		Requestor:      "BIntegrationTest",
+		ServerID:       "server2",
+		CreateTime:     time.Now().Truncate(time.Second),
+	}
+	scheduleIDb, err := suite.txStorage.StoreSchedule(ctx, &scheduleB)
+	require.NoError(t, err)
+
+	schedule, err := suite.txStorage.GetSchedule(ctx, scheduleIDa)
+	require.NoError(t, err)
+	require.Equal(t, scheduleIDa, schedule.ID)
+	require.Equal(t, "0 2 * * *", schedule.CronExpression)
+	require.Equal(t, jobDescriptorFirst, schedule.JobDescriptor)
+	require.Equal(t, "AIntegrationTest", schedule.Requestor)
+	require.True(t, schedule.SkipIfRunning)
+	require.True(t, schedule.CreateTime.Equal(scheduleA.CreateTime))
+	require.Zero(t, schedule.LastJobID)
+	require.True(t, schedule.LastRunTime.IsZero())
+
+	// Listing by server.
+	schedules, err := suite.txStorage.ListSchedules(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(schedules))
+	schedules, err = suite.txStorage.ListSchedules(ctx, "server2")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(schedules))
+	require.Equal(t, scheduleIDb, schedules[0].ID)
+
+	// Last run tracking.
+	runTime := time.Now().Truncate(time.Second)
+	require.NoError(t, suite.txStorage.UpdateScheduleLastRun(ctx, scheduleIDa, types.JobID(42), runTime))
+	schedule, err = suite.txStorage.GetSchedule(ctx, scheduleIDa)
+	require.NoError(t, err)
+	require.Equal(t, types.JobID(42), schedule.LastJobID)
+	require.True(t, schedule.LastRunTime.Equal(runTime))
+
+	// Deletion.
+	require.NoError(t, suite.txStorage.DeleteSchedule(ctx, scheduleIDa))
+	_, err = suite.txStorage.GetSchedule(ctx, scheduleIDa)
+	require.Error(t, err)
+	require.Error(t, suite.txStorage.DeleteSchedule(ctx, scheduleIDa))
+	schedules, err = suite.txStorage.ListSchedules(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(schedules))
+	require.Equal(t, scheduleIDb, schedules[0].ID)
+}
+
+func (suite *JobSuite) TestJobLogs() {
+	t := suite.T()
+
+	logTime := time.Now().Truncate(time.Second)
+	entries := []job.LogEntry{
+		{JobID: 1, Time: logTime, Level: "info", Message: "first"},
+		{JobID: 2, Time: logTime, Level: "info", Message: "other job"},
+		{JobID: 1, Time: logTime, Level: "error", Message: "second"},
+		{JobID: 1, Time: logTime, Level: "debug", Message: "third"},
+	}
+	require.NoError(t, suite.txStorage.StoreJobLogs(ctx, entries))
+
+	res, err := suite.txStorage.GetJobLogs(ctx, &storage.LogQuery{JobID: 1})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res))
+	var messages []string
+	for i, entry := range res {
+		if i > 0 {
+			require.Greater(t, entry.SequenceID, res[i-1].SequenceID)
+		}
+		require.Equal(t, types.JobID(1), entry.JobID)
+		require.True(t, entry.Time.Equal(logTime))
+		messages = append(messages, entry.Level+" "+entry.Message)
+	}
+	require.Equal(t, []string{"info first", "error second", "debug third"}, messages)
+
+	// Following the log from a sequence ID, with a limit.
+	next, err := suite.txStorage.GetJobLogs(ctx, &storage.LogQuery{JobID: 1, AfterSequenceID: res[0].SequenceID, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(next))
+	require.Equal(t, "second", next[0].Message)
+	next, err = suite.txStorage.GetJobLogs(ctx, &storage.LogQuery{JobID: 1, AfterSequenceID: res[2].SequenceID})
+	require.NoError(t, err)
+	require.Empty(t, next)
+}
+
+func (suite *JobSuite) TestInventoryTargets() {
+	t := suite.T()
+
+	createTime := time.Now().Truncate(time.Second)
+	t1 := inventory.Target{
+		ID:          "t1",
+		FQDN:        "t1.example.com",
+		PrimaryIPv4: net.ParseIP("10.0.0.1").To4(),
+		Labels:      map[string]string{"board": "tioga", "pool": "nightly"},
+		Attributes:  map[string]string{"bmc_host": "t1-bmc.example.com"},
+		CreateTime:  createTime,
+		UpdateTime:  createTime,
+	}
+	t2 := inventory.Target{
+		ID:          "t2",
+		PrimaryIPv6: net.ParseIP("2001:db8::2"),
+		Labels:      map[string]string{"board": "delta"},
+		CreateTime:  createTime,
+		UpdateTime:  createTime,
+	}
+	require.NoError(t, suite.txStorage.AddInventoryTarget(ctx, &t2))
+	require.NoError(t, suite.txStorage.AddInventoryTarget(ctx, &t1))
+	require.True(t, errors.Is(suite.txStorage.AddInventoryTarget(ctx, &t1), inventory.ErrAlreadyExists))
+
+	res, err := suite.txStorage.ListInventoryTargets(ctx, &inventory.Query{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	require.Equal(t, "t1", res[0].ID)
+	require.Equal(t, t1.Labels, res[0].Labels)
+	require.Equal(t, t1.Attributes, res[0].Attributes)
+	require.True(t, t1.PrimaryIPv4.Equal(res[0].PrimaryIPv4))
+	require.True(t, t2.PrimaryIPv6.Equal(res[1].PrimaryIPv6))
+	require.True(t, res[0].CreateTime.Equal(createTime))
+
+	selector, err := inventory.ParseSelector("board=tioga")
+	require.NoError(t, err)
+	res, err = suite.txStorage.ListInventoryTargets(ctx, &inventory.Query{Selector: selector})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	require.Equal(t, "t1", res[0].ID)
+
+	// Updates replace everything but the creation time.
+	updated := t2
+	updated.Labels = map[string]string{"board": "tioga"}
+	updated.CreateTime = createTime.Add(time.Hour)
+	updated.UpdateTime = createTime.Add(time.Hour)
+	require.NoError(t, suite.txStorage.UpdateInventoryTarget(ctx, &updated))
+	res, err = suite.txStorage.ListInventoryTargets(ctx, &inventory.Query{IDs: []string{"t2"}, Selector: selector})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	require.True(t, res[0].CreateTime.Equal(createTime))
+	require.True(t, res[0].UpdateTime.Equal(updated.UpdateTime))
+
+	require.NoError(t, suite.txStorage.DeleteInventoryTarget(ctx, "t1"))
+	require.True(t, errors.Is(suite.txStorage.DeleteInventoryTarget(ctx, "t1"), inventory.ErrNotFound))
+	missing := inventory.Target{ID: "t3"}
+	require.True(t, errors.Is(suite.txStorage.UpdateInventoryTarget(ctx, &missing), inventory.ErrNotFound))
+	res, err = suite.txStorage.ListInventoryTargets(ctx, &inventory.Query{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	require.Equal(t, "t2", res[0].ID)
+}
+
+func (suite *JobSuite) TestTargetHealth() {
+	t := suite.T()
+
+	now := time.Now().Truncate(time.Second)
+	for i, infra := range []bool{false, true, true} {
+		outcome := health.Outcome{
+			TargetID:       "t1",
+			JobID:          types.JobID(i + 1),
+			RunID:          1,
+			TestName:       "test",
+			TestStepLabel:  "step",
+			Time:           now.Add(time.Duration(i) * time.Second),
+			Infrastructure: infra,
+		}
+		if infra {
+			outcome.Error = "unreachable"
+		}
+		require.NoError(t, suite.txStorage.StoreTargetOutcome(ctx, &outcome))
+	}
+	outcomes, err := suite.txStorage.GetTargetOutcomes(ctx, "t1", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(outcomes))
+	require.Equal(t, types.JobID(3), outcomes[0].JobID)
+	require.Equal(t, "unreachable", outcomes[0].Error)
+	require.True(t, outcomes[0].Infrastructure)
+	require.True(t, outcomes[0].Time.Equal(now.Add(2*time.Second)))
+	outcomes, err = suite.txStorage.GetTargetOutcomes(ctx, "t1", 0)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(outcomes))
+	require.Equal(t, "", outcomes[2].Error)
+
+	_, err = suite.txStorage.GetTargetQuarantine(ctx, "t1")
+	require.True(t, errors.Is(err, health.ErrNotFound))
+	require.True(t, errors.Is(suite.txStorage.ReleaseTarget(ctx, "t1", &health.Release{Time: now}), health.ErrNotQuarantined))
+
+	q := health.Quarantine{TargetID: "t1", Reason: "bricked", Time: now}
+	require.NoError(t, suite.txStorage.QuarantineTarget(ctx, &q))
+	require.True(t, errors.Is(suite.txStorage.QuarantineTarget(ctx, &q), health.ErrQuarantined))
+	require.NoError(t, suite.txStorage.QuarantineTarget(ctx, &health.Quarantine{TargetID: "t0", Reason: "flaky", Requestor: "admin", Time: now}))
+	quarantines, err := suite.txStorage.ListQuarantinedTargets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(quarantines))
+	require.Equal(t, "t0", quarantines[0].TargetID)
+	require.Equal(t, "admin", quarantines[0].Requestor)
+	require.Equal(t, "bricked", quarantines[1].Reason)
+	require.True(t, quarantines[1].Time.Equal(now))
+
+	release := health.Release{Reason: "reflashed", Requestor: "admin", Time: now.Add(time.Hour)}
+	require.NoError(t, suite.txStorage.ReleaseTarget(ctx, "t1", &release))
+	require.True(t, errors.Is(suite.txStorage.ReleaseTarget(ctx, "t1", &release), health.ErrNotQuarantined))
+	got, err := suite.txStorage.GetTargetQuarantine(ctx, "t1")
+	require.NoError(t, err)
+	require.False(t, got.Active())
+	require.Equal(t, "reflashed", got.Release.Reason)
+	require.True(t, got.Release.Time.Equal(release.Time))
+	quarantines, err = suite.txStorage.ListQuarantinedTargets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(quarantines))
+	require.Equal(t, "t0", quarantines[0].TargetID)
+
+	// Released targets can be quarantined again.
+	require.NoError(t, suite.txStorage.QuarantineTarget(ctx, &q))
+	got, err = suite.txStorage.GetTargetQuarantine(ctx, "t1")
+	require.NoError(t, err)
+	require.True(t, got.Active())
 }
@@ -9,9 +9,11 @@
 package test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -21,9 +23,13 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/linuxboot/contest/pkg/api"
+	"github.com/linuxboot/contest/pkg/artifact"
 	"github.com/linuxboot/contest/pkg/event"
 	"github.com/linuxboot/contest/pkg/event/frameworkevent"
+	"github.com/linuxboot/contest/pkg/event/stream"
 	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/health"
+	"github.com/linuxboot/contest/pkg/inventory"
 	"github.com/linuxboot/contest/pkg/job"
 	"github.com/linuxboot/contest/pkg/jobmanager"
 	"github.com/linuxboot/contest/pkg/logging"
@@ -34,8 +40,10 @@ import (
 	"github.com/linuxboot/contest/pkg/xcontext"
 	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
 	"github.com/linuxboot/contest/pkg/xcontext/logger"
+	"github.com/linuxboot/contest/pkg/xcontext/metrics/perf"
 	"github.com/linuxboot/contest/plugins/reporters/targetsuccess"
 	"github.com/linuxboot/contest/plugins/targetlocker/inmemory"
+	"github.com/linuxboot/contest/plugins/targetmanagers/inventorytargetmanager"
 	"github.com/linuxboot/contest/plugins/targetmanagers/targetlist"
 	"github.com/linuxboot/contest/plugins/testfetchers/literal"
 	testsCommon "github.com/linuxboot/contest/tests/common"
@@ -43,6 +51,7 @@ import (
 	testsIntegCommon "github.com/linuxboot/contest/tests/integ/common"
 	readmetareporter "github.com/linuxboot/contest/tests/plugins/reporters/readmeta"
 	"github.com/linuxboot/contest/tests/plugins/targetmanagers/readmeta"
+	"github.com/linuxboot/contest/tests/plugins/teststeps/artifacts"
 	"github.com/linuxboot/contest/tests/plugins/teststeps/crash"
 	"github.com/linuxboot/contest/tests/plugins/teststeps/fail"
 	"github.com/linuxboot/contest/tests/plugins/teststeps/noop"
@@ -58,10 +67,32 @@ import (
 type CommandType string
 
 const (
-	StartJob CommandType = "start"
-	StopJob  CommandType = "stop"
-	Status   CommandType = "status"
-	List     CommandType = "list"
+	StartJob  CommandType = "start"
+	StopJob   CommandType = "stop"
+	Status    CommandType = "status"
+	List      CommandType = "list"
+	Retry     CommandType = "retry"
+	PauseJob  CommandType = "pause"
+	ResumeJob CommandType = "resume"
+
+	Schedule      CommandType = "schedule"
+	Unschedule    CommandType = "unschedule"
+	ListSchedules CommandType = "listschedules"
+
+	Tail     CommandType = "tail"
+	Events   CommandType = "events"
+	Artifact CommandType = "artifact"
+	Logs     CommandType = "logs"
+
+	AddTarget   CommandType = "addtarget"
+	ListTargets CommandType = "listtargets"
+
+	QuarantineTarget       CommandType = "quarantine"
+	ReleaseTarget          CommandType = "release"
+	ListQuarantinedTargets CommandType = "quarantined"
+
+	ListLocks   CommandType = "locks"
+	ForceUnlock CommandType = "forceunlock"
 )
 
 type command struct {
@@ -70,6 +101,29 @@ type command struct {
 	jobDescriptor string
 	// List arguments
 	jobQuery *storage.JobQuery
+	// Retry arguments
+	retryOptions api.RetryOptions
+	// Schedule arguments
+	cronExpression string
+	skipIfRunning  bool
+	scheduleID     types.ScheduleID
+	// Tail arguments
+	cursor stream.Cursor
+	// Events arguments
+	testQuery      testevent.QueryFields
+	frameworkQuery frameworkevent.QueryFields
+	// Artifact arguments
+	artifactID uint64
+	// Logs arguments
+	afterSequenceID uint64
+	// Inventory arguments
+	target   inventory.Target
+	selector string
+	// Quarantine arguments
+	targetID string
+	reason   string
+	// Locks arguments
+	targetIDs []string
 }
 
 const fakeJobID types.JobID = 1234567
@@ -88,6 +142,12 @@ type TestListener struct {
 	// errorCh is an input channel to the integration tests where the
 	// dummy TestListener forwards errors coming from the API layer
 	errorCh chan error
+	// credentials, if set, are attached to the requests in place of the
+	// requestor name
+	credentials *api.Credentials
+	// requestScoped, if set, cancels the context of every request once the
+	// API call returns, like the HTTP and gRPC listeners do
+	requestScoped bool
 }
 
 // Serve implements the main logic of a dummy listener which talks to the API
@@ -99,34 +159,149 @@ func (tl *TestListener) Serve(ctx xcontext.Context, contestApi *api.API) error {
 		select {
 		case command := <-tl.commandCh:
 			ctx.Debugf("received command: %#+v", command)
+			ctx, requestor := ctx, api.EventRequestor("IntegrationTest")
+			cancelRequest := func() {}
+			if tl.requestScoped {
+				var reqCtx context.Context
+				reqCtx, cancelRequest = context.WithCancel(context.Background())
+				ctx = xcontext.WithStdContext(ctx, reqCtx)
+			}
+			if tl.credentials != nil {
+				ctx = xcontext.WithStdContext(ctx, api.WithCredentials(ctx, *tl.credentials))
+				requestor = ""
+			}
 			switch command.commandType {
 			case StartJob:
-				resp, err := contestApi.Start(ctx, "IntegrationTest", command.jobDescriptor)
+				resp, err := contestApi.Start(ctx, requestor, command.jobDescriptor)
 				if err != nil {
 					tl.errorCh <- err
 				}
 				tl.responseCh <- resp
 			case StopJob:
-				resp, err := contestApi.Stop(ctx, "IntegrationTest", command.jobID)
+				resp, err := contestApi.Stop(ctx, requestor, command.jobID)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case PauseJob:
+				resp, err := contestApi.Pause(ctx, requestor, command.jobID)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case ResumeJob:
+				resp, err := contestApi.Resume(ctx, requestor, command.jobID)
 				if err != nil {
 					tl.errorCh <- err
 				}
 				tl.responseCh <- resp
 			case Status:
-				resp, err := contestApi.Status(ctx, "IntegrationTest", command.jobID)
+				resp, err := contestApi.Status(ctx, requestor, command.jobID)
 				if err != nil {
 					tl.errorCh <- err
 				}
 				tl.responseCh <- resp
 			case List:
-				resp, err := contestApi.List(ctx, "IntegrationTest", command.jobQuery)
+				resp, err := contestApi.List(ctx, requestor, command.jobQuery)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case Retry:
+				resp, err := contestApi.Retry(ctx, requestor, command.jobID, command.retryOptions)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case Schedule:
+				resp, err := contestApi.Schedule(ctx, requestor, command.jobDescriptor, command.cronExpression, command.skipIfRunning)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case Unschedule:
+				resp, err := contestApi.Unschedule(ctx, requestor, command.scheduleID)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case ListSchedules:
+				resp, err := contestApi.ListSchedules(ctx, requestor)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case Tail:
+				resp, err := contestApi.Tail(ctx, requestor, command.jobID, stream.Filter{}, command.cursor, time.Second)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case Artifact:
+				resp, err := contestApi.Artifact(ctx, requestor, command.jobID, command.artifactID)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case Logs:
+				resp, err := contestApi.Logs(ctx, requestor, command.jobID, command.afterSequenceID, 0)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case AddTarget:
+				resp, err := contestApi.AddTarget(ctx, requestor, command.target)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case ListTargets:
+				resp, err := contestApi.ListTargets(ctx, requestor, command.selector)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case QuarantineTarget:
+				resp, err := contestApi.QuarantineTarget(ctx, requestor, command.targetID, command.reason)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case ReleaseTarget:
+				resp, err := contestApi.ReleaseTarget(ctx, requestor, command.targetID, command.reason)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case ListQuarantinedTargets:
+				resp, err := contestApi.ListQuarantinedTargets(ctx, requestor)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case ListLocks:
+				resp, err := contestApi.ListLocks(ctx, requestor, command.targetIDs)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case ForceUnlock:
+				resp, err := contestApi.ForceUnlock(ctx, requestor, command.targetIDs, command.reason)
+				if err != nil {
+					tl.errorCh <- err
+				}
+				tl.responseCh <- resp
+			case Events:
+				resp, err := contestApi.QueryEvents(ctx, requestor, command.testQuery, command.frameworkQuery)
 				if err != nil {
 					tl.errorCh <- err
 				}
 				tl.responseCh <- resp
 			default:
+				cancelRequest()
 				return nil
 			}
+			cancelRequest()
 		case <-ctx.Done():
 			return nil
 		}
@@ -257,6 +432,111 @@ func (suite *TestJobManagerSuite) stopJob(jobID types.JobID) error {
 	return nil
 }
 
+func (suite *TestJobManagerSuite) pauseJob(jobID types.JobID) error {
+	suite.listener.commandCh <- command{commandType: PauseJob, jobID: jobID}
+	select {
+	case resp := <-suite.listener.responseCh:
+		return resp.Err
+	case <-time.After(2 * time.Second):
+		return fmt.Errorf("Listener response should come within the timeout")
+	}
+}
+
+func (suite *TestJobManagerSuite) resumeJob(jobID types.JobID) error {
+	suite.listener.commandCh <- command{commandType: ResumeJob, jobID: jobID}
+	select {
+	case resp := <-suite.listener.responseCh:
+		return resp.Err
+	case <-time.After(2 * time.Second):
+		return fmt.Errorf("Listener response should come within the timeout")
+	}
+}
+
+func (suite *TestJobManagerSuite) retryJob(jobID types.JobID, opts api.RetryOptions) (types.JobID, error) {
+	suite.listener.commandCh <- command{commandType: Retry, jobID: jobID, retryOptions: opts}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return types.JobID(0), resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return types.JobID(0), fmt.Errorf("Listener response should come within the timeout")
+	}
+	return resp.Data.(api.ResponseDataRetry).NewJobID, nil
+}
+
+func (suite *TestJobManagerSuite) scheduleJob(jobDescriptor, cronExpression string, skipIfRunning bool) (types.ScheduleID, error) {
+	suite.listener.commandCh <- command{commandType: Schedule, jobDescriptor: jobDescriptor, cronExpression: cronExpression, skipIfRunning: skipIfRunning}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return 0, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return 0, fmt.Errorf("Listener response should come within the timeout")
+	}
+	return resp.Data.(api.ResponseDataSchedule).ScheduleID, nil
+}
+
+func (suite *TestJobManagerSuite) unscheduleJob(scheduleID types.ScheduleID) error {
+	suite.listener.commandCh <- command{commandType: Unschedule, scheduleID: scheduleID}
+	select {
+	case resp := <-suite.listener.responseCh:
+		return resp.Err
+	case <-time.After(2 * time.Second):
+		return fmt.Errorf("Listener response should come within the timeout")
+	}
+}
+
+func (suite *TestJobManagerSuite) listSchedules() ([]*job.Schedule, error) {
+	suite.listener.commandCh <- command{commandType: ListSchedules}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	return resp.Data.(api.ResponseDataListSchedules).Schedules, nil
+}
+
+func (suite *TestJobManagerSuite) tailJob(jobID types.JobID, cursor stream.Cursor) (*api.ResponseDataTail, error) {
+	suite.listener.commandCh <- command{commandType: Tail, jobID: jobID, cursor: cursor}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(5 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	data := resp.Data.(api.ResponseDataTail)
+	return &data, nil
+}
+
+func (suite *TestJobManagerSuite) queryEvents(testQuery testevent.QueryFields, frameworkQuery frameworkevent.QueryFields) (*api.ResponseDataEvents, error) {
+	suite.listener.commandCh <- command{commandType: Events, testQuery: testQuery, frameworkQuery: frameworkQuery}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case err := <-suite.listener.errorCh:
+		<-suite.listener.responseCh
+		return nil, err
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	data := resp.Data.(api.ResponseDataEvents)
+	return &data, nil
+}
+
 func (suite *TestJobManagerSuite) jobStatus(jobID types.JobID) (*job.Status, error) {
 	suite.listener.commandCh <- command{commandType: Status, jobID: jobID}
 	var resp api.Response
@@ -271,6 +551,144 @@ func (suite *TestJobManagerSuite) jobStatus(jobID types.JobID) (*job.Status, err
 	return resp.Data.(api.ResponseDataStatus).Status, nil
 }
 
+func (suite *TestJobManagerSuite) artifact(jobID types.JobID, artifactID uint64) (*api.ResponseDataArtifact, error) {
+	suite.listener.commandCh <- command{commandType: Artifact, jobID: jobID, artifactID: artifactID}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	data := resp.Data.(api.ResponseDataArtifact)
+	return &data, nil
+}
+
+func (suite *TestJobManagerSuite) logs(jobID types.JobID, afterSequenceID uint64) (*api.ResponseDataLogs, error) {
+	suite.listener.commandCh <- command{commandType: Logs, jobID: jobID, afterSequenceID: afterSequenceID}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	data := resp.Data.(api.ResponseDataLogs)
+	return &data, nil
+}
+
+func (suite *TestJobManagerSuite) addTarget(t inventory.Target) error {
+	suite.listener.commandCh <- command{commandType: AddTarget, target: t}
+	select {
+	case resp := <-suite.listener.responseCh:
+		return resp.Err
+	case <-time.After(2 * time.Second):
+		return fmt.Errorf("Listener response should come within the timeout")
+	}
+}
+
+func (suite *TestJobManagerSuite) listTargets(selector string) ([]inventory.Target, error) {
+	suite.listener.commandCh <- command{commandType: ListTargets, selector: selector}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	return resp.Data.(api.ResponseDataListTargets).Targets, nil
+}
+
+func (suite *TestJobManagerSuite) quarantineTarget(targetID, reason string) error {
+	suite.listener.commandCh <- command{commandType: QuarantineTarget, targetID: targetID, reason: reason}
+	select {
+	case resp := <-suite.listener.responseCh:
+		return resp.Err
+	case <-time.After(2 * time.Second):
+		return fmt.Errorf("Listener response should come within the timeout")
+	}
+}
+
+func (suite *TestJobManagerSuite) releaseTarget(targetID, reason string) error {
+	suite.listener.commandCh <- command{commandType: ReleaseTarget, targetID: targetID, reason: reason}
+	select {
+	case resp := <-suite.listener.responseCh:
+		return resp.Err
+	case <-time.After(2 * time.Second):
+		return fmt.Errorf("Listener response should come within the timeout")
+	}
+}
+
+func (suite *TestJobManagerSuite) listQuarantinedTargets() ([]health.Quarantine, error) {
+	suite.listener.commandCh <- command{commandType: ListQuarantinedTargets}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	return resp.Data.(api.ResponseDataListQuarantinedTargets).Quarantines, nil
+}
+
+func (suite *TestJobManagerSuite) listLocks(targetIDs []string) ([]target.Lock, error) {
+	suite.listener.commandCh <- command{commandType: ListLocks, targetIDs: targetIDs}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	return resp.Data.(api.ResponseDataListLocks).Locks, nil
+}
+
+func (suite *TestJobManagerSuite) forceUnlock(targetIDs []string, reason string) ([]target.Lock, error) {
+	suite.listener.commandCh <- command{commandType: ForceUnlock, targetIDs: targetIDs, reason: reason}
+	var resp api.Response
+	select {
+	case resp = <-suite.listener.responseCh:
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+	case <-time.After(2 * time.Second):
+		return nil, fmt.Errorf("Listener response should come within the timeout")
+	}
+	return resp.Data.(api.ResponseDataForceUnlock).Locks, nil
+}
+
+// waitForLogs returns all the log entries of a job once it is over.
+func (suite *TestJobManagerSuite) waitForLogs(jobID types.JobID, timeout time.Duration) ([]job.LogEntry, error) {
+	var entries []job.LogEntry
+	var afterSequenceID uint64
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		data, err := suite.logs(jobID, afterSequenceID)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range data.Entries {
+			entries = append(entries, entry)
+			afterSequenceID = entry.SequenceID
+		}
+		if data.Done {
+			return entries, nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return nil, fmt.Errorf("job %d still running after %s", jobID, timeout)
+}
+
 func (suite *TestJobManagerSuite) listJobs(states []job.State, tags []string, serverID string) ([]types.JobID, error) {
 	var fields []storage.JobQueryField
 	if len(states) > 0 {
@@ -282,6 +700,14 @@ func (suite *TestJobManagerSuite) listJobs(states []job.State, tags []string, se
 	if len(serverID) > 0 {
 		fields = append(fields, storage.QueryJobServerID(serverID))
 	}
+	jobs, err := suite.listJobSummaries(fields...)
+	if err != nil {
+		return nil, err
+	}
+	return summaryIDs(jobs), nil
+}
+
+func (suite *TestJobManagerSuite) listJobSummaries(fields ...storage.JobQueryField) ([]job.Summary, error) {
 	jobQuery, err := storage.BuildJobQuery(fields...)
 	if err != nil {
 		return nil, err
@@ -296,7 +722,19 @@ func (suite *TestJobManagerSuite) listJobs(states []job.State, tags []string, se
 	case <-time.After(2 * time.Second):
 		return nil, fmt.Errorf("Listener response should come within the timeout")
 	}
-	return resp.Data.(api.ResponseDataList).JobIDs, nil
+	data := resp.Data.(api.ResponseDataList)
+	if !reflect.DeepEqual(summaryIDs(data.Jobs), data.JobIDs) {
+		return nil, fmt.Errorf("job IDs %v do not match the summaries %v", data.JobIDs, data.Jobs)
+	}
+	return data.Jobs, nil
+}
+
+func summaryIDs(jobs []job.Summary) []types.JobID {
+	jobIDs := []types.JobID{}
+	for _, summary := range jobs {
+		jobIDs = append(jobIDs, summary.JobID)
+	}
+	return jobIDs
 }
 
 func (suite *TestJobManagerSuite) SetupTest() {
@@ -321,6 +759,7 @@ func (suite *TestJobManagerSuite) SetupTest() {
 	pr := pluginregistry.NewPluginRegistry(xcontext.Background())
 	pr.RegisterTargetManager(targetlist.Name, targetlist.New)
 	pr.RegisterTargetManager(readmeta.Name, readmeta.New)
+	pr.RegisterTargetManager(inventorytargetmanager.Name, inventorytargetmanager.New)
 	pr.RegisterTestFetcher(literal.Name, literal.New)
 	pr.RegisterReporter(readmetareporter.Name, readmetareporter.New)
 	pr.RegisterReporter(targetsuccess.Name, targetsuccess.New)
@@ -330,6 +769,7 @@ func (suite *TestJobManagerSuite) SetupTest() {
 	pr.RegisterTestStep(noreturn.Name, noreturn.New, noreturn.Events)
 	pr.RegisterTestStep(readmetastep.Name, readmetastep.New, readmetastep.Events)
 	pr.RegisterTestStep(slowecho.Name, slowecho.New, slowecho.Events)
+	pr.RegisterTestStep(artifacts.Name, artifacts.New, artifacts.Events)
 	suite.pluginRegistry = pr
 
 	suite.txStorage = testsIntegCommon.InitStorage(suite.storage)
@@ -342,13 +782,14 @@ func (suite *TestJobManagerSuite) SetupTest() {
 	suite.initJobManager("")
 }
 
-func (suite *TestJobManagerSuite) initJobManager(instanceTag string) {
+func (suite *TestJobManagerSuite) initJobManager(instanceTag string, extraOpts ...jobmanager.Option) {
 	suite.listener = &TestListener{commandCh: make(chan command), responseCh: make(chan api.Response), errorCh: make(chan error)}
 	suite.jobManagerCh = make(chan struct{})
 	opts := []jobmanager.Option{jobmanager.OptionClock(suite.clock)}
 	if instanceTag != "" {
 		opts = append(opts, jobmanager.OptionInstanceTag(instanceTag))
 	}
+	opts = append(opts, extraOpts...)
 	jm, err := jobmanager.New(suite.listener, suite.pluginRegistry, suite.storageEngineVault, opts...)
 	require.NoError(suite.T(), err)
 
@@ -602,21 +1043,136 @@ func (suite *TestJobManagerSuite) TestPauseAndFailToResume() {
 		true)
 }
 
-func (suite *TestJobManagerSuite) getTargetEvents(testName, targetID string) string {
-	return suite.getEvents(testName, &targetID, nil)
-}
+// pauseSlowEcho2 starts a job with jobDescriptorSlowEcho2 and pauses it
+// through the API during its first run.
+func (suite *TestJobManagerSuite) pauseSlowEcho2() types.JobID {
+	jobID, err := suite.startJob(jobDescriptorSlowEcho2)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobStarted, jobID, 1*time.Second)
+	require.NoError(suite.T(), err)
+	time.Sleep(250 * time.Millisecond)
 
-func (suite *TestJobManagerSuite) getEvents(testName string, targetID, stepLabel *string) string {
-	return testsCommon.GetTestEventsAsString(suite.jmCtx, suite.txStorage, testName, targetID, stepLabel)
+	require.NoError(suite.T(), suite.pauseJob(jobID))
+	ev, err := pollForEvent(suite.eventManager, job.EventJobPaused, jobID, 3*time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+	return jobID
 }
 
-func (suite *TestJobManagerSuite) TestJobManagerJobStartSingle() {
+func (suite *TestJobManagerSuite) TestJobManagerPauseAndResumeJob() {
 	suite.startJobManager(false /* resumeJobs */)
 
-	jobID, err := suite.startJob(jobDescriptorNoop)
+	jobID := suite.pauseSlowEcho2()
+	// The job keeps its targets while paused, and can't be paused again.
+	suite.verifyTargetLockStatus([]string{"id1", "id2"}, true)
+	require.Error(suite.T(), suite.pauseJob(jobID))
+	status, err := suite.jobStatus(jobID)
 	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), string(job.EventJobPaused), status.State)
 
-	_, err = suite.jsm.GetJobRequest(suite.jmCtx, jobID)
+	require.NoError(suite.T(), suite.resumeJob(jobID))
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+	suite.verifyTargetLockStatus([]string{"id1", "id2"}, false)
+
+	// The job ran as if it was never paused.
+	require.Equal(suite.T(), strings.Replace(`
+{[JOBID 1 IntegrationTest: resume 0 ][Target{ID: "id1"} TargetAcquired]}
+{[JOBID 1 IntegrationTest: resume 0 Step 1][Target{ID: "id1"} TargetIn]}
+{[JOBID 1 IntegrationTest: resume 0 Step 1][Target{ID: "id1"} TargetOut]}
+{[JOBID 1 IntegrationTest: resume 0 Step 2][Target{ID: "id1"} TargetIn]}
+{[JOBID 1 IntegrationTest: resume 0 Step 2][Target{ID: "id1"} TargetOut]}
+{[JOBID 1 IntegrationTest: resume 0 ][Target{ID: "id1"} TargetReleased]}
+{[JOBID 2 IntegrationTest: resume 0 ][Target{ID: "id1"} TargetAcquired]}
+{[JOBID 2 IntegrationTest: resume 0 Step 1][Target{ID: "id1"} TargetIn]}
+{[JOBID 2 IntegrationTest: resume 0 Step 1][Target{ID: "id1"} TargetOut]}
+{[JOBID 2 IntegrationTest: resume 0 Step 2][Target{ID: "id1"} TargetIn]}
+{[JOBID 2 IntegrationTest: resume 0 Step 2][Target{ID: "id1"} TargetOut]}
+{[JOBID 2 IntegrationTest: resume 0 ][Target{ID: "id1"} TargetReleased]}
+`, "JOBID", fmt.Sprintf("%d", jobID), -1),
+		suite.getTargetEvents("IntegrationTest: resume", "id1"))
+
+	// Only paused jobs can be resumed.
+	require.Error(suite.T(), suite.resumeJob(jobID))
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerResumeRequestCancelled() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID := suite.pauseSlowEcho2()
+	// The resumed job outlives the request which resumed it.
+	suite.listener.requestScoped = true
+	require.NoError(suite.T(), suite.resumeJob(jobID))
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+	ev, err = suite.eventManager.Fetch(suite.jmCtx, frameworkevent.QueryJobID(jobID), frameworkevent.QueryEventName(job.EventJobCancelled))
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), ev)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerStopPausedJob() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID := suite.pauseSlowEcho2()
+	suite.verifyTargetLockStatus([]string{"id1", "id2"}, true)
+
+	// The paused job is cancelled and gives its targets back right away.
+	require.NoError(suite.T(), suite.stopJob(jobID))
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCancelled, jobID, time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+	suite.verifyTargetLockStatus([]string{"id1", "id2"}, false)
+	require.Contains(suite.T(), suite.getTargetEvents("IntegrationTest: resume", "id1"),
+		fmt.Sprintf(`{[%d 1 IntegrationTest: resume 0 ][Target{ID: "id1"} TargetReleased]}`, jobID))
+
+	// The job cannot be resumed nor stopped again.
+	require.Error(suite.T(), suite.resumeJob(jobID))
+	require.Error(suite.T(), suite.stopJob(jobID))
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerPausedLockTimeout() {
+	suite.initJobManager("",
+		jobmanager.OptionTargetLockDuration(200*time.Millisecond),
+		jobmanager.OptionPausedLockTimeout(300*time.Millisecond),
+	)
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID := suite.pauseSlowEcho2()
+	suite.verifyTargetLockStatus([]string{"id1", "id2"}, true)
+
+	// Past the timeout, the locks are not refreshed anymore and expire.
+	time.Sleep(time.Second)
+	suite.verifyTargetLockStatus([]string{"id1", "id2"}, false)
+
+	// The targets are not ours anymore, the paused run fails to get them back
+	// when resumed.
+	require.NoError(suite.T(), suite.resumeJob(jobID))
+	_, err := pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	targetEvents := suite.getTargetEvents("IntegrationTest: resume", "id1")
+	require.NotContains(suite.T(), targetEvents,
+		fmt.Sprintf(`{[%d 1 IntegrationTest: resume 0 Step 2][Target{ID: "id1"} TargetIn]}`, jobID))
+	require.Contains(suite.T(), targetEvents,
+		fmt.Sprintf(`{[%d 2 IntegrationTest: resume 0 ][Target{ID: "id1"} TargetReleased]}`, jobID))
+}
+
+func (suite *TestJobManagerSuite) getTargetEvents(testName, targetID string) string {
+	return suite.getEvents(testName, &targetID, nil)
+}
+
+func (suite *TestJobManagerSuite) getEvents(testName string, targetID, stepLabel *string) string {
+	return testsCommon.GetTestEventsAsString(suite.jmCtx, suite.txStorage, testName, targetID, stepLabel)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerJobStartSingle() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+
+	_, err = suite.jsm.GetJobRequest(suite.jmCtx, jobID)
 	require.NoError(suite.T(), err)
 
 	r, err := suite.jsm.GetJobRequest(suite.jmCtx, jobID+1)
@@ -634,6 +1190,208 @@ func (suite *TestJobManagerSuite) TestJobManagerJobStartSingle() {
 	require.Equal(suite.T(), 1, len(ev))
 }
 
+func (suite *TestJobManagerSuite) TestJobManagerTail() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorSlowEcho)
+	require.NoError(suite.T(), err)
+
+	// Tail the job until it completes, resuming from the last cursor.
+	var (
+		cursor stream.Cursor
+		names  []event.Name
+	)
+	for i := 0; ; i++ {
+		require.Less(suite.T(), i, 30, "job did not complete")
+		data, err := suite.tailJob(jobID, cursor)
+		require.NoError(suite.T(), err)
+		for _, ev := range data.Events {
+			if ev.TestEvent != nil {
+				require.Greater(suite.T(), ev.TestEvent.SequenceID, cursor.TestEventSequenceID)
+				names = append(names, ev.TestEvent.Data.EventName)
+			} else {
+				require.Greater(suite.T(), ev.FrameworkEvent.SequenceID, cursor.FrameworkEventSequenceID)
+				names = append(names, ev.FrameworkEvent.EventName)
+			}
+		}
+		cursor = data.Cursor
+		if data.Done {
+			break
+		}
+	}
+	require.Contains(suite.T(), names, job.EventJobStarted)
+	require.Contains(suite.T(), names, target.EventTargetAcquired)
+	require.Contains(suite.T(), names, target.EventTargetReleased)
+	require.Equal(suite.T(), job.EventJobCompleted, names[len(names)-1])
+
+	// Nothing is left to stream once the job completed.
+	data, err := suite.tailJob(jobID, cursor)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), data.Events)
+	require.True(suite.T(), data.Done)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerQueryEvents() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 1*time.Second)
+	require.NoError(suite.T(), err)
+
+	all, err := suite.testEventManager.Fetch(suite.jmCtx, testevent.QueryJobID(jobID))
+	require.NoError(suite.T(), err)
+	require.NotEmpty(suite.T(), all)
+
+	// Page through the test events of the job one by one.
+	var (
+		paged     []testevent.Event
+		lastSeqID uint64
+	)
+	for {
+		testQuery := testevent.QueryFields{testevent.QueryJobID(jobID), testevent.QueryLimit(1)}
+		if lastSeqID != 0 {
+			testQuery = append(testQuery, testevent.QueryAfterSequenceID(lastSeqID))
+		}
+		data, err := suite.queryEvents(testQuery, nil)
+		require.NoError(suite.T(), err)
+		require.Empty(suite.T(), data.FrameworkEvents)
+		if len(data.TestEvents) == 0 {
+			break
+		}
+		require.Len(suite.T(), data.TestEvents, 1)
+		paged = append(paged, data.TestEvents...)
+		lastSeqID = data.TestEvents[0].SequenceID
+	}
+	require.Equal(suite.T(), len(all), len(paged))
+
+	data, err := suite.queryEvents(
+		testevent.QueryFields{testevent.QueryJobID(jobID), testevent.QueryTargetID("id1")},
+		frameworkevent.QueryFields{frameworkevent.QueryJobID(jobID), frameworkevent.QueryEventName(job.EventJobCompleted)},
+	)
+	require.NoError(suite.T(), err)
+	require.NotEmpty(suite.T(), data.TestEvents)
+	for _, ev := range data.TestEvents {
+		require.Equal(suite.T(), "id1", ev.Data.Target.ID)
+	}
+	require.Len(suite.T(), data.FrameworkEvents, 1)
+
+	// Queries must be restricted to a job.
+	_, err = suite.queryEvents(testevent.QueryFields{testevent.QueryTargetID("id1")}, nil)
+	require.Error(suite.T(), err)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerArtifacts() {
+	store, err := artifact.NewFilesystemStore(suite.T().TempDir(), 0, 0)
+	require.NoError(suite.T(), err)
+	suite.initJobManager("", jobmanager.OptionArtifactStore(store))
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorArtifacts)
+	require.NoError(suite.T(), err)
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+
+	// Each target uploaded an artifact, they are listed in the status.
+	status, err := suite.jobStatus(jobID)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), status.Artifacts, 2)
+	names := make(map[string]artifact.Artifact)
+	for _, a := range status.Artifacts {
+		require.Equal(suite.T(), jobID, a.JobID)
+		require.Equal(suite.T(), types.RunID(1), a.RunID)
+		require.Equal(suite.T(), "IntegrationTest: artifacts", a.TestName)
+		require.Equal(suite.T(), "artifacts_label", a.TestStepLabel)
+		names[a.Name] = a
+	}
+	a, ok := names["id2.txt"]
+	require.True(suite.T(), ok)
+	require.Equal(suite.T(), "id2", a.TargetID)
+
+	data, err := suite.artifact(jobID, a.ID)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "artifact of id2", string(data.Content))
+	require.Equal(suite.T(), a.SHA256, data.Artifact.SHA256)
+
+	_, err = suite.artifact(jobID, 3)
+	require.Error(suite.T(), err)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerArtifactTooLarge() {
+	store, err := artifact.NewFilesystemStore(suite.T().TempDir(), 0, 0)
+	require.NoError(suite.T(), err)
+	suite.initJobManager("", jobmanager.OptionArtifactStore(store), jobmanager.OptionArtifactMaxDownloadSize(10))
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorArtifacts)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+
+	// The artifacts are stored, but are too large to be downloaded.
+	status, err := suite.jobStatus(jobID)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), status.Artifacts, 2)
+	_, err = suite.artifact(jobID, status.Artifacts[0].ID)
+	require.ErrorIs(suite.T(), err, artifact.ErrTooLarge)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerLogs() {
+	suite.initJobManager("", jobmanager.OptionJobLogs(logger.LevelInfo, 0))
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+
+	entries, err := suite.waitForLogs(jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	var messages []string
+	for _, entry := range entries {
+		require.Equal(suite.T(), jobID, entry.JobID)
+		require.NotEqual(suite.T(), "debug", entry.Level)
+		messages = append(messages, entry.Message)
+	}
+	require.Contains(suite.T(), messages, fmt.Sprintf("Job %d finished", jobID))
+	found := false
+	for _, message := range messages {
+		found = found || strings.HasPrefix(message, "Running job ")
+	}
+	require.True(suite.T(), found, "JobRunner lines are captured: %v", messages)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerLogsTruncated() {
+	suite.initJobManager("", jobmanager.OptionJobLogs(logger.LevelDebug, 2))
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+	entries, err := suite.waitForLogs(jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), entries, 3)
+	require.Equal(suite.T(), "Log truncated after 2 lines", entries[2].Message)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerMetrics() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+
+	metrics := suite.jmCtx.Metrics().WithTags(nil)
+	// The completion is counted once the event is stored.
+	require.Eventually(suite.T(), func() bool {
+		return metrics.WithTag(perf.TAG_JOB_STATE, job.JobStateCompleted.String()).Count(perf.JOB_STATES).Add(0) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(suite.T(), uint64(1), metrics.WithTag(perf.TAG_JOB_STATE, job.JobStateStarted.String()).Count(perf.JOB_STATES).Add(0))
+	require.Equal(suite.T(), uint64(1), metrics.WithTag(perf.TAG_API_METHOD, "start").Count(perf.API_REQUESTS).Add(0))
+	require.Equal(suite.T(), uint64(1), metrics.Count(perf.TARGET_ACQUIRES).Add(0))
+}
+
 func (suite *TestJobManagerSuite) TestJobManagerTargetManagerMetadataAvailable() {
 	suite.startJobManager(false /* resumeJobs */)
 
@@ -754,6 +1512,556 @@ func (suite *TestJobManagerSuite) TestJobManagerJobFailure() {
 	require.Equal(suite.T(), 0, len(jobReport.FinalReports))
 }
 
+func (suite *TestJobManagerSuite) TestJobManagerRetry() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 1*time.Second)
+	require.NoError(suite.T(), err)
+
+	newJobID, err := suite.retryJob(jobID, api.RetryOptions{})
+	require.NoError(suite.T(), err)
+	require.NotEqual(suite.T(), jobID, newJobID)
+
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, newJobID, 1*time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+
+	status, err := suite.jobStatus(newJobID)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), jobID, status.RetryOf)
+
+	// There is nothing to retry when only failures are requested.
+	_, err = suite.retryJob(jobID, api.RetryOptions{OnlyFailedTests: true})
+	require.Error(suite.T(), err)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerRetryRequestCancelled() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorSlowEcho)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+
+	// The retry outlives the request which submitted it.
+	suite.listener.requestScoped = true
+	newJobID, err := suite.retryJob(jobID, api.RetryOptions{})
+	require.NoError(suite.T(), err)
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, newJobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+	ev, err = suite.eventManager.Fetch(suite.jmCtx, frameworkevent.QueryJobID(newJobID), frameworkevent.QueryEventName(job.EventJobCancelled))
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), ev)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerRetryOnlyFailedTargets() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorFailure)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 1*time.Second)
+	require.NoError(suite.T(), err)
+
+	newJobID, err := suite.retryJob(jobID, api.RetryOptions{OnlyFailedTargets: true})
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, newJobID, 1*time.Second)
+	require.NoError(suite.T(), err)
+
+	req, err := suite.jsm.GetJobRequest(suite.jmCtx, newJobID)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), jobID, req.ExtendedDescriptor.RetryOf)
+	require.Equal(suite.T(), 1, len(req.ExtendedDescriptor.TestDescriptors))
+	require.ElementsMatch(suite.T(), []string{"id1", "id2"}, req.ExtendedDescriptor.TestDescriptors[0].TargetIDs)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerRetryRunning() {
+	suite.startJobManager(false /* resumeJobs */)
+
+	jobID, err := suite.startJob(jobDescriptorSlowEcho)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobStarted, jobID, 1*time.Second)
+	require.NoError(suite.T(), err)
+
+	_, err = suite.retryJob(jobID, api.RetryOptions{})
+	require.Error(suite.T(), err)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerAuthorization() {
+	suite.initJobManager("",
+		jobmanager.APIOption(api.OptionAuthenticator{Authenticator: api.StaticTokens{
+			"alice-token": "alice",
+			"bob-token":   "bob",
+			"admin-token": "admin",
+		}}),
+		jobmanager.APIOption(api.OptionAuthorizer{Authorizer: api.OwnerOrAdmin{Admins: []api.EventRequestor{"admin"}}}),
+	)
+	suite.startJobManager(false /* resumeJobs */)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "alice-token"}
+	jobID, err := suite.startJob(jobDescriptorSlowEcho)
+	require.NoError(suite.T(), err)
+	req, err := suite.jsm.GetJobRequest(suite.jmCtx, jobID)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "alice", req.Requestor)
+
+	// Only the requestor of the job and the admins can stop or retry it.
+	suite.listener.credentials = &api.Credentials{BearerToken: "bob-token"}
+	require.ErrorIs(suite.T(), suite.stopJob(jobID), api.ErrPermissionDenied)
+	_, err = suite.retryJob(jobID, api.RetryOptions{})
+	require.ErrorIs(suite.T(), err, api.ErrPermissionDenied)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "admin-token"}
+	require.NoError(suite.T(), suite.stopJob(jobID))
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCancelled, jobID, time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerUnscheduleAuthorization() {
+	suite.initJobManager("",
+		jobmanager.APIOption(api.OptionAuthenticator{Authenticator: api.StaticTokens{
+			"alice-token": "alice",
+			"bob-token":   "bob",
+			"admin-token": "admin",
+		}}),
+		jobmanager.APIOption(api.OptionAuthorizer{Authorizer: api.OwnerOrAdmin{Admins: []api.EventRequestor{"admin"}}}),
+	)
+	suite.startJobManager(false /* resumeJobs */)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "alice-token"}
+	aliceScheduleID, err := suite.scheduleJob(jobDescriptorNoop, "0 0 1 1 *", false)
+	require.NoError(suite.T(), err)
+	adminScheduleID, err := suite.scheduleJob(jobDescriptorNoop, "0 0 1 1 *", false)
+	require.NoError(suite.T(), err)
+
+	// Only the owner of a schedule and the admins can delete it.
+	suite.listener.credentials = &api.Credentials{BearerToken: "bob-token"}
+	require.ErrorIs(suite.T(), suite.unscheduleJob(aliceScheduleID), api.ErrPermissionDenied)
+	suite.listener.credentials = &api.Credentials{BearerToken: "alice-token"}
+	require.NoError(suite.T(), suite.unscheduleJob(aliceScheduleID))
+	suite.listener.credentials = &api.Credentials{BearerToken: "admin-token"}
+	require.NoError(suite.T(), suite.unscheduleJob(adminScheduleID))
+	schedules, err := suite.listSchedules()
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), schedules)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerInventory() {
+	suite.initJobManager("",
+		jobmanager.APIOption(api.OptionAuthenticator{Authenticator: api.StaticTokens{
+			"alice-token": "alice",
+			"admin-token": "admin",
+		}}),
+		jobmanager.APIOption(api.OptionAuthorizer{Authorizer: api.OwnerOrAdmin{Admins: []api.EventRequestor{"admin"}}}),
+	)
+	suite.startJobManager(false /* resumeJobs */)
+
+	// Only the admins can change the inventory.
+	suite.listener.credentials = &api.Credentials{BearerToken: "alice-token"}
+	require.ErrorIs(suite.T(), suite.addTarget(inventory.Target{ID: "t1"}), api.ErrPermissionDenied)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "admin-token"}
+	for _, t := range []inventory.Target{
+		{ID: "t1", Labels: map[string]string{"board": "tioga"}},
+		{ID: "t2", Labels: map[string]string{"board": "tioga"}},
+		{ID: "t3", Labels: map[string]string{"board": "tioga", "broken": ""}},
+		{ID: "t4", Labels: map[string]string{"board": "yosemite"}},
+	} {
+		require.NoError(suite.T(), suite.addTarget(t))
+	}
+	require.ErrorIs(suite.T(), suite.addTarget(inventory.Target{ID: "t1"}), inventory.ErrAlreadyExists)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "alice-token"}
+	targets, err := suite.listTargets("board=tioga,!broken")
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), targets, 2)
+	require.False(suite.T(), targets[0].CreateTime.IsZero())
+
+	jobID, err := suite.startJob(jobDescriptorInventory)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	evs, err := pollForTestEvent(suite.testEventManager, target.EventTargetIn, jobID, time.Second)
+	require.NoError(suite.T(), err)
+	var targetIDs []string
+	for _, ev := range evs {
+		targetIDs = append(targetIDs, ev.Data.Target.ID)
+	}
+	require.ElementsMatch(suite.T(), []string{"t1", "t2"}, targetIDs)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerQuarantine() {
+	suite.initJobManager("",
+		jobmanager.APIOption(api.OptionAuthenticator{Authenticator: api.StaticTokens{
+			"alice-token": "alice",
+			"admin-token": "admin",
+		}}),
+		jobmanager.APIOption(api.OptionAuthorizer{Authorizer: api.OwnerOrAdmin{Admins: []api.EventRequestor{"admin"}}}),
+	)
+	suite.startJobManager(false /* resumeJobs */)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "admin-token"}
+	for _, id := range []string{"t1", "t2", "t3"} {
+		require.NoError(suite.T(), suite.addTarget(inventory.Target{ID: id, Labels: map[string]string{"board": "tioga"}}))
+	}
+
+	// Only the admins can quarantine and release targets.
+	suite.listener.credentials = &api.Credentials{BearerToken: "alice-token"}
+	require.ErrorIs(suite.T(), suite.quarantineTarget("t1", "bricked"), api.ErrPermissionDenied)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "admin-token"}
+	require.NoError(suite.T(), suite.quarantineTarget("t1", "bricked"))
+	require.ErrorIs(suite.T(), suite.quarantineTarget("t1", "bricked"), health.ErrQuarantined)
+	quarantines, err := suite.listQuarantinedTargets()
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), quarantines, 1)
+	require.Equal(suite.T(), "t1", quarantines[0].TargetID)
+	require.Equal(suite.T(), "admin", quarantines[0].Requestor)
+	require.Equal(suite.T(), "bricked", quarantines[0].Reason)
+	require.False(suite.T(), quarantines[0].Time.IsZero())
+
+	// The quarantined target is not handed out to jobs.
+	jobID, err := suite.startJob(jobDescriptorInventory)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	evs, err := pollForTestEvent(suite.testEventManager, target.EventTargetIn, jobID, time.Second)
+	require.NoError(suite.T(), err)
+	var targetIDs []string
+	for _, ev := range evs {
+		targetIDs = append(targetIDs, ev.Data.Target.ID)
+	}
+	require.ElementsMatch(suite.T(), []string{"t2", "t3"}, targetIDs)
+
+	require.NoError(suite.T(), suite.releaseTarget("t1", "reflashed"))
+	require.ErrorIs(suite.T(), suite.releaseTarget("t1", "reflashed"), health.ErrNotQuarantined)
+	quarantines, err = suite.listQuarantinedTargets()
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), quarantines)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerForceUnlock() {
+	suite.initJobManager("",
+		jobmanager.APIOption(api.OptionAuthenticator{Authenticator: api.StaticTokens{
+			"alice-token": "alice",
+			"admin-token": "admin",
+		}}),
+		jobmanager.APIOption(api.OptionAuthorizer{Authorizer: api.OwnerOrAdmin{Admins: []api.EventRequestor{"admin"}}}),
+	)
+	suite.startJobManager(false /* resumeJobs */)
+
+	targets := []*target.Target{{ID: "t1"}, {ID: "t2"}}
+	require.NoError(suite.T(), suite.targetLocker.Lock(suite.jmCtx, fakeJobID, time.Minute, targets))
+
+	// Only the admins can inspect and override the locks.
+	suite.listener.credentials = &api.Credentials{BearerToken: "alice-token"}
+	_, err := suite.listLocks(nil)
+	require.ErrorIs(suite.T(), err, api.ErrPermissionDenied)
+	_, err = suite.forceUnlock([]string{"t1"}, "stale lock")
+	require.ErrorIs(suite.T(), err, api.ErrPermissionDenied)
+
+	suite.listener.credentials = &api.Credentials{BearerToken: "admin-token"}
+	locks, err := suite.listLocks(nil)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), locks, 2)
+	require.Equal(suite.T(), "t1", locks[0].TargetID)
+	require.Equal(suite.T(), fakeJobID, locks[0].JobID)
+	require.True(suite.T(), locks[0].ExpiresAt.After(locks[0].CreatedAt))
+
+	locks, err = suite.forceUnlock([]string{"t1"}, "stale lock")
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), locks, 1)
+	require.Equal(suite.T(), "t1", locks[0].TargetID)
+	suite.verifyTargetLockStatus([]string{"t1"}, false)
+	locks, err = suite.listLocks([]string{"t1", "t2"})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), locks, 1)
+	require.Equal(suite.T(), "t2", locks[0].TargetID)
+
+	// The override is recorded as an event of the job which held the lock.
+	evs, err := pollForEvent(suite.eventManager, target.EventTargetForceUnlocked, fakeJobID, time.Second)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), evs, 1)
+	var payload target.ForceUnlockPayload
+	require.NoError(suite.T(), json.Unmarshal(*evs[0].Payload, &payload))
+	require.Equal(suite.T(), "t1", payload.Lock.TargetID)
+	require.Equal(suite.T(), "admin", payload.Requestor)
+	require.Equal(suite.T(), "stale lock", payload.Reason)
+
+	_, err = suite.forceUnlock([]string{"t1"}, "stale lock")
+	require.Error(suite.T(), err)
+	require.Contains(suite.T(), err.Error(), "not locked")
+	require.NoError(suite.T(), suite.targetLocker.Unlock(suite.jmCtx, fakeJobID, targets[1:]))
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerWaitForTargets() {
+	suite.initJobManager("")
+	suite.startJobManager(false /* resumeJobs */)
+	for _, id := range []string{"t1", "t2", "t3"} {
+		require.NoError(suite.T(), suite.addTarget(inventory.Target{ID: id, Labels: map[string]string{"board": "tioga"}}))
+	}
+
+	busyJobID, err := suite.startJob(jobDescriptorInventorySlowEcho)
+	require.NoError(suite.T(), err)
+	_, err = pollForTestEvent(suite.testEventManager, target.EventTargetAcquired, busyJobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+
+	// All the targets are taken, the job waits for them instead of failing.
+	waitJobID, err := suite.startJob(jobDescriptorInventoryWait)
+	require.NoError(suite.T(), err)
+	var status *job.Status
+	require.Eventually(suite.T(), func() bool {
+		status, err = suite.jobStatus(waitJobID)
+		require.NoError(suite.T(), err)
+		return status.TargetWait != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(suite.T(), 1, status.TargetWait.Position)
+	require.Equal(suite.T(), uint(2), status.TargetWait.MinTargets)
+
+	busyEv, err := pollForEvent(suite.eventManager, job.EventJobCompleted, busyJobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, waitJobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	evs, err := pollForTestEvent(suite.testEventManager, target.EventTargetAcquired, waitJobID, time.Second)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), evs, 3)
+	require.False(suite.T(), evs[0].EmitTime.Before(busyEv[0].EmitTime))
+	status, err = suite.jobStatus(waitJobID)
+	require.NoError(suite.T(), err)
+	require.Nil(suite.T(), status.TargetWait)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerQueue() {
+	suite.initJobManager("", jobmanager.OptionMaxConcurrentJobs(1))
+	suite.startJobManager(false /* resumeJobs */)
+
+	slowJobID, err := suite.startJob(jobDescriptorSlowEcho)
+	require.NoError(suite.T(), err)
+	lowJobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+	highJobID, err := suite.startJob(strings.Replace(jobDescriptorNoop, `"JobName": "test job",`, `"JobName": "test job", "Priority": 10,`, 1))
+	require.NoError(suite.T(), err)
+
+	// The higher priority job jumps ahead of the other queued job.
+	status, err := suite.jobStatus(highJobID)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), string(job.EventJobQueued), status.State)
+	require.Equal(suite.T(), 1, status.QueuePosition)
+	status, err = suite.jobStatus(lowJobID)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), string(job.EventJobQueued), status.State)
+	require.Equal(suite.T(), 2, status.QueuePosition)
+
+	jobIDs, err := suite.listJobs([]job.State{job.JobStateQueued}, nil, "")
+	require.NoError(suite.T(), err)
+	require.ElementsMatch(suite.T(), []types.JobID{lowJobID, highJobID}, jobIDs)
+
+	var completions []*frameworkevent.Event
+	for _, jobID := range []types.JobID{slowJobID, highJobID, lowJobID} {
+		ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), 1, len(ev))
+		completions = append(completions, &ev[0])
+	}
+	// Jobs ran one at a time, in queue order.
+	for _, jobID := range []types.JobID{highJobID, lowJobID} {
+		ev, err := pollForEvent(suite.eventManager, job.EventJobStarted, jobID, time.Second)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), 1, len(ev))
+		if jobID == highJobID {
+			require.Greater(suite.T(), ev[0].SequenceID, completions[0].SequenceID)
+		} else {
+			require.Greater(suite.T(), ev[0].SequenceID, completions[1].SequenceID)
+		}
+	}
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerQueueStop() {
+	suite.initJobManager("", jobmanager.OptionTagConcurrencyLimit{Tag: "integration_testing", Max: 1})
+	suite.startJobManager(false /* resumeJobs */)
+
+	_, err := suite.startJob(jobDescriptorSlowEcho)
+	require.NoError(suite.T(), err)
+	queuedJobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+
+	require.NoError(suite.T(), suite.stopJob(queuedJobID))
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCancelled, queuedJobID, time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerQueueResume() {
+	suite.initJobManager("", jobmanager.OptionMaxConcurrentJobs(1))
+	suite.startJobManager(true /* resumeJobs */)
+
+	runningJobID, err := suite.startJob(jobDescriptorSlowEcho2)
+	require.NoError(suite.T(), err)
+	queuedJobID, err := suite.startJob(jobDescriptorNoop)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobStarted, runningJobID, time.Second)
+	require.NoError(suite.T(), err)
+
+	suite.jmPause()
+	select {
+	case <-suite.jobManagerCh:
+	case <-time.After(3 * time.Second):
+		suite.T().Errorf("JobManager should return within the timeout")
+	}
+	query, err := storage.BuildJobQuery(storage.QueryJobStates(job.JobStateQueued))
+	require.NoError(suite.T(), err)
+	jobs, err := suite.jsm.ListJobs(suite.jmCtx, query)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []types.JobID{queuedJobID}, summaryIDs(jobs))
+
+	// The queued job is picked up again by the new instance.
+	suite.initJobManager("", jobmanager.OptionMaxConcurrentJobs(1))
+	suite.startJobManager(true /* resumeJobs */)
+	for _, jobID := range []types.JobID{runningJobID, queuedJobID} {
+		ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, jobID, 5*time.Second)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), 1, len(ev))
+	}
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerResumeLimits() {
+	suite.initJobManager("", jobmanager.OptionMaxConcurrentJobs(1))
+	suite.startJobManager(false /* resumeJobs */)
+
+	pausedJobID := suite.pauseSlowEcho2()
+	// The paused job frees its slot, another job takes it.
+	slowJob := strings.Replace(jobDescriptorSlowEcho, `"sleep": ["0.5"]`, `"sleep": ["30"]`, 1)
+	slowJob = strings.NewReplacer(`"id1"`, `"id3"`, `"id2"`, `"id4"`).Replace(slowJob)
+	slowJobID, err := suite.startJob(slowJob)
+	require.NoError(suite.T(), err)
+	_, err = pollForEvent(suite.eventManager, job.EventJobStarted, slowJobID, time.Second)
+	require.NoError(suite.T(), err)
+
+	// The paused job has to wait for a free slot to be resumed.
+	err = suite.resumeJob(pausedJobID)
+	require.Error(suite.T(), err)
+	require.Contains(suite.T(), err.Error(), "concurrency limits")
+	status, err := suite.jobStatus(pausedJobID)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), string(job.EventJobPaused), status.State)
+
+	require.NoError(suite.T(), suite.stopJob(slowJobID))
+	_, err = pollForEvent(suite.eventManager, job.EventJobCancelled, slowJobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+	require.Eventually(suite.T(), func() bool {
+		return suite.resumeJob(pausedJobID) == nil
+	}, 5*time.Second, 50*time.Millisecond)
+	_, err = pollForEvent(suite.eventManager, job.EventJobCompleted, pausedJobID, 5*time.Second)
+	require.NoError(suite.T(), err)
+}
+
+// waitForScheduledJobs advances the mock clock a minute at a time until at
+// least n jobs exist.
+func (suite *TestJobManagerSuite) waitForScheduledJobs(clk *clock.Mock, n int) []types.JobID {
+	var jobIDs []types.JobID
+	require.Eventually(suite.T(), func() bool {
+		clk.Add(time.Minute)
+		query, err := storage.BuildJobQuery()
+		require.NoError(suite.T(), err)
+		jobs, err := suite.jsm.ListJobs(suite.jmCtx, query)
+		require.NoError(suite.T(), err)
+		jobIDs = summaryIDs(jobs)
+		return len(jobIDs) >= n
+	}, 5*time.Second, 50*time.Millisecond)
+	return jobIDs
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerSchedule() {
+	clk := clock.NewMock()
+	suite.initJobManager("", jobmanager.OptionClock(clk))
+	suite.startJobManager(false /* resumeJobs */)
+
+	_, err := suite.scheduleJob(jobDescriptorNoop, "61 * * * *", false)
+	require.Error(suite.T(), err)
+	_, err = suite.scheduleJob(jobDescriptorBadTag, "* * * * *", false)
+	require.Error(suite.T(), err)
+
+	scheduleID, err := suite.scheduleJob(jobDescriptorNoop, "* * * * *", false)
+	require.NoError(suite.T(), err)
+	schedules, err := suite.listSchedules()
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(schedules))
+	require.Equal(suite.T(), scheduleID, schedules[0].ID)
+	require.Equal(suite.T(), "* * * * *", schedules[0].CronExpression)
+	require.Equal(suite.T(), "IntegrationTest", schedules[0].Requestor)
+
+	jobIDs := suite.waitForScheduledJobs(clk, 1)
+	ev, err := pollForEvent(suite.eventManager, job.EventJobCompleted, jobIDs[0], 5*time.Second)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(ev))
+	req, err := suite.jsm.GetJobRequest(suite.jmCtx, jobIDs[0])
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), "IntegrationTest", req.Requestor)
+
+	require.NoError(suite.T(), suite.unscheduleJob(scheduleID))
+	schedules, err = suite.listSchedules()
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), schedules)
+	require.Error(suite.T(), suite.unscheduleJob(scheduleID))
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerScheduleSkipIfRunning() {
+	clk := clock.NewMock()
+	suite.initJobManager("", jobmanager.OptionClock(clk))
+	suite.startJobManager(false /* resumeJobs */)
+
+	slowJob := strings.Replace(jobDescriptorSlowEcho, `"sleep": ["0.5"]`, `"sleep": ["30"]`, 1)
+	_, err := suite.scheduleJob(slowJob, "* * * * *", true /* skipIfRunning */)
+	require.NoError(suite.T(), err)
+	jobIDs := suite.waitForScheduledJobs(clk, 1)
+	require.Equal(suite.T(), 1, len(jobIDs))
+	_, err = pollForEvent(suite.eventManager, job.EventJobStarted, jobIDs[0], time.Second)
+	require.NoError(suite.T(), err)
+
+	// No new job while the previous one is running.
+	for i := 0; i < 5; i++ {
+		clk.Add(time.Minute)
+		time.Sleep(20 * time.Millisecond)
+	}
+	query, err := storage.BuildJobQuery()
+	require.NoError(suite.T(), err)
+	allJobs, err := suite.jsm.ListJobs(suite.jmCtx, query)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), jobIDs, summaryIDs(allJobs))
+
+	// Once it is done, the schedule fires again.
+	require.NoError(suite.T(), suite.stopJob(jobIDs[0]))
+	_, err = pollForEvent(suite.eventManager, job.EventJobCancelled, jobIDs[0], 5*time.Second)
+	require.NoError(suite.T(), err)
+	suite.waitForScheduledJobs(clk, 2)
+}
+
+func (suite *TestJobManagerSuite) TestJobManagerSchedulePersistence() {
+	suite.startJobManager(false /* resumeJobs */)
+	scheduleID, err := suite.scheduleJob(jobDescriptorNoop, "*/10 * * * *", false)
+	require.NoError(suite.T(), err)
+	suite.stopJobManager()
+
+	// The schedule is picked up again by the new instance.
+	clk := clock.NewMock()
+	suite.initJobManager("", jobmanager.OptionClock(clk))
+	suite.startJobManager(false /* resumeJobs */)
+	schedules, err := suite.listSchedules()
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), 1, len(schedules))
+	require.Equal(suite.T(), scheduleID, schedules[0].ID)
+
+	jobIDs := suite.waitForScheduledJobs(clk, 1)
+	require.Eventually(suite.T(), func() bool {
+		schedules, err = suite.listSchedules()
+		require.NoError(suite.T(), err)
+		return schedules[0].LastJobID == jobIDs[0]
+	}, time.Second, 10*time.Millisecond)
+}
+
 func (suite *TestJobManagerSuite) TestJobManagerJobCrash() {
 	suite.startJobManager(false /* resumeJobs */)
 
@@ -936,4 +2244,43 @@ func (suite *TestJobManagerSuite) TestJobListing() {
 	jobIDs, err = suite.listJobs(nil, nil, suite.listener.api.ServerID())
 	require.NoError(suite.T(), err)
 	require.Equal(suite.T(), []types.JobID{jobID1, jobID2}, jobIDs)
+
+	// Summaries, newest first and paginated.
+	jobs, err := suite.listJobSummaries(storage.QueryJobsNewestFirst(), storage.QueryJobLimit(1))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []types.JobID{jobID2}, summaryIDs(jobs))
+	require.Equal(suite.T(), job.JobStateFailed, jobs[0].State)
+	require.Equal(suite.T(), "IntegrationTest", jobs[0].Requestor)
+	require.Contains(suite.T(), jobs[0].Tags, "integration_testing")
+	require.False(suite.T(), jobs[0].StartTime.IsZero())
+	require.NotNil(suite.T(), jobs[0].EndTime)
+	jobs, err = suite.listJobSummaries(storage.QueryJobsNewestFirst(), storage.QueryJobLimit(1), storage.QueryJobAfterJobID(jobID2))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []types.JobID{jobID1}, summaryIDs(jobs))
+	require.Equal(suite.T(), job.JobStateCompleted, jobs[0].State)
+
+	// Filter by requestor, name and end time.
+	jobIDs, err = suite.listJobsWith(storage.QueryJobRequestor("IntegrationTest"))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []types.JobID{jobID1, jobID2}, jobIDs)
+	jobIDs, err = suite.listJobsWith(storage.QueryJobRequestor("someone else"))
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), jobIDs)
+	jobIDs, err = suite.listJobsWith(storage.QueryJobNamePattern("*"))
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []types.JobID{jobID1, jobID2}, jobIDs)
+	jobIDs, err = suite.listJobsWith(storage.QueryJobNamePattern("no such job*"))
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), jobIDs)
+	jobIDs, err = suite.listJobsWith(storage.QueryJobEndedAfter(jobs[0].EndTime.Add(time.Nanosecond)))
+	require.NoError(suite.T(), err)
+	require.NotContains(suite.T(), jobIDs, jobID1)
+}
+
+func (suite *TestJobManagerSuite) listJobsWith(fields ...storage.JobQueryField) ([]types.JobID, error) {
+	jobs, err := suite.listJobSummaries(fields...)
+	if err != nil {
+		return nil, err
+	}
+	return summaryIDs(jobs), nil
 }
@@ -10,6 +10,7 @@ package test
 
 import (
 	"bytes"
+	"strings"
 	"text/template"
 
 	"github.com/linuxboot/contest/pkg/job"
@@ -116,6 +117,18 @@ var jobDescriptorSlowEcho = descriptorMust(jobDescriptorTemplate, `
        "TestName": "IntegrationTest: slow echo"
    }`)
 
+var jobDescriptorArtifacts = descriptorMust(jobDescriptorTemplate, `
+   "TestFetcherFetchParameters": {
+       "Steps": [
+           {
+               "name": "artifacts",
+               "label": "artifacts_label",
+               "parameters": {}
+           }
+       ],
+       "TestName": "IntegrationTest: artifacts"
+   }`)
+
 var jobDescriptorFailure = descriptorMust(jobDescriptorTemplate, `
    "TestFetcherFetchParameters": {
        "Steps": [
@@ -352,3 +365,55 @@ var readmetaTemplate = template.Must(template.New("jobDescriptor").Parse(`
 }
 `))
 var jobDescriptorReadmeta = descriptorMust2(readmetaTemplate, &templateData{Version: jobDescriptorVersion})
+
+var inventoryTemplate = template.Must(template.New("jobDescriptor").Parse(`
+{
+    "JobName": "test job",
+    "Version": "{{ .Version }}",
+    "Runs": 1,
+    "RunInterval": "1s",
+    "Tags": [
+        "integration_testing"
+    ],
+    "TestDescriptors": [
+        {
+            "TargetManagerName": "InventoryTargetManager",
+            "TargetManagerAcquireParameters": {
+                "Selector": "board=tioga,!broken",
+                "MinNumberDevices": 2
+            },
+            "TargetManagerReleaseParameters": {},
+            "TestFetcherName": "literal",
+            {{ .Def }}
+        }
+    ],
+    "Reporting": {
+        "RunReporters": [
+            {
+                "Name": "TargetSuccess",
+                "Parameters": {
+                    "SuccessExpression": ">0%"
+                }
+            }
+        ]
+    }
+}
+`))
+var jobDescriptorInventory = descriptorMust2(inventoryTemplate, &templateData{Version: jobDescriptorVersion, Def: testStepsNoop})
+
+var jobDescriptorInventorySlowEcho = descriptorMust2(inventoryTemplate, &templateData{Version: jobDescriptorVersion, Def: `
+   "TestFetcherFetchParameters": {
+       "Steps": [
+           {
+               "name": "slowecho",
+               "label": "slowecho_label",
+               "parameters": {
+                 "sleep": ["1"],
+                 "text": ["Hello world"]
+               }
+           }
+       ],
+       "TestName": "IntegrationTest: slow echo"
+   }`})
+
+var jobDescriptorInventoryWait = strings.Replace(jobDescriptorInventory, `"MinNumberDevices": 2`, `"MinNumberDevices": 2, "Wait": true`, 1)
@@ -6,6 +6,7 @@
 package targetlocker
 
 import (
+	"errors"
 	"time"
 
 	"github.com/benbjohnson/clock"
@@ -16,6 +17,7 @@ import (
 	"github.com/linuxboot/contest/pkg/logging"
 	"github.com/linuxboot/contest/pkg/target"
 	"github.com/linuxboot/contest/pkg/types"
+	"github.com/linuxboot/contest/pkg/xcontext"
 	"github.com/linuxboot/contest/pkg/xcontext/bundles/logrusctx"
 	"github.com/linuxboot/contest/pkg/xcontext/logger"
 	"github.com/linuxboot/contest/tests/common/goroutine_leak_check"
@@ -24,6 +26,7 @@ import (
 var (
 	job1 = types.JobID(1)
 	job2 = types.JobID(2)
+	job3 = types.JobID(3)
 
 	shortTimeout   = 2 * time.Minute
 	defaultTimeout = 5 * time.Minute
@@ -326,3 +329,146 @@ func (ts *TargetLockerTestSuite) TestLockingTransactional() {
 	// this means it can be locked by the first owner
 	require.NoError(ts.T(), ts.tl.Lock(ctx, job1, defaultTimeout, target1))
 }
+
+type waitResult struct {
+	locked []string
+	err    error
+}
+
+// waitLock starts waiting for targets in the background, and returns once
+// the job is in the queue.
+func (ts *TargetLockerTestSuite) waitLock(ctx xcontext.Context, jobID types.JobID, priority int, targets []*target.Target, min, limit uint) <-chan waitResult {
+	wl := ts.tl.(target.WaitLocker)
+	res := make(chan waitResult, 1)
+	go func() {
+		locked, err := wl.WaitLock(ctx, jobID, priority, defaultTimeout, targets, min, limit)
+		res <- waitResult{locked: locked, err: err}
+	}()
+	require.Eventually(ts.T(), func() bool {
+		for _, w := range wl.Waiters() {
+			if w.JobID == jobID {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+	return res
+}
+
+func (ts *TargetLockerTestSuite) TestWaitLockAvailable() {
+	wl := ts.tl.(target.WaitLocker)
+	require.NoError(ts.T(), ts.tl.Lock(ctx, job1, defaultTimeout, target1))
+	locked, err := wl.WaitLock(ctx, job2, 0, defaultTimeout, allTargets, 2, 2)
+	require.NoError(ts.T(), err)
+	require.ElementsMatch(ts.T(), []string{"002", "003"}, locked)
+	require.Empty(ts.T(), wl.Waiters())
+}
+
+func (ts *TargetLockerTestSuite) TestWaitLockInvalid() {
+	wl := ts.tl.(target.WaitLocker)
+	_, err := wl.WaitLock(ctx, 0, 0, defaultTimeout, twoTargets, 1, 1)
+	require.Error(ts.T(), err)
+	_, err = wl.WaitLock(ctx, job1, 0, defaultTimeout, twoTargets, 3, 3)
+	require.Error(ts.T(), err)
+	_, err = wl.WaitLock(ctx, job1, 0, defaultTimeout, twoTargets, 2, 1)
+	require.Error(ts.T(), err)
+}
+
+func (ts *TargetLockerTestSuite) TestWaitLockUnlock() {
+	require.NoError(ts.T(), ts.tl.Lock(ctx, job1, defaultTimeout, allTargets[1:]))
+	res := ts.waitLock(ctx, job2, 0, allTargets, 2, 4)
+	require.NoError(ts.T(), ts.tl.Unlock(ctx, job1, target2))
+	r := <-res
+	require.NoError(ts.T(), r.err)
+	require.ElementsMatch(ts.T(), []string{"001", "002"}, r.locked)
+	require.Error(ts.T(), ts.tl.Lock(ctx, job1, defaultTimeout, target1))
+}
+
+func (ts *TargetLockerTestSuite) TestWaitLockOrder() {
+	wl := ts.tl.(target.WaitLocker)
+	require.NoError(ts.T(), ts.tl.Lock(ctx, job1, defaultTimeout, twoTargets))
+	resLow := ts.waitLock(ctx, job2, 0, twoTargets, 1, 1)
+	resHigh := ts.waitLock(ctx, job3, 10, twoTargets, 2, 2)
+	waiters := wl.Waiters()
+	require.Equal(ts.T(), 2, len(waiters))
+	require.Equal(ts.T(), job3, waiters[0].JobID)
+	require.Equal(ts.T(), 1, waiters[0].Position)
+	require.Equal(ts.T(), uint(2), waiters[0].MinTargets)
+	require.Equal(ts.T(), job2, waiters[1].JobID)
+	require.Equal(ts.T(), 2, waiters[1].Position)
+
+	// The freed target is reserved for the job with the higher priority,
+	// even if it needs two targets and the other job only one.
+	require.NoError(ts.T(), ts.tl.Unlock(ctx, job1, target1))
+	require.Never(ts.T(), func() bool { return len(resLow) > 0 || len(resHigh) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	require.NoError(ts.T(), ts.tl.Unlock(ctx, job1, target2))
+	r := <-resHigh
+	require.NoError(ts.T(), r.err)
+	require.ElementsMatch(ts.T(), []string{"001", "002"}, r.locked)
+
+	require.NoError(ts.T(), ts.tl.Unlock(ctx, job3, target2))
+	r = <-resLow
+	require.NoError(ts.T(), r.err)
+	require.Equal(ts.T(), []string{"002"}, r.locked)
+}
+
+func (ts *TargetLockerTestSuite) TestWaitLockExpired() {
+	require.NoError(ts.T(), ts.tl.Lock(ctx, job1, shortTimeout, target1))
+	res := ts.waitLock(ctx, job2, 0, target1, 1, 1)
+	var r waitResult
+	require.Eventually(ts.T(), func() bool {
+		ts.clock.Add(target.DefaultWaitInterval)
+		select {
+		case r = <-res:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(ts.T(), r.err)
+	require.Equal(ts.T(), []string{"001"}, r.locked)
+}
+
+func (ts *TargetLockerTestSuite) TestWaitLockCanceled() {
+	wl := ts.tl.(target.WaitLocker)
+	require.NoError(ts.T(), ts.tl.Lock(ctx, job1, defaultTimeout, target1))
+	waitCtx, cancel := xcontext.WithCancel(ctx)
+	res := ts.waitLock(waitCtx, job2, 0, target1, 1, 1)
+	cancel()
+	r := <-res
+	require.True(ts.T(), errors.Is(r.err, xcontext.ErrCanceled))
+	require.Empty(ts.T(), wl.Waiters())
+	require.NoError(ts.T(), ts.tl.Unlock(ctx, job1, target1))
+}
+
+func (ts *TargetLockerTestSuite) TestListLocks() {
+	ll := ts.tl.(target.LockLister)
+	locks, err := ll.ListLocks(ctx, nil)
+	require.NoError(ts.T(), err)
+	require.Empty(ts.T(), locks)
+
+	now := ts.clock.Now()
+	require.NoError(ts.T(), ts.tl.Lock(ctx, job2, defaultTimeout, target2))
+	require.NoError(ts.T(), ts.tl.Lock(ctx, job1, shortTimeout, target1))
+	locks, err = ll.ListLocks(ctx, nil)
+	require.NoError(ts.T(), err)
+	require.Equal(ts.T(), 2, len(locks))
+	require.Equal(ts.T(), "001", locks[0].TargetID)
+	require.Equal(ts.T(), job1, locks[0].JobID)
+	require.True(ts.T(), locks[0].CreatedAt.Equal(now))
+	require.True(ts.T(), locks[0].ExpiresAt.Equal(now.Add(shortTimeout)))
+	require.Equal(ts.T(), "002", locks[1].TargetID)
+	require.Equal(ts.T(), job2, locks[1].JobID)
+
+	locks, err = ll.ListLocks(ctx, []string{"002", "003"})
+	require.NoError(ts.T(), err)
+	require.Equal(ts.T(), 1, len(locks))
+	require.Equal(ts.T(), "002", locks[0].TargetID)
+
+	// Expired locks are listed until they are taken over.
+	ts.clock.Add(shortTimeout + time.Second)
+	locks, err = ll.ListLocks(ctx, []string{"001"})
+	require.NoError(ts.T(), err)
+	require.Equal(ts.T(), 1, len(locks))
+	require.Equal(ts.T(), job1, locks[0].JobID)
+}
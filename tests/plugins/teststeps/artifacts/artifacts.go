@@ -0,0 +1,53 @@
+// Copyright (c) Facebook, Inc. and its affiliates.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree.
+
+package artifacts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/linuxboot/contest/pkg/artifact"
+	"github.com/linuxboot/contest/pkg/event"
+	"github.com/linuxboot/contest/pkg/event/testevent"
+	"github.com/linuxboot/contest/pkg/target"
+	"github.com/linuxboot/contest/pkg/test"
+	"github.com/linuxboot/contest/pkg/xcontext"
+	"github.com/linuxboot/contest/plugins/teststeps"
+)
+
+// Name is the name used to look this plugin up.
+var Name = "Artifacts"
+
+// Events defines the events that a TestStep is allow to emit
+var Events = []event.Name{}
+
+type artifacts struct {
+}
+
+// Name returns the name of the Step
+func (ts *artifacts) Name() string {
+	return Name
+}
+
+// Run executes a step that uploads an artifact named after each target.
+func (ts *artifacts) Run(ctx xcontext.Context, ch test.TestStepChannels, params test.TestStepParameters, ev testevent.Emitter, resumeState json.RawMessage) (json.RawMessage, error) {
+	return teststeps.ForEachTarget(Name, ctx, ch, func(ctx xcontext.Context, t *target.Target) error {
+		content := fmt.Sprintf("artifact of %s", t.ID)
+		_, err := artifact.Upload(ctx, t, t.ID+".txt", strings.NewReader(content))
+		return err
+	})
+}
+
+// ValidateParameters validates the parameters associated to the TestStep
+func (ts *artifacts) ValidateParameters(_ xcontext.Context, params test.TestStepParameters) error {
+	return nil
+}
+
+// New creates a new artifacts step
+func New() test.TestStep {
+	return &artifacts{}
+}